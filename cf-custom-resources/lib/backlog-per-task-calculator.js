@@ -0,0 +1,90 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+const aws = require("aws-sdk");
+
+/**
+ * Get the number of running tasks of an ECS service.
+ *
+ * @param {string} cluster Name of the ECS cluster.
+ * @param {string} service Name of the ECS service.
+ *
+ * @returns {number} The number of running tasks.
+ */
+const getRunningTaskCount = async function (cluster, service) {
+  const ecs = new aws.ECS();
+  const resp = await ecs
+    .describeServices({
+      cluster: cluster,
+      services: [service],
+    })
+    .promise();
+  if (resp.services.length !== 1) {
+    throw new Error(
+      `Expected exactly one service ${service} in cluster ${cluster}, got ${resp.services.length}`
+    );
+  }
+  return resp.services[0].runningCount;
+};
+
+/**
+ * Get the approximate number of visible messages in a queue.
+ *
+ * @param {string} queueUrl URL of the SQS queue.
+ *
+ * @returns {number} The approximate number of messages available for retrieval.
+ */
+const getVisibleMessageCount = async function (queueUrl) {
+  const sqs = new aws.SQS();
+  const resp = await sqs
+    .getQueueAttributes({
+      QueueUrl: queueUrl,
+      AttributeNames: ["ApproximateNumberOfMessages"],
+    })
+    .promise();
+  return parseInt(resp.Attributes.ApproximateNumberOfMessages, 10);
+};
+
+/**
+ * Compute the backlog per task.
+ * If there are no running tasks, the whole backlog is attributed to a single task so that the service scales out.
+ *
+ * @param {number} messages Number of visible messages in the queue.
+ * @param {number} tasks Number of running tasks.
+ *
+ * @returns {number} The number of messages per running task, rounded up.
+ */
+const backlogPerTask = function (messages, tasks) {
+  if (tasks === 0) {
+    return messages;
+  }
+  return Math.ceil(messages / tasks);
+};
+
+/**
+ * Backlog per task handler, invoked by Lambda on a schedule.
+ * Emits the "ApproximateBacklogPerTask" metric in the CloudWatch embedded metric format.
+ */
+exports.handler = async function (event, context) {
+  const [messages, tasks] = await Promise.all([
+    getVisibleMessageCount(process.env.QUEUE_URL),
+    getRunningTaskCount(process.env.CLUSTER_NAME, process.env.ECS_SERVICE_NAME),
+  ]);
+  console.log(
+    JSON.stringify({
+      _aws: {
+        Timestamp: Date.now(),
+        CloudWatchMetrics: [
+          {
+            Namespace: process.env.NAMESPACE,
+            Dimensions: [["QueueName"]],
+            Metrics: [{ Name: "ApproximateBacklogPerTask", Unit: "Count" }],
+          },
+        ],
+      },
+      QueueName: process.env.QUEUE_NAME,
+      ApproximateBacklogPerTask: backlogPerTask(messages, tasks),
+    })
+  );
+};
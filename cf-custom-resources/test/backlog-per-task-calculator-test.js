@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+"use strict";
+
+describe("Backlog per task calculator", () => {
+  const AWS = require("aws-sdk-mock");
+  const sinon = require("sinon");
+  const BacklogPerTaskCalculator = require("../lib/backlog-per-task-calculator");
+  const LambdaTester = require("lambda-tester").noVersionCheck();
+  let origLog = console.log;
+  let origEnv = process.env;
+
+  beforeEach(() => {
+    process.env = {
+      ...origEnv,
+      CLUSTER_NAME: "mockCluster",
+      ECS_SERVICE_NAME: "mockService",
+      QUEUE_URL: "https://sqs.us-west-2.amazonaws.com/1234/mockQueue",
+      QUEUE_NAME: "mockQueue",
+      NAMESPACE: "app-env-worker",
+    };
+  });
+  afterEach(() => {
+    AWS.restore();
+    console.log = origLog;
+    process.env = origEnv;
+  });
+
+  const mockCounts = (messages, runningCount) => {
+    AWS.mock(
+      "SQS",
+      "getQueueAttributes",
+      sinon.fake.resolves({
+        Attributes: { ApproximateNumberOfMessages: `${messages}` },
+      })
+    );
+    AWS.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({ services: [{ runningCount: runningCount }] })
+    );
+  };
+
+  test("emits the backlog per running task", () => {
+    mockCounts(25, 2);
+    const logs = [];
+    console.log = (msg) => logs.push(JSON.parse(msg));
+
+    return LambdaTester(BacklogPerTaskCalculator.handler)
+      .event({})
+      .expectResolve(() => {
+        expect(logs.length).toBe(1);
+        expect(logs[0].QueueName).toBe("mockQueue");
+        expect(logs[0].ApproximateBacklogPerTask).toBe(13);
+        expect(logs[0]._aws.CloudWatchMetrics[0].Namespace).toBe(
+          "app-env-worker"
+        );
+      });
+  });
+
+  test("emits the whole backlog if there are no running tasks", () => {
+    mockCounts(7, 0);
+    const logs = [];
+    console.log = (msg) => logs.push(JSON.parse(msg));
+
+    return LambdaTester(BacklogPerTaskCalculator.handler)
+      .event({})
+      .expectResolve(() => {
+        expect(logs[0].ApproximateBacklogPerTask).toBe(7);
+      });
+  });
+
+  test("fails if the service can't be found", () => {
+    AWS.mock(
+      "SQS",
+      "getQueueAttributes",
+      sinon.fake.resolves({ Attributes: { ApproximateNumberOfMessages: "1" } })
+    );
+    AWS.mock("ECS", "describeServices", sinon.fake.resolves({ services: [] }));
+
+    return LambdaTester(BacklogPerTaskCalculator.handler)
+      .event({})
+      .expectReject((err) => {
+        expect(err.message).toBe(
+          "Expected exactly one service mockService in cluster mockCluster, got 0"
+        );
+      });
+  });
+});
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
@@ -24,6 +25,11 @@ func main() {
 	cmd := buildRootCmd()
 	if err := cmd.Execute(); err != nil {
 		log.Errorln(err.Error())
+		// Commands such as "task run --follow" exit with the exit code of the container that failed.
+		var exitErr interface{ ExitCode() int }
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -52,21 +52,14 @@ type changeSetDescription struct {
 }
 
 func newCreateChangeSet(cfnClient changeSetAPI, stackName string) (*changeSet, error) {
-	id, err := uuid.NewRandom()
-	if err != nil {
-		return nil, fmt.Errorf("generate random id for Change Set: %w", err)
-	}
-
-	return &changeSet{
-		name:      fmt.Sprintf(fmtChangeSetName, id.String()),
-		stackName: stackName,
-		csType:    createChangeSetType,
-
-		client: cfnClient,
-	}, nil
+	return newChangeSet(cfnClient, stackName, createChangeSetType)
 }
 
 func newUpdateChangeSet(cfnClient changeSetAPI, stackName string) (*changeSet, error) {
+	return newChangeSet(cfnClient, stackName, updateChangeSetType)
+}
+
+func newChangeSet(cfnClient changeSetAPI, stackName string, csType changeSetType) (*changeSet, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
 		return nil, fmt.Errorf("generate random id for Change Set: %w", err)
@@ -75,7 +68,7 @@ func newUpdateChangeSet(cfnClient changeSetAPI, stackName string) (*changeSet, e
 	return &changeSet{
 		name:      fmt.Sprintf(fmtChangeSetName, id.String()),
 		stackName: stackName,
-		csType:    updateChangeSetType,
+		csType:    csType,
 
 		client: cfnClient,
 	}, nil
@@ -87,7 +80,7 @@ func (cs *changeSet) String() string {
 
 // create creates a Change Set and waits until it's created.
 func (cs *changeSet) create(conf *stackConfig) error {
-	_, err := cs.client.CreateChangeSet(&cloudformation.CreateChangeSetInput{
+	in := &cloudformation.CreateChangeSetInput{
 		ChangeSetName: aws.String(cs.name),
 		StackName:     aws.String(cs.stackName),
 		ChangeSetType: aws.String(cs.csType.String()),
@@ -100,7 +93,12 @@ func (cs *changeSet) create(conf *stackConfig) error {
 			cloudformation.CapabilityCapabilityNamedIam,
 			cloudformation.CapabilityCapabilityAutoExpand,
 		}),
-	})
+	}
+	if conf.UsePreviousTemplate {
+		in.TemplateBody = nil
+		in.UsePreviousTemplate = aws.Bool(true)
+	}
+	_, err := cs.client.CreateChangeSet(in)
 	if err != nil {
 		return fmt.Errorf("create %s: %w", cs, err)
 	}
@@ -176,6 +174,15 @@ func (cs *changeSet) execute() error {
 // createAndExecute calls create and then execute.
 // If the change set is empty, returns a ErrChangeSetEmpty.
 func (cs *changeSet) createAndExecute(conf *stackConfig) error {
+	if err := cs.createNonEmpty(conf); err != nil {
+		return err
+	}
+	return cs.execute()
+}
+
+// createNonEmpty calls create.
+// If the change set is empty, deletes it and returns a ErrChangeSetEmpty.
+func (cs *changeSet) createNonEmpty(conf *stackConfig) error {
 	if err := cs.create(conf); err != nil {
 		// It's possible that there are no changes between the previous and proposed stack change sets.
 		// We make a call to describe the change set to see if that is indeed the case and handle it gracefully.
@@ -195,7 +202,7 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return err
 	}
-	return cs.execute()
+	return nil
 }
 
 // delete removes the change set.
@@ -173,8 +173,55 @@ func (c *CloudFormation) Describe(name string) (*StackDescription, error) {
 	return &descr, nil
 }
 
+// ListStacksWithTags returns the stacks in the account and region that have all the tags.
+// A tag with an empty value matches any value of the key.
+func (c *CloudFormation) ListStacksWithTags(tags map[string]string) ([]StackDescription, error) {
+	var stacks []StackDescription
+	var nextToken *string
+	for {
+		out, err := c.client.DescribeStacks(&cloudformation.DescribeStacksInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list stacks: %w", err)
+		}
+		for _, stack := range out.Stacks {
+			if hasTags(stack.Tags, tags) {
+				stacks = append(stacks, StackDescription(*stack))
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return stacks, nil
+}
+
+func hasTags(stackTags []*cloudformation.Tag, tags map[string]string) bool {
+	values := make(map[string]string)
+	for _, tag := range stackTags {
+		values[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for key, value := range tags {
+		v, ok := values[key]
+		if !ok {
+			return false
+		}
+		if value != "" && v != value {
+			return false
+		}
+	}
+	return true
+}
+
 // Events returns the list of stack events in **chronological** order.
 func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
+	return c.EventsSince(stackName, time.Time{})
+}
+
+// EventsSince returns the list of stack events that occurred at or after the given time in **chronological** order.
+func (c *CloudFormation) EventsSince(stackName string, since time.Time) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
 	for {
@@ -185,11 +232,17 @@ func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 		if err != nil {
 			return nil, fmt.Errorf("desribe stack events for stack %s: %w", stackName, err)
 		}
+		olderEventFound := false
 		for _, event := range out.StackEvents {
+			if aws.TimeValue(event.Timestamp).Before(since) {
+				// Events are returned in reverse chronological order, so all the remaining events are older.
+				olderEventFound = true
+				break
+			}
 			events = append(events, StackEvent(*event))
 		}
 		nextToken = out.NextToken
-		if nextToken == nil {
+		if nextToken == nil || olderEventFound {
 			break
 		}
 	}
@@ -202,6 +255,84 @@ func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 	return events, nil
 }
 
+// CreateChangeSet creates a change set for the stack and waits until it's created without executing it,
+// so that the changes can be reviewed first.
+// If the stack does not exist, the change set creates the stack. Otherwise, the change set updates it.
+// If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) CreateChangeSet(stack *Stack) (*ChangeSetDescription, error) {
+	csType, err := c.changeSetType(stack.Name)
+	if err != nil {
+		return nil, err
+	}
+	cs, err := newChangeSet(c.client, stack.Name, csType)
+	if err != nil {
+		return nil, err
+	}
+	if err := cs.createNonEmpty(stack.stackConfig); err != nil {
+		return nil, err
+	}
+	descr, err := cs.describe()
+	if err != nil {
+		return nil, err
+	}
+	return &ChangeSetDescription{
+		Name:      cs.name,
+		StackName: cs.stackName,
+		Type:      cs.csType.String(),
+		Changes:   descr.changes,
+	}, nil
+}
+
+// ExecuteChangeSet executes a change set that was previously created with CreateChangeSet.
+func (c *CloudFormation) ExecuteChangeSet(stackName, changeSetName string) error {
+	cs := &changeSet{
+		name:      changeSetName,
+		stackName: stackName,
+		client:    c.client,
+	}
+	return cs.execute()
+}
+
+// DeleteChangeSet removes a change set that was previously created with CreateChangeSet.
+func (c *CloudFormation) DeleteChangeSet(stackName, changeSetName string) error {
+	cs := &changeSet{
+		name:      changeSetName,
+		stackName: stackName,
+		client:    c.client,
+	}
+	return cs.delete()
+}
+
+// changeSetType returns the type of change set needed to deploy the stack.
+// If the stack exists but failed to create, it is deleted so that it can be re-created.
+func (c *CloudFormation) changeSetType(stackName string) (changeSetType, error) {
+	descr, err := c.Describe(stackName)
+	if err != nil {
+		var stackNotFound *ErrStackNotFound
+		if !errors.As(err, &stackNotFound) {
+			return createChangeSetType, err
+		}
+		return createChangeSetType, nil
+	}
+	status := stackStatus(aws.StringValue(descr.StackStatus))
+	if status.requiresCleanup() {
+		if err := c.Delete(stackName); err != nil {
+			return createChangeSetType, fmt.Errorf("cleanup previously failed stack %s: %w", stackName, err)
+		}
+		return createChangeSetType, nil
+	}
+	if status.inReview() {
+		// A previous change set that creates the stack was never executed.
+		return createChangeSetType, nil
+	}
+	if status.inProgress() {
+		return createChangeSetType, &ErrStackUpdateInProgress{
+			Name: stackName,
+		}
+	}
+	return updateChangeSetType, nil
+}
+
 func (c *CloudFormation) create(stack *Stack) error {
 	cs, err := newCreateChangeSet(c.client, stack.Name)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -401,6 +402,102 @@ func TestCloudFormation_DeleteAndWait(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_ListStacksWithTags(t *testing.T) {
+	testCases := map[string]struct {
+		inTags      map[string]string
+		createMock  func(ctrl *gomock.Controller) api
+		wantedNames []string
+		wantedErr   error
+	}{
+		"wraps error from DescribeStacks": {
+			inTags: map[string]string{"copilot-task": ""},
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("list stacks: some error"),
+		},
+		"returns the stacks with matching tags across pages": {
+			inTags: map[string]string{
+				"copilot-task":        "",
+				"copilot-environment": "test",
+			},
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				gomock.InOrder(
+					m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{}).Return(&cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{
+							{
+								StackName: aws.String("task-in-test"),
+								Tags: []*cloudformation.Tag{
+									{Key: aws.String("copilot-task"), Value: aws.String("migrate")},
+									{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+								},
+							},
+							{
+								StackName: aws.String("task-in-prod"),
+								Tags: []*cloudformation.Tag{
+									{Key: aws.String("copilot-task"), Value: aws.String("migrate")},
+									{Key: aws.String("copilot-environment"), Value: aws.String("prod")},
+								},
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeStacks(&cloudformation.DescribeStacksInput{
+						NextToken: aws.String("next"),
+					}).Return(&cloudformation.DescribeStacksOutput{
+						Stacks: []*cloudformation.Stack{
+							{
+								StackName: aws.String("env-stack"),
+								Tags: []*cloudformation.Tag{
+									{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+								},
+							},
+							{
+								StackName: aws.String("other-task-in-test"),
+								Tags: []*cloudformation.Tag{
+									{Key: aws.String("copilot-task"), Value: aws.String("cleanup")},
+									{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+								},
+							},
+						},
+					}, nil),
+				)
+				return m
+			},
+			wantedNames: []string{"task-in-test", "other-task-in-test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			stacks, err := c.ListStacksWithTags(tc.inTags)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, stack := range stacks {
+				names = append(names, aws.StringValue(stack.StackName))
+			}
+			require.Equal(t, tc.wantedNames, names)
+		})
+	}
+}
+
 func TestCloudFormation_Describe(t *testing.T) {
 	testCases := map[string]struct {
 		createMock  func(ctrl *gomock.Controller) api
@@ -515,6 +612,220 @@ func TestCloudFormation_Events(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_EventsSince(t *testing.T) {
+	since := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		createMock   func(ctrl *gomock.Controller) api
+		wantedEvents []StackEvent
+		wantedErr    error
+	}{
+		"stops paginating once an older event is found": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String(mockStack.Name),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							ResourceType: aws.String("ecs"),
+							Timestamp:    aws.Time(since.Add(time.Minute)),
+						},
+						{
+							ResourceType: aws.String("s3"),
+							Timestamp:    aws.Time(since),
+						},
+						{
+							ResourceType: aws.String("iam"),
+							Timestamp:    aws.Time(since.Add(-time.Minute)),
+						},
+					},
+					NextToken: aws.String("1111"),
+				}, nil)
+				return m
+			},
+			wantedEvents: []StackEvent{
+				{
+					ResourceType: aws.String("s3"),
+					Timestamp:    aws.Time(since),
+				},
+				{
+					ResourceType: aws.String("ecs"),
+					Timestamp:    aws.Time(since.Add(time.Minute)),
+				},
+			},
+		},
+		"wraps error on failure": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: fmt.Errorf("desribe stack events for stack %s: %w", mockStack.Name, errors.New("some error")),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			events, err := c.EventsSince(mockStack.Name, since)
+
+			// THEN
+			require.Equal(t, tc.wantedEvents, events)
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
+
+func TestCloudFormation_CreateChangeSet(t *testing.T) {
+	mockChanges := []*cloudformation.Change{
+		{
+			ResourceChange: &cloudformation.ResourceChange{
+				Action:            aws.String(cloudformation.ChangeActionModify),
+				LogicalResourceId: aws.String("TargetGroup"),
+				Replacement:       aws.String(cloudformation.ReplacementTrue),
+			},
+			Type: aws.String(cloudformation.ChangeTypeResource),
+		},
+	}
+	testCases := map[string]struct {
+		createMock  func(ctrl *gomock.Controller) api
+		wantedDescr *ChangeSetDescription
+		wantedErr   error
+	}{
+		"fail if a stack exists that's already in progress": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStack.Name,
+			},
+		},
+		"returns the changes to create the stack without executing the change set": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(nil, errDoesNotExist)
+				addCreateChangeSetCalls(m, cloudformation.ChangeSetTypeCreate, mockChanges)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+			wantedDescr: &ChangeSetDescription{
+				Name:      mockChangeSetName,
+				StackName: mockStack.Name,
+				Type:      cloudformation.ChangeSetTypeCreate,
+				Changes:   mockChanges,
+			},
+		},
+		"returns the changes to update an existing stack": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+						},
+					},
+				}, nil)
+				addCreateChangeSetCalls(m, cloudformation.ChangeSetTypeUpdate, mockChanges)
+				return m
+			},
+			wantedDescr: &ChangeSetDescription{
+				Name:      mockChangeSetName,
+				StackName: mockStack.Name,
+				Type:      cloudformation.ChangeSetTypeUpdate,
+				Changes:   mockChanges,
+			},
+		},
+		"deletes the change set and returns ErrChangeSetEmpty if there are no changes": {
+			createMock: func(ctrl *gomock.Controller) api {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusUnavailable),
+					StatusReason:    aws.String(noChangesReason),
+				}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStack.Name),
+				})
+				return m
+			},
+			wantedErr: &ErrChangeSetEmpty{
+				cs: &changeSet{
+					name:      mockChangeSetName,
+					stackName: mockStack.Name,
+					csType:    updateChangeSetType,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			descr, err := c.CreateChangeSet(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDescr, descr)
+		})
+	}
+}
+
+func addCreateChangeSetCalls(m *mocks.Mockapi, changeSetType string, changes []*cloudformation.Change) {
+	m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(in *cloudformation.CreateChangeSetInput) (*cloudformation.CreateChangeSetOutput, error) {
+		if aws.StringValue(in.ChangeSetType) != changeSetType {
+			return nil, fmt.Errorf("unexpected change set type %s", aws.StringValue(in.ChangeSetType))
+		}
+		return nil, nil
+	})
+	m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any())
+	m.EXPECT().DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(mockChangeSetName),
+		StackName:     aws.String(mockStack.Name),
+	}).Return(&cloudformation.DescribeChangeSetOutput{
+		Changes:         changes,
+		ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+	}, nil)
+}
+
 func addCreateDeployCalls(m *mocks.Mockapi) {
 	addDeployCalls(m, cloudformation.ChangeSetTypeCreate)
 }
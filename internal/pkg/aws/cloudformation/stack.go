@@ -15,10 +15,11 @@ type Stack struct {
 }
 
 type stackConfig struct {
-	Template   string
-	Parameters []*cloudformation.Parameter
-	Tags       []*cloudformation.Tag
-	RoleARN    *string
+	Template            string
+	UsePreviousTemplate bool
+	Parameters          []*cloudformation.Parameter
+	Tags                []*cloudformation.Tag
+	RoleARN             *string
 }
 
 // StackOption allows you to initialize a Stack with additional properties.
@@ -73,6 +74,14 @@ func WithRoleARN(roleARN string) StackOption {
 	}
 }
 
+// WithPreviousTemplate updates a stack with the template that it's currently deployed with instead of a new template.
+func WithPreviousTemplate() StackOption {
+	return func(s *Stack) {
+		s.Template = ""
+		s.UsePreviousTemplate = true
+	}
+}
+
 // StackEvent represents a stack event for a resource.
 type StackEvent cloudformation.StackEvent
 
@@ -84,3 +93,11 @@ func (d *StackDescription) SDK() *cloudformation.Stack {
 	raw := cloudformation.Stack(*d)
 	return &raw
 }
+
+// ChangeSetDescription represents a change set that was created for a stack but not executed yet.
+type ChangeSetDescription struct {
+	Name      string
+	StackName string
+	Type      string // Either "CREATE" if the change set creates the stack or "UPDATE" otherwise.
+	Changes   []*cloudformation.Change
+}
@@ -39,3 +39,12 @@ func TestNewStack(t *testing.T) {
 	}, s.Tags)
 	require.Equal(t, aws.String("arn"), s.RoleARN)
 }
+
+func TestNewStack_WithPreviousTemplate(t *testing.T) {
+	// WHEN
+	s := NewStack("hello", "world", WithPreviousTemplate())
+
+	// THEN
+	require.Equal(t, "", s.Template)
+	require.True(t, s.UsePreviousTemplate)
+}
@@ -16,6 +16,11 @@ func (s stackStatus) requiresCleanup() bool {
 	return cloudformation.StackStatusRollbackComplete == string(s) || cloudformation.StackStatusRollbackFailed == string(s)
 }
 
+// inReview returns true if the stack was created by a change set that hasn't been executed yet.
+func (s stackStatus) inReview() bool {
+	return cloudformation.StackStatusReviewInProgress == string(s)
+}
+
 // inProgress returns true if the stack is currently being updated.
 func (s stackStatus) inProgress() bool {
 	return strings.HasSuffix(string(s), "IN_PROGRESS")
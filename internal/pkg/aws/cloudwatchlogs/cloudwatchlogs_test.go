@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs/mocks"
 	"github.com/golang/mock/gomock"
@@ -269,3 +270,55 @@ func TestLogEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteLogGroup(t *testing.T) {
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"returns wrapped error": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteLogGroup(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("delete log group mockLogGroup: some error"),
+		},
+		"ignores log groups that don't exist": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteLogGroup(gomock.Any()).Return(nil, awserr.New(cloudwatchlogs.ErrCodeResourceNotFoundException, "not found", nil))
+			},
+		},
+		"deletes the log group": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
+					LogGroupName: aws.String("mockLogGroup"),
+				}).Return(&cloudwatchlogs.DeleteLogGroupOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcloudwatchlogsClient := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(mockcloudwatchlogsClient)
+
+			service := CloudWatchLogs{
+				client: mockcloudwatchlogsClient,
+			}
+
+			// WHEN
+			err := service.DeleteLogGroup("mockLogGroup")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -62,6 +62,8 @@ type api interface {
 	DescribeSecurityGroups(*ec2.DescribeSecurityGroupsInput) (*ec2.DescribeSecurityGroupsOutput, error)
 	DescribeVpcs(input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
 	DescribeVpcAttribute(input *ec2.DescribeVpcAttributeInput) (*ec2.DescribeVpcAttributeOutput, error)
+	DescribeVpcEndpoints(input *ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error)
+	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -166,6 +168,55 @@ func (c *EC2) HasDNSSupport(vpcID string) (bool, error) {
 	return aws.BoolValue(resp.EnableDnsSupport.Value), nil
 }
 
+// VPCEndpointServices returns the service names of the endpoints in the VPC, such as "com.amazonaws.us-west-2.s3".
+func (c *EC2) VPCEndpointServices(vpcID string) ([]string, error) {
+	var endpoints []*ec2.VpcEndpoint
+	in := &ec2.DescribeVpcEndpointsInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "vpc-id",
+				Values: []string{vpcID},
+			},
+		}),
+	}
+	for {
+		resp, err := c.client.DescribeVpcEndpoints(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe endpoints for VPC %s: %w", vpcID, err)
+		}
+		endpoints = append(endpoints, resp.VpcEndpoints...)
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	var services []string
+	for _, endpoint := range endpoints {
+		services = append(services, aws.StringValue(endpoint.ServiceName))
+	}
+	return services, nil
+}
+
+// AvailabilityZoneNames returns the names of the availability zones of the region that are available to the account.
+func (c *EC2) AvailabilityZoneNames() ([]string, error) {
+	resp, err := c.client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		Filters: toEC2Filter([]Filter{
+			{
+				Name:   "state",
+				Values: []string{ec2.AvailabilityZoneStateAvailable},
+			},
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe availability zones: %w", err)
+	}
+	var names []string
+	for _, zone := range resp.AvailabilityZones {
+		names = append(names, aws.StringValue(zone.ZoneName))
+	}
+	return names, nil
+}
+
 // ListVPCSubnets lists all subnets given a VPC ID.
 func (c *EC2) ListVPCSubnets(vpcID string, opts ...ListVPCSubnetsOpts) ([]string, error) {
 	respSubnets, err := c.subnets(Filter{
@@ -234,6 +285,53 @@ func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
 	return securityGroups, nil
 }
 
+// SecurityGroupRule represents an inbound rule of a security group.
+type SecurityGroupRule struct {
+	GroupID     string
+	Protocol    string // "-1" stands for all protocols.
+	FromPort    int64
+	ToPort      int64
+	Source      string // ID of the source security group or CIDR block.
+	Description string
+}
+
+// SecurityGroupRules returns the inbound rules of the security groups, with one rule per source.
+func (c *EC2) SecurityGroupRules(groupIDs ...string) ([]*SecurityGroupRule, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	resp, err := c.client.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+		GroupIds: aws.StringSlice(groupIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe security groups %s: %w", strings.Join(groupIDs, ", "), err)
+	}
+	var rules []*SecurityGroupRule
+	for _, sg := range resp.SecurityGroups {
+		for _, perm := range sg.IpPermissions {
+			rule := SecurityGroupRule{
+				GroupID:  aws.StringValue(sg.GroupId),
+				Protocol: aws.StringValue(perm.IpProtocol),
+				FromPort: aws.Int64Value(perm.FromPort),
+				ToPort:   aws.Int64Value(perm.ToPort),
+			}
+			for _, pair := range perm.UserIdGroupPairs {
+				r := rule
+				r.Source = aws.StringValue(pair.GroupId)
+				r.Description = aws.StringValue(pair.Description)
+				rules = append(rules, &r)
+			}
+			for _, ipRange := range perm.IpRanges {
+				r := rule
+				r.Source = aws.StringValue(ipRange.CidrIp)
+				r.Description = aws.StringValue(ipRange.Description)
+				rules = append(rules, &r)
+			}
+		}
+	}
+	return rules, nil
+}
+
 func (c *EC2) subnets(filters ...Filter) ([]*ec2.Subnet, error) {
 	inputFilters := toEC2Filter(filters)
 	var subnets []*ec2.Subnet
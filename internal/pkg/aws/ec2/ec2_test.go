@@ -482,3 +482,202 @@ func TestEC2_HasDNSSupport(t *testing.T) {
 		})
 	}
 }
+
+func TestEC2_AvailabilityZoneNames(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedNames []string
+	}{
+		"fail to describe availability zones": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZones(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe availability zones: some error"),
+		},
+		"success": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("state"),
+							Values: aws.StringSlice([]string{"available"}),
+						},
+					},
+				}).Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: []*ec2.AvailabilityZone{
+						{
+							ZoneName: aws.String("us-west-2a"),
+						},
+						{
+							ZoneName: aws.String("us-west-2b"),
+						},
+					},
+				}, nil)
+			},
+			wantedNames: []string{"us-west-2a", "us-west-2b"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			names, err := ec2Client.AvailabilityZoneNames()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedNames, names)
+			}
+		})
+	}
+}
+
+func TestEC2_VPCEndpointServices(t *testing.T) {
+	mockInput := &ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{"mockVPCID"}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError    error
+		wantedServices []string
+	}{
+		"fail to describe VPC endpoints": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcEndpoints(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe endpoints for VPC mockVPCID: some error"),
+		},
+		"success with pagination": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeVpcEndpoints(mockInput).Return(&ec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []*ec2.VpcEndpoint{
+						{ServiceName: aws.String("com.amazonaws.us-west-2.s3")},
+					},
+					NextToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+					Filters:   mockInput.Filters,
+					NextToken: aws.String("mockToken"),
+				}).Return(&ec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []*ec2.VpcEndpoint{
+						{ServiceName: aws.String("com.amazonaws.us-west-2.ecr.api")},
+					},
+				}, nil)
+			},
+			wantedServices: []string{"com.amazonaws.us-west-2.s3", "com.amazonaws.us-west-2.ecr.api"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			services, err := ec2Client.VPCEndpointServices("mockVPCID")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedServices, services)
+			}
+		})
+	}
+}
+
+func TestEC2_SecurityGroupRules(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedRules []*SecurityGroupRule
+	}{
+		"fail to describe security groups": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe security groups sg-1, sg-2: some error"),
+		},
+		"returns one rule per source": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+					GroupIds: aws.StringSlice([]string{"sg-1", "sg-2"}),
+				}).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []*ec2.SecurityGroup{
+						{
+							GroupId: aws.String("sg-1"),
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("-1"),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{
+										{GroupId: aws.String("sg-1"), Description: aws.String("Ingress from self")},
+									},
+								},
+							},
+						},
+						{
+							GroupId: aws.String("sg-2"),
+							IpPermissions: []*ec2.IpPermission{
+								{
+									IpProtocol: aws.String("tcp"),
+									FromPort:   aws.Int64(80),
+									ToPort:     aws.Int64(80),
+									UserIdGroupPairs: []*ec2.UserIdGroupPair{
+										{GroupId: aws.String("sg-3"), Description: aws.String("Ingress from api")},
+									},
+									IpRanges: []*ec2.IpRange{
+										{CidrIp: aws.String("10.0.0.0/16")},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedRules: []*SecurityGroupRule{
+				{GroupID: "sg-1", Protocol: "-1", Source: "sg-1", Description: "Ingress from self"},
+				{GroupID: "sg-2", Protocol: "tcp", FromPort: 80, ToPort: 80, Source: "sg-3", Description: "Ingress from api"},
+				{GroupID: "sg-2", Protocol: "tcp", FromPort: 80, ToPort: 80, Source: "10.0.0.0/16"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			rules, err := ec2Client.SecurityGroupRules("sg-1", "sg-2")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedRules, rules)
+			}
+		})
+	}
+}
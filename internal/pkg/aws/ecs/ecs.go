@@ -28,6 +28,11 @@ const (
 
 	// DesiredStatusStopped represents the desired status "STOPPED" for a task.
 	DesiredStatusStopped = ecs.DesiredStatusStopped
+	// DesiredStatusRunning represents the desired status "RUNNING" for a task.
+	DesiredStatusRunning = ecs.DesiredStatusRunning
+
+	// CapacityProviderFargateSpot is the capacity provider of tasks that run on Fargate Spot.
+	CapacityProviderFargateSpot = "FARGATE_SPOT"
 )
 
 type api interface {
@@ -37,12 +42,14 @@ type api interface {
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	DescribeClusters(input *ecs.DescribeClustersInput) (*ecs.DescribeClustersOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
+	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
 }
 
 // ECS wraps an AWS ECS client.
 type ECS struct {
-	client api
+	client     api
+	execClient execAPI
 }
 
 // TaskDefinition wraps up ECS TaskDefinition struct.
@@ -65,18 +72,19 @@ type ServiceStatus struct {
 
 // TaskStatus contains the status info of a task.
 type TaskStatus struct {
-	Health        string    `json:"health"`
-	ID            string    `json:"id"`
-	Images        []Image   `json:"images"`
-	LastStatus    string    `json:"lastStatus"`
-	StartedAt     time.Time `json:"startedAt"`
-	StoppedAt     time.Time `json:"stoppedAt"`
-	StoppedReason string    `json:"stoppedReason"`
+	Health           string    `json:"health"`
+	ID               string    `json:"id"`
+	Images           []Image   `json:"images"`
+	LastStatus       string    `json:"lastStatus"`
+	StartedAt        time.Time `json:"startedAt"`
+	StoppedAt        time.Time `json:"stoppedAt"`
+	StoppedReason    string    `json:"stoppedReason"`
+	CapacityProvider string    `json:"capacityProvider"` // Empty unless the task was placed with a capacity provider, such as FARGATE_SPOT.
 }
 
 // HumanString returns the stringified TaskStatus struct with human readable format.
 // Example output:
-//   6ca7a60d          f884127d            RUNNING             UNKNOWN             19 hours ago        -
+//   6ca7a60d          f884127d            RUNNING             19 hours ago        -                   UNKNOWN             FARGATE_SPOT
 func (t TaskStatus) HumanString() string {
 	var digest []string
 	imageDigest := "-"
@@ -101,7 +109,11 @@ func (t TaskStatus) HumanString() string {
 	if len(t.ID) >= shortTaskIDLength {
 		shortTaskID = t.ID[:shortTaskIDLength]
 	}
-	return fmt.Sprintf("  %s\t%s\t%s\t%s\t%s\t%s\n", shortTaskID, imageDigest, t.LastStatus, startedSince, stoppedSince, taskHealthColor(t.Health))
+	capacityProvider := "-"
+	if t.CapacityProvider != "" {
+		capacityProvider = t.CapacityProvider
+	}
+	return fmt.Sprintf("  %s\t%s\t%s\t%s\t%s\t%s\t%s\n", shortTaskID, imageDigest, t.LastStatus, startedSince, stoppedSince, taskHealthColor(t.Health), capacityProvider)
 }
 
 func taskHealthColor(status string) string {
@@ -131,12 +143,15 @@ type RunTaskInput struct {
 	SecurityGroups []string
 	TaskFamilyName string
 	StartedBy      string
+	Spot           bool // Run the tasks on Fargate Spot instead of Fargate.
 }
 
 // New returns a Service configured against the input session.
 func New(s *session.Session) *ECS {
+	client := ecs.New(s)
 	return &ECS{
-		client: ecs.New(s),
+		client:     client,
+		execClient: &execClient{client},
 	}
 }
 
@@ -202,6 +217,73 @@ func (e *ECS) ServiceTasks(clusterName, serviceName string) ([]*Task, error) {
 	return tasks, nil
 }
 
+// ListTasksOpts sets optional filters of ListTasks.
+type ListTasksOpts func(*ecs.ListTasksInput)
+
+// WithFamily lists only the tasks of the task definition family.
+func WithFamily(family string) ListTasksOpts {
+	return func(in *ecs.ListTasksInput) {
+		in.Family = aws.String(family)
+	}
+}
+
+// WithDesiredStatus lists only the tasks with the desired status. By default, ECS lists the running tasks.
+func WithDesiredStatus(status string) ListTasksOpts {
+	return func(in *ecs.ListTasksInput) {
+		in.DesiredStatus = aws.String(status)
+	}
+}
+
+// ListTasks returns the tasks in the cluster that match the filters.
+func (e *ECS) ListTasks(cluster string, opts ...ListTasksOpts) ([]*Task, error) {
+	var tasks []*Task
+	in := &ecs.ListTasksInput{
+		Cluster: aws.String(cluster),
+	}
+	for _, opt := range opts {
+		opt(in)
+	}
+	for {
+		listTaskResp, err := e.client.ListTasks(in)
+		if err != nil {
+			return nil, fmt.Errorf("list tasks in cluster %s: %w", cluster, err)
+		}
+		if len(listTaskResp.TaskArns) != 0 {
+			descTaskResp, err := e.client.DescribeTasks(&ecs.DescribeTasksInput{
+				Cluster: aws.String(cluster),
+				Tasks:   listTaskResp.TaskArns,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("describe tasks in cluster %s: %w", cluster, err)
+			}
+			for _, task := range descTaskResp.Tasks {
+				t := Task(*task)
+				tasks = append(tasks, &t)
+			}
+		}
+		if listTaskResp.NextToken == nil {
+			break
+		}
+		in.NextToken = listTaskResp.NextToken
+	}
+	return tasks, nil
+}
+
+// StopTasks stops the tasks in the cluster, and records the reason in their stopped reason.
+func (e *ECS) StopTasks(cluster string, taskARNs []string, reason string) error {
+	for _, taskARN := range taskARNs {
+		_, err := e.client.StopTask(&ecs.StopTaskInput{
+			Cluster: aws.String(cluster),
+			Task:    aws.String(taskARN),
+			Reason:  aws.String(reason),
+		})
+		if err != nil {
+			return fmt.Errorf("stop task %s: %w", taskARN, err)
+		}
+	}
+	return nil
+}
+
 // DefaultCluster returns the default cluster ARN in the account and region.
 func (e *ECS) DefaultCluster() (string, error) {
 	resp, err := e.client.DescribeClusters(&ecs.DescribeClustersInput{})
@@ -232,7 +314,7 @@ func (e *ECS) HasDefaultCluster() (bool, error) {
 // RunTask runs a number of tasks with the task definition and network configurations in a cluster, and returns after
 // the task(s) is running or fails to run, along with task ARNs if possible.
 func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
-	resp, err := e.client.RunTask(&ecs.RunTaskInput{
+	in := &ecs.RunTaskInput{
 		Cluster:        aws.String(input.Cluster),
 		Count:          aws.Int64(int64(input.Count)),
 		LaunchType:     aws.String(ecs.LaunchTypeFargate),
@@ -245,7 +327,18 @@ func (e *ECS) RunTask(input RunTaskInput) ([]*Task, error) {
 				SecurityGroups: aws.StringSlice(input.SecurityGroups),
 			},
 		},
-	})
+	}
+	if input.Spot {
+		// A launch type and a capacity provider strategy are mutually exclusive.
+		in.LaunchType = nil
+		in.CapacityProviderStrategy = []*ecs.CapacityProviderStrategyItem{
+			{
+				CapacityProvider: aws.String(CapacityProviderFargateSpot),
+				Weight:           aws.Int64(1),
+			},
+		}
+	}
+	resp, err := e.client.RunTask(in)
 	if err != nil {
 		return nil, fmt.Errorf("run task(s) %s: %w", input.TaskFamilyName, err)
 	}
@@ -327,13 +420,14 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		})
 	}
 	return &TaskStatus{
-		Health:        aws.StringValue(t.HealthStatus),
-		ID:            taskID,
-		Images:        images,
-		LastStatus:    aws.StringValue(t.LastStatus),
-		StartedAt:     startedAt,
-		StoppedAt:     stoppedAt,
-		StoppedReason: stoppedReason,
+		Health:           aws.StringValue(t.HealthStatus),
+		ID:               taskID,
+		Images:           images,
+		LastStatus:       aws.StringValue(t.LastStatus),
+		StartedAt:        startedAt,
+		StoppedAt:        stoppedAt,
+		StoppedReason:    stoppedReason,
+		CapacityProvider: aws.StringValue(t.CapacityProviderName),
 	}, nil
 }
 
@@ -364,6 +458,20 @@ func (t *TaskDefinition) EnvironmentVariables() map[string]string {
 	return envs
 }
 
+// ContainerImage returns the image of the container with the given name in the task definition.
+// If there is no such container, returns the image of the first container.
+func (t *TaskDefinition) ContainerImage(containerName string) string {
+	for _, container := range t.ContainerDefinitions {
+		if aws.StringValue(container.Name) == containerName {
+			return aws.StringValue(container.Image)
+		}
+	}
+	if len(t.ContainerDefinitions) == 0 {
+		return ""
+	}
+	return aws.StringValue(t.ContainerDefinitions[0].Image)
+}
+
 // ServiceArn is the arn of an ECS service.
 type ServiceArn string
 
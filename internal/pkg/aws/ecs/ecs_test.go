@@ -328,6 +328,167 @@ func TestECS_Tasks(t *testing.T) {
 	}
 }
 
+func TestECS_ListTasks(t *testing.T) {
+	testCases := map[string]struct {
+		inOpts        []ListTasksOpts
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr   error
+		wantTasks []*Task
+	}{
+		"errors if failed to list tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster: aws.String("mockCluster"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("list tasks in cluster mockCluster: some error"),
+		},
+		"errors if failed to describe tasks": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(gomock.Any()).Return(&ecs.ListTasksOutput{
+					TaskArns: aws.StringSlice([]string{"mockTaskArn"}),
+				}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe tasks in cluster mockCluster: some error"),
+		},
+		"does not describe tasks if none match": {
+			inOpts: []ListTasksOpts{WithFamily("copilot-migrate")},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTasks(&ecs.ListTasksInput{
+					Cluster: aws.String("mockCluster"),
+					Family:  aws.String("copilot-migrate"),
+				}).Return(&ecs.ListTasksOutput{}, nil)
+				m.EXPECT().DescribeTasks(gomock.Any()).Times(0)
+			},
+		},
+		"success with filters and pagination": {
+			inOpts: []ListTasksOpts{WithFamily("copilot-migrate"), WithDesiredStatus(DesiredStatusStopped)},
+			mockECSClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListTasks(&ecs.ListTasksInput{
+						Cluster:       aws.String("mockCluster"),
+						Family:        aws.String("copilot-migrate"),
+						DesiredStatus: aws.String(DesiredStatusStopped),
+					}).Return(&ecs.ListTasksOutput{
+						NextToken: aws.String("next"),
+						TaskArns:  aws.StringSlice([]string{"mockTaskArn1"}),
+					}, nil),
+					m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+						Cluster: aws.String("mockCluster"),
+						Tasks:   aws.StringSlice([]string{"mockTaskArn1"}),
+					}).Return(&ecs.DescribeTasksOutput{
+						Tasks: []*ecs.Task{
+							{TaskArn: aws.String("mockTaskArn1")},
+						},
+					}, nil),
+					m.EXPECT().ListTasks(&ecs.ListTasksInput{
+						Cluster:       aws.String("mockCluster"),
+						Family:        aws.String("copilot-migrate"),
+						DesiredStatus: aws.String(DesiredStatusStopped),
+						NextToken:     aws.String("next"),
+					}).Return(&ecs.ListTasksOutput{
+						TaskArns: aws.StringSlice([]string{"mockTaskArn2"}),
+					}, nil),
+					m.EXPECT().DescribeTasks(&ecs.DescribeTasksInput{
+						Cluster: aws.String("mockCluster"),
+						Tasks:   aws.StringSlice([]string{"mockTaskArn2"}),
+					}).Return(&ecs.DescribeTasksOutput{
+						Tasks: []*ecs.Task{
+							{TaskArn: aws.String("mockTaskArn2")},
+						},
+					}, nil),
+				)
+			},
+			wantTasks: []*Task{
+				{TaskArn: aws.String("mockTaskArn1")},
+				{TaskArn: aws.String("mockTaskArn2")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			tasks, err := ecs.ListTasks("mockCluster", tc.inOpts...)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantTasks, tasks)
+			}
+		})
+	}
+}
+
+func TestECS_StopTasks(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+	}{
+		"errors if failed to stop a task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StopTask(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("stop task mockTaskArn1: some error"),
+		},
+		"stops every task": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StopTask(&ecs.StopTaskInput{
+					Cluster: aws.String("mockCluster"),
+					Task:    aws.String("mockTaskArn1"),
+					Reason:  aws.String("some reason"),
+				}).Return(&ecs.StopTaskOutput{}, nil)
+				m.EXPECT().StopTask(&ecs.StopTaskInput{
+					Cluster: aws.String("mockCluster"),
+					Task:    aws.String("mockTaskArn2"),
+					Reason:  aws.String("some reason"),
+				}).Return(&ecs.StopTaskOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			ecs := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			err := ecs.StopTasks("mockCluster", []string{"mockTaskArn1", "mockTaskArn2"}, "some reason")
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestECS_DefaultCluster(t *testing.T) {
 	testCases := map[string]struct {
 		mockECSClient func(m *mocks.Mockapi)
@@ -454,6 +615,7 @@ func TestECS_RunTask(t *testing.T) {
 		securityGroups []string
 		taskFamilyName string
 		startedBy      string
+		spot           bool
 	}
 
 	runTaskInput := input{
@@ -537,6 +699,58 @@ func TestECS_RunTask(t *testing.T) {
 				},
 			},
 		},
+		"run task on Fargate Spot": {
+			input: input{
+				cluster:        "my-cluster",
+				count:          1,
+				subnets:        []string{"subnet-1"},
+				taskFamilyName: "my-task",
+				startedBy:      "task",
+				spot:           true,
+			},
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RunTask(&ecs.RunTaskInput{
+					Cluster: aws.String("my-cluster"),
+					Count:   aws.Int64(1),
+					CapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{
+						{
+							CapacityProvider: aws.String("FARGATE_SPOT"),
+							Weight:           aws.Int64(1),
+						},
+					},
+					StartedBy:      aws.String("task"),
+					TaskDefinition: aws.String("my-task"),
+					NetworkConfiguration: &ecs.NetworkConfiguration{
+						AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+							AssignPublicIp: aws.String(ecs.AssignPublicIpEnabled),
+							Subnets:        aws.StringSlice([]string{"subnet-1"}),
+							SecurityGroups: aws.StringSlice(nil),
+						},
+					},
+				}).Return(&ecs.RunTaskOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn: aws.String("task-1"),
+						},
+					},
+				}, nil)
+				m.EXPECT().WaitUntilTasksRunning(gomock.Any()).Times(1)
+				m.EXPECT().DescribeTasks(gomock.Any()).Return(&ecs.DescribeTasksOutput{
+					Tasks: []*ecs.Task{
+						{
+							TaskArn:              aws.String("task-1"),
+							CapacityProviderName: aws.String("FARGATE_SPOT"),
+						},
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskArn:              aws.String("task-1"),
+					CapacityProviderName: aws.String("FARGATE_SPOT"),
+				},
+			},
+		},
 		"run task failed": {
 			input: runTaskInput,
 
@@ -581,6 +795,7 @@ func TestECS_RunTask(t *testing.T) {
 				Subnets:        tc.subnets,
 				SecurityGroups: tc.securityGroups,
 				StartedBy:      tc.startedBy,
+				Spot:           tc.spot,
 			})
 
 			if tc.wantedError != nil {
@@ -712,18 +927,68 @@ func TestTaskDefinition_EnvVars(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_ContainerImage(t *testing.T) {
+	testCases := map[string]struct {
+		inContainers []*ecs.ContainerDefinition
+
+		wantImage string
+	}{
+		"should return the image of the container with the given name": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name:  aws.String("firelens_log_router"),
+					Image: aws.String("amazon/aws-for-fluent-bit"),
+				},
+				{
+					Name:  aws.String("api"),
+					Image: aws.String("1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1"),
+				},
+			},
+
+			wantImage: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+		},
+		"should fall back to the image of the first container": {
+			inContainers: []*ecs.ContainerDefinition{
+				{
+					Name:  aws.String("web"),
+					Image: aws.String("nginx"),
+				},
+			},
+
+			wantImage: "nginx",
+		},
+		"should return empty string if there are no containers": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				ContainerDefinitions: tc.inContainers,
+			}
+
+			// WHEN
+			got := taskDefinition.ContainerImage("api")
+
+			// THEN
+			require.Equal(t, tc.wantImage, got)
+		})
+	}
+}
+
 func TestTask_TaskStatus(t *testing.T) {
 	startTime, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05+00:00")
 	stopTime, _ := time.Parse(time.RFC3339, "2006-01-02T16:04:05+00:00")
 	mockImageDigest := "18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
 	testCases := map[string]struct {
-		health        *string
-		taskArn       *string
-		containers    []*ecs.Container
-		lastStatus    *string
-		startedAt     time.Time
-		stoppedAt     time.Time
-		stoppedReason *string
+		health           *string
+		taskArn          *string
+		containers       []*ecs.Container
+		lastStatus       *string
+		startedAt        time.Time
+		stoppedAt        time.Time
+		stoppedReason    *string
+		capacityProvider *string
 
 		wantTaskStatus *TaskStatus
 		wantErr        error
@@ -809,6 +1074,21 @@ func TestTask_TaskStatus(t *testing.T) {
 				StoppedReason: "some reason",
 			},
 		},
+		"success with a task on Fargate Spot": {
+			taskArn:          aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+			health:           aws.String("HEALTHY"),
+			lastStatus:       aws.String("RUNNING"),
+			startedAt:        startTime,
+			capacityProvider: aws.String("FARGATE_SPOT"),
+
+			wantTaskStatus: &TaskStatus{
+				Health:           "HEALTHY",
+				ID:               "4082490ee6c245e09d2145010aa1ba8d",
+				LastStatus:       "RUNNING",
+				StartedAt:        startTime,
+				CapacityProvider: "FARGATE_SPOT",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -818,13 +1098,14 @@ func TestTask_TaskStatus(t *testing.T) {
 			defer ctrl.Finish()
 
 			task := Task{
-				HealthStatus:  tc.health,
-				TaskArn:       tc.taskArn,
-				Containers:    tc.containers,
-				LastStatus:    tc.lastStatus,
-				StartedAt:     &tc.startedAt,
-				StoppedAt:     &tc.stoppedAt,
-				StoppedReason: tc.stoppedReason,
+				HealthStatus:         tc.health,
+				TaskArn:              tc.taskArn,
+				Containers:           tc.containers,
+				LastStatus:           tc.lastStatus,
+				StartedAt:            &tc.startedAt,
+				StoppedAt:            &tc.stoppedAt,
+				StoppedReason:        tc.stoppedReason,
+				CapacityProviderName: tc.capacityProvider,
 			}
 
 			gotTaskStatus, gotErr := task.TaskStatus()
@@ -851,6 +1132,8 @@ func TestTaskStatus_HumanString(t *testing.T) {
 		startedAt   time.Time
 		stoppedAt   time.Time
 
+		capacityProvider string
+
 		wantTaskStatus string
 	}{
 		"all params": {
@@ -861,13 +1144,20 @@ func TestTaskStatus_HumanString(t *testing.T) {
 			stoppedAt:   stopTime,
 			imageDigest: mockImageDigest,
 
-			wantTaskStatus: "  aslhfnqo\t18f7eb6c\tRUNNING\t14 years ago\t14 years ago\tHEALTHY\n",
+			wantTaskStatus: "  aslhfnqo\t18f7eb6c\tRUNNING\t14 years ago\t14 years ago\tHEALTHY\t-\n",
 		},
 		"missing params": {
 			health:     "HEALTHY",
 			lastStatus: "RUNNING",
 
-			wantTaskStatus: "  -\t-\tRUNNING\t-\t-\tHEALTHY\n",
+			wantTaskStatus: "  -\t-\tRUNNING\t-\t-\tHEALTHY\t-\n",
+		},
+		"task on Fargate Spot": {
+			health:           "HEALTHY",
+			lastStatus:       "RUNNING",
+			capacityProvider: "FARGATE_SPOT",
+
+			wantTaskStatus: "  -\t-\tRUNNING\t-\t-\tHEALTHY\tFARGATE_SPOT\n",
 		},
 	}
 
@@ -885,9 +1175,10 @@ func TestTaskStatus_HumanString(t *testing.T) {
 						Digest: tc.imageDigest,
 					},
 				},
-				LastStatus: tc.lastStatus,
-				StartedAt:  tc.startedAt,
-				StoppedAt:  tc.stoppedAt,
+				LastStatus:       tc.lastStatus,
+				StartedAt:        tc.startedAt,
+				StoppedAt:        tc.stoppedAt,
+				CapacityProvider: tc.capacityProvider,
 			}
 
 			gotTaskStatus := task.HumanString()
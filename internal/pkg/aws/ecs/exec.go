@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	executeCommandOpName = "ExecuteCommand"
+
+	fmtSSMTarget = "ecs:%s_%s_%s"
+)
+
+// execAPI is implemented by execClient.
+// The version of the SDK that we depend on predates the ECS ExecuteCommand API, so the operation is defined by hand.
+type execAPI interface {
+	executeCommand(input *executeCommandInput) (*executeCommandOutput, error)
+}
+
+type executeCommandInput struct {
+	_ struct{} `type:"structure"`
+
+	Cluster     *string `locationName:"cluster" type:"string"`
+	Command     *string `locationName:"command" type:"string" required:"true"`
+	Container   *string `locationName:"container" type:"string"`
+	Interactive *bool   `locationName:"interactive" type:"boolean" required:"true"`
+	Task        *string `locationName:"task" type:"string" required:"true"`
+}
+
+type executeCommandOutput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterArn    *string  `locationName:"clusterArn" type:"string"`
+	ContainerName *string  `locationName:"containerName" type:"string"`
+	Interactive   *bool    `locationName:"interactive" type:"boolean"`
+	Session       *Session `locationName:"session" type:"structure"`
+	TaskArn       *string  `locationName:"taskArn" type:"string"`
+}
+
+// Session holds the Session Manager session that's started when executing a command in a container.
+// It is marshaled to JSON as expected by the session-manager-plugin.
+type Session struct {
+	_ struct{} `type:"structure"`
+
+	SessionID  *string `json:"SessionId" locationName:"sessionId" type:"string"`
+	StreamURL  *string `json:"StreamUrl" locationName:"streamUrl" type:"string"`
+	TokenValue *string `json:"TokenValue" locationName:"tokenValue" type:"string" sensitive:"true"`
+}
+
+// execClient sends ExecuteCommand requests with the ECS client's handlers.
+type execClient struct {
+	*ecs.ECS
+}
+
+func (c *execClient) executeCommand(input *executeCommandInput) (*executeCommandOutput, error) {
+	op := &request.Operation{
+		Name:       executeCommandOpName,
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}
+	output := &executeCommandOutput{}
+	req := c.NewRequest(op, input, output)
+	return output, req.Send()
+}
+
+// ExecuteCommandInput holds the fields needed to run a command in a container of a task.
+type ExecuteCommandInput struct {
+	Cluster   string
+	Command   string
+	Task      string
+	Container string
+}
+
+// ExecuteCommand starts an interactive Session Manager session that runs the command in the container of a task.
+func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (*Session, error) {
+	resp, err := e.execClient.executeCommand(&executeCommandInput{
+		Cluster:     aws.String(in.Cluster),
+		Command:     aws.String(in.Command),
+		Container:   aws.String(in.Container),
+		Interactive: aws.Bool(true),
+		Task:        aws.String(in.Task),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute command %s in container %s: %w", in.Command, in.Container, err)
+	}
+	return resp.Session, nil
+}
+
+// SSMTarget returns the Session Manager target of the container with the given name in the task.
+// For example: ecs:my-project-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879
+func (t *Task) SSMTarget(containerName string) (string, error) {
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	clusterARN, err := arn.Parse(aws.StringValue(t.ClusterArn))
+	if err != nil {
+		return "", fmt.Errorf("parse ECS cluster ARN: %w", err)
+	}
+	clusterName := strings.TrimPrefix(clusterARN.Resource, "cluster/")
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		if container.RuntimeId == nil {
+			return "", fmt.Errorf("container %s in task %s is not running", containerName, taskID)
+		}
+		return fmt.Sprintf(fmtSSMTarget, clusterName, taskID, aws.StringValue(container.RuntimeId)), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, taskID)
+}
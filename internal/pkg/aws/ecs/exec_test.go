@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExecAPI struct {
+	t *testing.T
+
+	wantedInput *executeCommandInput
+	output      *executeCommandOutput
+	err         error
+}
+
+func (m *mockExecAPI) executeCommand(input *executeCommandInput) (*executeCommandOutput, error) {
+	require.Equal(m.t, m.wantedInput, input)
+	return m.output, m.err
+}
+
+func TestECS_ExecuteCommand(t *testing.T) {
+	mockError := errors.New("some error")
+	mockInput := &executeCommandInput{
+		Cluster:     aws.String("mockCluster"),
+		Command:     aws.String("/bin/sh"),
+		Container:   aws.String("api"),
+		Interactive: aws.Bool(true),
+		Task:        aws.String("mockTask"),
+	}
+	testCases := map[string]struct {
+		output *executeCommandOutput
+		err    error
+
+		wantedSession *Session
+		wantedErr     error
+	}{
+		"returns wrapped error if fail to execute command": {
+			err:       mockError,
+			wantedErr: fmt.Errorf("execute command /bin/sh in container api: %w", mockError),
+		},
+		"returns the session": {
+			output: &executeCommandOutput{
+				Session: &Session{
+					SessionID:  aws.String("mockSessionID"),
+					StreamURL:  aws.String("mockStreamURL"),
+					TokenValue: aws.String("mockToken"),
+				},
+			},
+			wantedSession: &Session{
+				SessionID:  aws.String("mockSessionID"),
+				StreamURL:  aws.String("mockStreamURL"),
+				TokenValue: aws.String("mockToken"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			service := ECS{
+				execClient: &mockExecAPI{
+					t:           t,
+					wantedInput: mockInput,
+					output:      tc.output,
+					err:         tc.err,
+				},
+			}
+
+			// WHEN
+			session, err := service.ExecuteCommand(ExecuteCommandInput{
+				Cluster:   "mockCluster",
+				Command:   "/bin/sh",
+				Task:      "mockTask",
+				Container: "api",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSession, session)
+		})
+	}
+}
+
+func TestTask_SSMTarget(t *testing.T) {
+	testCases := map[string]struct {
+		containers []*ecs.Container
+
+		wantedTarget string
+		wantedErr    error
+	}{
+		"returns error if the container doesn't exist": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("firelens_log_router"),
+				},
+			},
+			wantedErr: errors.New("container api not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"returns error if the container isn't running yet": {
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("api"),
+				},
+			},
+			wantedErr: errors.New("container api in task 4082490ee6c245e09d2145010aa1ba8d is not running"),
+		},
+		"returns the target of the container": {
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("firelens_log_router"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1111111111"),
+				},
+				{
+					Name:      aws.String("api"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2531612879"),
+				},
+			},
+			wantedTarget: "ecs:my-project-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			task := Task{
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/my-project-test-Cluster-9F7Y0RLP60R7"),
+				TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+				Containers: tc.containers,
+			}
+
+			// WHEN
+			target, err := task.SSMTarget("api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTarget, target)
+		})
+	}
+}
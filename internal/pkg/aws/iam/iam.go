@@ -0,0 +1,49 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package iam provides a client to make API requests to AWS Identity and Access Management.
+package iam
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+)
+
+type api interface {
+	SimulatePrincipalPolicyPages(input *iam.SimulatePrincipalPolicyInput, fn func(*iam.SimulatePolicyResponse, bool) bool) error
+}
+
+// IAM wraps the AWS IAM client.
+type IAM struct {
+	client api
+}
+
+// New returns an IAM configured against the input session.
+func New(s *session.Session) *IAM {
+	return &IAM{
+		client: iam.New(s),
+	}
+}
+
+// DeniedActions simulates the policies attached to the principal and returns the actions that it is not allowed to perform.
+func (c *IAM) DeniedActions(principalARN string, actions []string) ([]string, error) {
+	var denied []string
+	err := c.client.SimulatePrincipalPolicyPages(&iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalARN),
+		ActionNames:     aws.StringSlice(actions),
+	}, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		for _, result := range page.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.StringValue(result.EvalActionName))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("simulate policies of %s: %w", principalARN, err)
+	}
+	return denied, nil
+}
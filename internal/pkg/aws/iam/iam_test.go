@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIAM_DeniedActions(t *testing.T) {
+	mockError := errors.New("some error")
+	mockRoleARN := "arn:aws:iam::123456789:role/phonetool-test-api-TaskRole"
+	mockActions := []string{"ssmmessages:CreateControlChannel", "ssmmessages:OpenControlChannel"}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedDenied []string
+		wantedErr    error
+	}{
+		"returns wrapped error if fail to simulate the policies": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().SimulatePrincipalPolicyPages(gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("simulate policies of %s: %w", mockRoleARN, mockError),
+		},
+		"returns the actions that are not allowed": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().SimulatePrincipalPolicyPages(&iam.SimulatePrincipalPolicyInput{
+					PolicySourceArn: aws.String(mockRoleARN),
+					ActionNames:     aws.StringSlice(mockActions),
+				}, gomock.Any()).DoAndReturn(func(_ *iam.SimulatePrincipalPolicyInput, fn func(*iam.SimulatePolicyResponse, bool) bool) error {
+					fn(&iam.SimulatePolicyResponse{
+						EvaluationResults: []*iam.EvaluationResult{
+							{
+								EvalActionName: aws.String("ssmmessages:CreateControlChannel"),
+								EvalDecision:   aws.String(iam.PolicyEvaluationDecisionTypeAllowed),
+							},
+							{
+								EvalActionName: aws.String("ssmmessages:OpenControlChannel"),
+								EvalDecision:   aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny),
+							},
+						},
+					}, true)
+					return nil
+				})
+			},
+			wantedDenied: []string{"ssmmessages:OpenControlChannel"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := IAM{
+				client: mockClient,
+			}
+
+			// WHEN
+			denied, err := client.DeniedActions(mockRoleARN, mockActions)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDenied, denied)
+		})
+	}
+}
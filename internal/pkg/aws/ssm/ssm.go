@@ -0,0 +1,41 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ssm provides a client to make API requests to AWS Systems Manager Parameter Store.
+package ssm
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+type api interface {
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+}
+
+// SSM wraps an AWS Systems Manager client.
+type SSM struct {
+	client api
+}
+
+// New returns a SSM configured against the input session.
+func New(s *session.Session) *SSM {
+	return &SSM{
+		client: ssm.New(s),
+	}
+}
+
+// GetSecret returns the decrypted value of the parameter with the input name or ARN.
+func (s *SSM) GetSecret(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
@@ -0,0 +1,71 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssm
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSM_GetSecret(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedValue string
+		wantedErr   error
+	}{
+		"returns wrapped error if fail to get the parameter": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("get parameter GITHUB_TOKEN: %w", mockError),
+		},
+		"returns the decrypted value of the parameter": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("GITHUB_TOKEN"),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("secret"),
+					},
+				}, nil)
+			},
+			wantedValue: "secret",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			value, err := client.GetSecret("GITHUB_TOKEN")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedValue, value)
+		})
+	}
+}
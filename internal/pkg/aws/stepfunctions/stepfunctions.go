@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package stepfunctions provides a client to make API requests to AWS Step Functions.
+package stepfunctions
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sfn"
+)
+
+type api interface {
+	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
+	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+}
+
+// StepFunctions wraps an AWS Step Functions client.
+type StepFunctions struct {
+	client api
+}
+
+// New returns a StepFunctions configured against the input session.
+func New(s *session.Session) *StepFunctions {
+	return &StepFunctions{
+		client: sfn.New(s),
+	}
+}
+
+// StateMachineDefinition returns the JSON definition of the state machine in the Amazon States Language.
+func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string, error) {
+	out, err := s.client.DescribeStateMachine(&sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe state machine %s: %w", stateMachineARN, err)
+	}
+	return aws.StringValue(out.Definition), nil
+}
+
+// Execute starts an execution of the state machine and returns the ARN of the execution.
+func (s *StepFunctions) Execute(stateMachineARN string) (string, error) {
+	out, err := s.client.StartExecution(&sfn.StartExecutionInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of state machine %s: %w", stateMachineARN, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
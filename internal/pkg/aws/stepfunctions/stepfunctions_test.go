@@ -0,0 +1,122 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stepfunctions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockStateMachineARN = "arn:aws:states:us-west-2:123456789:stateMachine:phonetool-test-report-StateMachine"
+
+func TestStepFunctions_StateMachineDefinition(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedDefinition string
+		wantedErr        error
+	}{
+		"returns wrapped error if fail to describe the state machine": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeStateMachine(gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("describe state machine %s: %w", mockStateMachineARN, mockError),
+		},
+		"returns the definition of the state machine": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeStateMachine(&sfn.DescribeStateMachineInput{
+					StateMachineArn: aws.String(mockStateMachineARN),
+				}).Return(&sfn.DescribeStateMachineOutput{
+					Definition: aws.String(`{"StartAt": "Run Fargate Task"}`),
+				}, nil)
+			},
+			wantedDefinition: `{"StartAt": "Run Fargate Task"}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			sfn := StepFunctions{
+				client: mockClient,
+			}
+
+			// WHEN
+			definition, err := sfn.StateMachineDefinition(mockStateMachineARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDefinition, definition)
+		})
+	}
+}
+
+func TestStepFunctions_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedExecutionARN string
+		wantedErr          error
+	}{
+		"returns wrapped error if fail to start the execution": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(gomock.Any()).Return(nil, mockError)
+			},
+			wantedErr: fmt.Errorf("start execution of state machine %s: %w", mockStateMachineARN, mockError),
+		},
+		"returns the ARN of the execution": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String(mockStateMachineARN),
+				}).Return(&sfn.StartExecutionOutput{
+					ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789:execution:phonetool-test-report-StateMachine:1234"),
+				}, nil)
+			},
+			wantedExecutionARN: "arn:aws:states:us-west-2:123456789:execution:phonetool-test-report-StateMachine:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(mockClient)
+			sfn := StepFunctions{
+				client: mockClient,
+			}
+
+			// WHEN
+			executionARN, err := sfn.Execute(mockStateMachineARN)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExecutionARN, executionARN)
+		})
+	}
+}
@@ -36,6 +36,8 @@ const (
 	fmtDeleteEnvStart    = "Deleting environment %s from application %s."
 	fmtDeleteEnvFailed   = "Failed to delete environment %s from application %s: %v."
 	fmtDeleteEnvComplete = "Deleted environment %s from application %s."
+
+	fmtDeleteEnvAccessLogsBucketRetained = "The access logs of environment %s are kept in the S3 bucket %s.\n"
 )
 
 var (
@@ -142,11 +144,19 @@ func (o *deleteEnvOpts) Execute() error {
 	if err := o.validateNoRunningServices(); err != nil {
 		return err
 	}
+	// The bucket can only be found while the stack exists.
+	bucket, err := o.deployClient.ManagedELBAccessLogsBucket(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get access logs bucket of environment %s: %w", o.name, err)
+	}
 
 	isStackDeleted := o.deleteStack()
 	if isStackDeleted { // TODO Add a --force flag that attempts to remove from SSM regardless.
 		// Only remove from SSM if the stack and roles were deleted. Otherwise, the command will error when re-run.
 		o.deleteFromStore()
+		if bucket != "" {
+			log.Infof(fmtDeleteEnvAccessLogsBucketRetained, o.name, color.HighlightResource(bucket))
+		}
 	}
 	return nil
 }
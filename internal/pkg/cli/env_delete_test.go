@@ -292,6 +292,7 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 			},
 			mockDeploy: func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer {
 				deploy := mocks.NewMockenvironmentDeployer(ctrl)
+				deploy.EXPECT().ManagedELBAccessLogsBucket(testApp, testEnv).Return("", nil)
 				deploy.EXPECT().DeleteEnvironment(testApp, testEnv).Return(testError)
 				return deploy
 			},
@@ -299,6 +300,53 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 				return mocks.NewMockenvironmentStore(ctrl)
 			},
 		},
+		"error if fail to get the access logs bucket": {
+			mockRG: func(ctrl *gomock.Controller) *mocks.MockresourceGetter {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+				return rg
+			},
+			mockProg: func(ctrl *gomock.Controller) *mocks.Mockprogress {
+				return nil
+			},
+			mockDeploy: func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer {
+				deploy := mocks.NewMockenvironmentDeployer(ctrl)
+				deploy.EXPECT().ManagedELBAccessLogsBucket(testApp, testEnv).Return("", testError)
+				return deploy
+			},
+			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
+				return nil
+			},
+			wantedError: errors.New("get access logs bucket of environment test: some error"),
+		},
+		"keeps the access logs bucket when deleting the stack": {
+			mockRG: func(ctrl *gomock.Controller) *mocks.MockresourceGetter {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+				return rg
+			},
+			mockProg: func(ctrl *gomock.Controller) *mocks.Mockprogress {
+				prog := mocks.NewMockprogress(ctrl)
+				prog.EXPECT().Start(fmt.Sprintf(fmtDeleteEnvStart, testEnv, testApp))
+				prog.EXPECT().Stop(log.Ssuccessf(fmtDeleteEnvComplete, testEnv, testApp))
+				return prog
+			},
+			mockDeploy: func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer {
+				deploy := mocks.NewMockenvironmentDeployer(ctrl)
+				gomock.InOrder(
+					deploy.EXPECT().ManagedELBAccessLogsBucket(testApp, testEnv).Return("phonetool-test-logs", nil),
+					deploy.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil),
+				)
+				return deploy
+			},
+			mockStore: func(ctrl *gomock.Controller) *mocks.MockenvironmentStore {
+				store := mocks.NewMockenvironmentStore(ctrl)
+				store.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
+				return store
+			},
+		},
 		"deletes from store if stack deletion succeeds": {
 			mockRG: func(ctrl *gomock.Controller) *mocks.MockresourceGetter {
 				rg := mocks.NewMockresourceGetter(ctrl)
@@ -314,6 +362,7 @@ func TestDeleteEnvOpts_Execute(t *testing.T) {
 			},
 			mockDeploy: func(ctrl *gomock.Controller) *mocks.MockenvironmentDeployer {
 				deploy := mocks.NewMockenvironmentDeployer(ctrl)
+				deploy.EXPECT().ManagedELBAccessLogsBucket(testApp, testEnv).Return("", nil)
 				deploy.EXPECT().DeleteEnvironment(testApp, testEnv).Return(nil)
 				return deploy
 			},
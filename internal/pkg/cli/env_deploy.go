@@ -0,0 +1,364 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDeployAppNamePrompt = "In which application is your environment?"
+	envDeployNamePrompt    = "Which environment do you want to deploy?"
+	envDeployNameHelp      = `Updates the environment's infrastructure with the configuration
+in copilot/environments/{name}/manifest.yml.`
+
+	fmtEnvDeployStart    = "Deploying the infrastructure changes for the %s environment."
+	fmtEnvDeployNoChange = "No changes to deploy for environment %s.\n"
+	fmtEnvDeployFailed   = "Failed to deploy the infrastructure changes for the %s environment.\n"
+	fmtEnvDeployComplete = "Deployed the infrastructure changes for the %s environment.\n"
+)
+
+type deployEnvVars struct {
+	appName string
+	name    string
+}
+
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store       store
+	deployStore deployedEnvironmentLister
+	ws          wsEnvironmentReader
+	sel         appEnvSelector
+	identity    identityService
+	prog        progress
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overriden in tests to provide mocks.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newEnvUpgrader      func(env *config.Environment) (envTemplateUpgrader, error)
+}
+
+func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := sessions.NewProvider()
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+
+		store:       store,
+		deployStore: deployStore,
+		ws:          ws,
+		sel:         selector.NewSelect(prompt.New(), store),
+		identity:    identity.New(defaultSession),
+		prog:        termprogress.NewSpinner(),
+
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %w", env, app, err)
+			}
+			return d, nil
+		},
+		newEnvUpgrader: func(env *config.Environment) (envTemplateUpgrader, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return deploycfn.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	return nil
+}
+
+// Ask prompts for any required flags that are not set by the user.
+func (o *deployEnvOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(envDeployAppNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		env, err := o.sel.Environment(envDeployNamePrompt, envDeployNameHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.name = env
+	}
+	return nil
+}
+
+// Execute updates the environment stack with the configuration in the environment's manifest.
+func (o *deployEnvOpts) Execute() error {
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return err
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal environment %s manifest: %w", o.name, err)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	in, err := o.deployInput(env, mft)
+	if err != nil {
+		return err
+	}
+	upgrader, err := o.newEnvUpgrader(env)
+	if err != nil {
+		return err
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtEnvDeployStart, color.HighlightUserInput(o.name)))
+	if err := o.deploy(upgrader, in); err != nil {
+		var errChangeSetEmpty *awscloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			o.prog.Stop(log.Serrorf(fmtEnvDeployFailed, color.HighlightUserInput(o.name)))
+			return fmt.Errorf("deploy environment %s: %w", o.name, err)
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployNoChange, color.HighlightUserInput(o.name)))
+	} else {
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployComplete, color.HighlightUserInput(o.name)))
+	}
+	return o.updateCustomConfig(env, in)
+}
+
+// updateCustomConfig stores the VPC, certificates and features deployed with the manifest in the environment's configuration,
+// so that load balanced web services know whether they can serve HTTPS traffic and later deployments start from them.
+func (o *deployEnvOpts) updateCustomConfig(env *config.Environment, in *deploy.CreateEnvironmentInput) error {
+	conf := &config.CustomizeEnv{
+		ImportVPC:               in.ImportVPCConfig,
+		VPCConfig:               in.AdjustVPCConfig,
+		VPCEndpoints:            in.VPCEndpoints,
+		ImportCertARNs:          in.ImportCertARNs,
+		NATGateways:             in.NATGateways,
+		InternalALB:             in.InternalALB,
+		WebACLARN:               in.WebACLARN,
+		EnableContainerInsights: in.EnableContainerInsights,
+	}
+	if in.ELBAccessLogs != nil {
+		conf.ELBAccessLogs = &config.ELBAccessLogs{
+			BucketName: in.ELBAccessLogs.BucketName,
+			Prefix:     in.ELBAccessLogs.Prefix,
+			Retention:  in.ELBAccessLogs.Retention,
+		}
+	}
+	if reflect.DeepEqual(conf, &config.CustomizeEnv{}) {
+		conf = nil
+	}
+	if reflect.DeepEqual(env.CustomConfig, conf) {
+		return nil
+	}
+	env.CustomConfig = conf
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("update environment %s configuration: %w", o.name, err)
+	}
+	return nil
+}
+
+// deployInput returns the input to render the latest environment template.
+// The configuration stored by "env init" or a previous deployment is the base, and the fields set in the manifest override it.
+// The features that only the manifest configures are taken from it as is, or from the stored configuration if mft is nil.
+func (o *deployEnvOpts) deployInput(env *config.Environment, mft *manifest.Environment) (*deploy.CreateEnvironmentInput, error) {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get identity: %w", err)
+	}
+	conf := config.CustomizeEnv{}
+	if env.CustomConfig != nil {
+		conf = *env.CustomConfig
+	}
+	if mft != nil {
+		applyEnvManifest(&conf, mft)
+	}
+	var accessLogs *template.ELBAccessLogsOpts
+	if conf.ELBAccessLogs != nil {
+		accessLogs = &template.ELBAccessLogsOpts{
+			BucketName: conf.ELBAccessLogs.BucketName,
+			Prefix:     conf.ELBAccessLogs.Prefix,
+			Retention:  conf.ELBAccessLogs.Retention,
+		}
+	}
+	return &deploy.CreateEnvironmentInput{
+		AppName:                  o.appName,
+		Name:                     o.name,
+		Prod:                     env.Prod,
+		ToolsAccountPrincipalARN: caller.RootUserARN,
+		AppDNSName:               app.Domain,
+		AdditionalTags:           app.Tags,
+		ImportVPCConfig:          conf.ImportVPC,
+		AdjustVPCConfig:          conf.VPCConfig,
+		ImportCertARNs:           conf.ImportCertARNs,
+		WebACLARN:                conf.WebACLARN,
+		ELBAccessLogs:            accessLogs,
+		InternalALB:              conf.InternalALB,
+		EnableContainerInsights:  conf.EnableContainerInsights,
+		NATGateways:              conf.NATGateways,
+		VPCEndpoints:             conf.VPCEndpoints,
+		Version:                  deploy.LatestEnvTemplateVersion,
+	}, nil
+}
+
+// applyEnvManifest overrides the configuration of the environment with its manifest.
+func applyEnvManifest(conf *config.CustomizeEnv, mft *manifest.Environment) {
+	if vpc := mft.Network.VPC.ImportedVPC(); vpc != nil {
+		conf.ImportVPC, conf.VPCConfig = vpc, nil
+	}
+	if vpc := mft.Network.VPC.ManagedVPC(); vpc != nil {
+		conf.ImportVPC, conf.VPCConfig = nil, vpc
+	}
+	if mft.Network.VPC.Endpoints != nil {
+		conf.VPCEndpoints = aws.BoolValue(mft.Network.VPC.Endpoints)
+	}
+	if len(mft.HTTPConfig.Public.Certificates) != 0 {
+		conf.ImportCertARNs = mft.HTTPConfig.Public.Certificates
+	}
+	// Only the manifest configures these features, so they are turned off when the manifest doesn't set them.
+	conf.NATGateways = aws.StringValue(mft.Network.VPC.NATGateways)
+	conf.InternalALB = aws.BoolValue(mft.HTTPConfig.Private.Enabled)
+	conf.WebACLARN = aws.StringValue(mft.HTTPConfig.Public.WebACL)
+	conf.EnableContainerInsights = aws.BoolValue(mft.Observability.ContainerInsights)
+	conf.ELBAccessLogs = nil
+	if opts := mft.HTTPConfig.Public.ELBAccessLogsOpts(); opts != nil {
+		conf.ELBAccessLogs = &config.ELBAccessLogs{
+			BucketName: opts.BucketName,
+			Prefix:     opts.Prefix,
+			Retention:  opts.Retention,
+		}
+	}
+}
+
+// deploy updates the environment stack.
+// Legacy environment stacks are upgraded to the latest template while keeping their load balancer.
+func (o *deployEnvOpts) deploy(upgrader envTemplateUpgrader, in *deploy.CreateEnvironmentInput) error {
+	versionGetter, err := o.newEnvVersionGetter(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	version, err := versionGetter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %w", o.name, o.appName, err)
+	}
+	if version != deploy.LegacyEnvTemplateVersion {
+		return upgrader.UpgradeEnvironment(in)
+	}
+	lbWebServices, err := o.deployedLBWebServices()
+	if err != nil {
+		return err
+	}
+	return upgrader.UpgradeLegacyEnvironment(in, lbWebServices...)
+}
+
+func (o *deployEnvOpts) deployedLBWebServices() ([]string, error) {
+	svcs, err := o.store.ListServices(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list services in application %s: %w", o.appName, err)
+	}
+	var names []string
+	for _, svc := range svcs {
+		if svc.Type != manifest.LoadBalancedWebServiceType {
+			continue
+		}
+		deployed, err := o.deployStore.IsServiceDeployed(o.appName, o.name, svc.Name)
+		if err != nil {
+			return nil, fmt.Errorf("check if service %s is deployed in environment %s: %w", svc.Name, o.name, err)
+		}
+		if deployed {
+			names = append(names, svc.Name)
+		}
+	}
+	return names, nil
+}
+
+// buildEnvDeployCmd builds the command to deploy the environment manifest.
+func buildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys the manifest of an environment.",
+		Long: `Deploys the manifest of an environment.
+Updates the environment's infrastructure with the configuration in copilot/environments/{name}/manifest.yml.`,
+		Example: `
+  Deploy the manifest of the "test" environment.
+  /code $ copilot env deploy --name test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,334 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDeployOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		mockSelector func(m *mocks.MockappEnvSelector)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"should prompt for the application and environment if not provided": {
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(envDeployAppNamePrompt, "").Return("phonetool", nil)
+				m.EXPECT().Environment(envDeployNamePrompt, envDeployNameHelp, "phonetool").Return("test", nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"should not prompt if flags are provided": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"should wrap error if fail to select environment": {
+			inAppName: "phonetool",
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockappEnvSelector(ctrl)
+			tc.mockSelector(mockSelector)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.name)
+		})
+	}
+}
+
+type deployEnvMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	ws          *mocks.MockwsEnvironmentReader
+	identity    *mocks.MockidentityService
+	prog        *mocks.Mockprogress
+	version     *mocks.MockversionGetter
+	upgrader    *mocks.MockenvTemplateUpgrader
+}
+
+func TestEnvDeployOpts_Execute(t *testing.T) {
+	const mockManifest = `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    subnets:
+      public:
+        - id: subnet-11111
+      private:
+        - id: subnet-22222
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/abc
+    web_acl: arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc
+    access_logs: true
+  private:
+    enabled: true
+observability:
+  container_insights: true
+`
+	mockEnv := func() *config.Environment {
+		return &config.Environment{
+			App:            "phonetool",
+			Name:           "test",
+			Region:         "us-west-2",
+			Prod:           true,
+			ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+		}
+	}
+	wantedEnv := mockEnv()
+	wantedEnv.CustomConfig = &config.CustomizeEnv{
+		ImportVPC: &config.ImportVPC{
+			ID:               "vpc-3f139646",
+			PublicSubnetIDs:  []string{"subnet-11111"},
+			PrivateSubnetIDs: []string{"subnet-22222"},
+		},
+		ImportCertARNs:          []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+		InternalALB:             true,
+		WebACLARN:               "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc",
+		EnableContainerInsights: true,
+		ELBAccessLogs: &config.ELBAccessLogs{
+			Retention: 30,
+		},
+	}
+	mockApp := &config.Application{
+		Name:   "phonetool",
+		Domain: "example.com",
+		Tags:   map[string]string{"owner": "boss"},
+	}
+	wantedInput := &deploy.CreateEnvironmentInput{
+		AppName:                  "phonetool",
+		Name:                     "test",
+		Prod:                     true,
+		ToolsAccountPrincipalARN: "arn:aws:iam::123456789012:root",
+		AppDNSName:               "example.com",
+		AdditionalTags:           map[string]string{"owner": "boss"},
+		ImportVPCConfig: &config.ImportVPC{
+			ID:               "vpc-3f139646",
+			PublicSubnetIDs:  []string{"subnet-11111"},
+			PrivateSubnetIDs: []string{"subnet-22222"},
+		},
+		ImportCertARNs:          []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+		WebACLARN:               "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc",
+		InternalALB:             true,
+		EnableContainerInsights: true,
+		Version:                 deploy.LatestEnvTemplateVersion,
+		ELBAccessLogs: &template.ELBAccessLogsOpts{
+			Retention: 30,
+		},
+	}
+	mockInputs := func(m deployEnvMocks) {
+		m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+		m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+		m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+		m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m deployEnvMocks)
+
+		wantedErr error
+	}{
+		"should return error if the manifest is invalid": {
+			setupMocks: func(m deployEnvMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: test\ntype: Backend Service\n"), nil)
+			},
+			wantedErr: fmt.Errorf("unmarshal environment test manifest: %w", &manifest.ErrInvalidWorkloadType{Type: "Backend Service"}),
+		},
+		"should update the stack with the manifest configuration": {
+			setupMocks: func(m deployEnvMocks) {
+				mockInputs(m)
+				m.version.EXPECT().Version().Return("v1.0.0", nil)
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtEnvDeployStart, "test")),
+					m.upgrader.EXPECT().UpgradeEnvironment(wantedInput).Return(nil),
+					m.prog.EXPECT().Stop(gomock.Any()),
+					m.store.EXPECT().UpdateEnvironment(wantedEnv).Return(nil),
+				)
+			},
+		},
+		"should keep the imported VPC and certificates of the environment if the manifest doesn't set them": {
+			setupMocks: func(m deployEnvMocks) {
+				env := mockEnv()
+				env.CustomConfig = &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "vpc-3f139646",
+						PublicSubnetIDs:  []string{"subnet-11111"},
+						PrivateSubnetIDs: []string{"subnet-22222"},
+					},
+					ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+				}
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: test\ntype: Environment\n"), nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+				m.version.EXPECT().Version().Return("v1.0.0", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					AppName:                  "phonetool",
+					Name:                     "test",
+					Prod:                     true,
+					ToolsAccountPrincipalARN: "arn:aws:iam::123456789012:root",
+					AppDNSName:               "example.com",
+					AdditionalTags:           map[string]string{"owner": "boss"},
+					ImportVPCConfig: &config.ImportVPC{
+						ID:               "vpc-3f139646",
+						PublicSubnetIDs:  []string{"subnet-11111"},
+						PrivateSubnetIDs: []string{"subnet-22222"},
+					},
+					ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+					Version:        deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Times(0)
+			},
+		},
+		"should keep the load balanced web services of a legacy environment": {
+			setupMocks: func(m deployEnvMocks) {
+				mockInputs(m)
+				m.version.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+				m.store.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "backend", Type: manifest.BackendServiceType},
+					{Name: "admin", Type: manifest.LoadBalancedWebServiceType},
+				}, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("phonetool", "test", "admin").Return(false, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeLegacyEnvironment(wantedInput, "frontend").Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(wantedEnv).Return(nil)
+			},
+		},
+		"should succeed if there are no changes to deploy": {
+			setupMocks: func(m deployEnvMocks) {
+				mockInputs(m)
+				m.version.EXPECT().Version().Return("v1.0.0", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Return(fmt.Errorf("update and wait for stack: %w", &awscloudformation.ErrChangeSetEmpty{}))
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(wantedEnv).Return(nil)
+			},
+		},
+		"should wrap error if fail to store the environment configuration": {
+			setupMocks: func(m deployEnvMocks) {
+				mockInputs(m)
+				m.version.EXPECT().Version().Return("v1.0.0", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("update environment test configuration: some error"),
+		},
+		"should wrap error if fail to update the stack": {
+			setupMocks: func(m deployEnvMocks) {
+				mockInputs(m)
+				m.version.EXPECT().Version().Return("v1.0.0", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.upgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("deploy environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := deployEnvMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				ws:          mocks.NewMockwsEnvironmentReader(ctrl),
+				identity:    mocks.NewMockidentityService(ctrl),
+				prog:        mocks.NewMockprogress(ctrl),
+				version:     mocks.NewMockversionGetter(ctrl),
+				upgrader:    mocks.NewMockenvTemplateUpgrader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				ws:          m.ws,
+				identity:    m.identity,
+				prog:        m.prog,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					return m.version, nil
+				},
+				newEnvUpgrader: func(env *config.Environment) (envTemplateUpgrader, error) {
+					return m.upgrader, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -76,6 +76,10 @@ var (
 	envInitAdjustEnvResourcesSelectOption = "Yes, but I'd like configure the default resources (CIDR ranges)."
 	envInitImportEnvResourcesSelectOption = "No, I'd like to import existing resources (VPC, subnets)."
 	envInitCustomizedEnvTypes             = []string{envInitDefaultConfigSelectOption, envInitAdjustEnvResourcesSelectOption, envInitImportEnvResourcesSelectOption}
+
+	// Services that tasks in private subnets need to reach without a NAT gateway.
+	// ECR pulls images from S3, so the S3 gateway endpoint is needed as well.
+	envVPCEndpointServices = []string{"ecr.api", "ecr.dkr", "logs", "ssm", "secretsmanager", "sts", "s3"}
 )
 
 type importVPCVars struct {
@@ -95,6 +99,9 @@ type adjustVPCVars struct {
 	CIDR               net.IPNet
 	PublicSubnetCIDRs  []string
 	PrivateSubnetCIDRs []string
+
+	AZCount int      // Number of availability zones of the VPC.
+	AZNames []string // Names of the availability zones of the subnets.
 }
 
 func (v adjustVPCVars) isSet() bool {
@@ -104,6 +111,23 @@ func (v adjustVPCVars) isSet() bool {
 	return len(v.PublicSubnetCIDRs) != 0 || len(v.PrivateSubnetCIDRs) != 0
 }
 
+func (v adjustVPCVars) hasAZs() bool {
+	return v.AZCount != 0 || len(v.AZNames) != 0
+}
+
+func (v adjustVPCVars) validate() error {
+	if v.AZCount == 0 {
+		return nil
+	}
+	if v.AZCount < config.MinAZCount || v.AZCount > config.MaxAZCount {
+		return fmt.Errorf("--%s must be between %d and %d", azCountFlag, config.MinAZCount, config.MaxAZCount)
+	}
+	if len(v.AZNames) != 0 && len(v.AZNames) != v.AZCount {
+		return fmt.Errorf("--%s %d does not match the %d zones in --%s", azCountFlag, v.AZCount, len(v.AZNames), azNamesFlag)
+	}
+	return nil
+}
+
 type tempCredsVars struct {
 	AccessKeyID     string
 	SecretAccessKey string
@@ -120,6 +144,9 @@ type initEnvVars struct {
 	profile       string // The named profile to use for credential retrieval. Mutually exclusive with tempCreds.
 	isProduction  bool   // True means retain resources even after deletion.
 	defaultConfig bool   // True means using default environment configuration.
+	vpcEndpoints  bool   // True means the tasks reach AWS services through VPC endpoints.
+
+	importCertARNs []string // ARNs of existing ACM certificates for the HTTPS listener of the public load balancer.
 
 	importVPC importVPCVars // Existing VPC resources to use instead of creating new ones.
 	adjustVPC adjustVPCVars // Configure parameters for VPC resources generated while initializing an environment.
@@ -192,6 +219,9 @@ func (o *initEnvOpts) Validate() error {
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
+	if err := validateCertARNs(o.importCertARNs); err != nil {
+		return err
+	}
 	return o.validateCredentials()
 }
 
@@ -206,7 +236,10 @@ func (o *initEnvOpts) Ask() error {
 	if err := o.askEnvRegion(); err != nil {
 		return err
 	}
-	return o.askCustomizedResources()
+	if err := o.askCustomizedResources(); err != nil {
+		return err
+	}
+	return o.validateAdjustedVPC()
 }
 
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
@@ -241,7 +274,7 @@ func (o *initEnvOpts) Execute() error {
 		return fmt.Errorf("get environment struct for %s: %w", o.name, err)
 	}
 	env.Prod = o.isProduction
-	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig())
+	env.CustomConfig = config.NewCustomizeEnv(o.importVPCConfig(), o.adjustVPCConfig(), o.vpcEndpoints, o.importCertARNs)
 
 	// 3. Add the stack set instance to the app stackset.
 	if err := o.addToStackset(app, env); err != nil {
@@ -263,12 +296,63 @@ func (o *initEnvOpts) RecommendedActions() []string {
 }
 
 func (o *initEnvOpts) validateCustomizedResources() error {
-	if o.importVPC.isSet() && o.adjustVPC.isSet() {
+	if o.importVPC.isSet() && (o.adjustVPC.isSet() || o.adjustVPC.hasAZs()) {
 		return errors.New("cannot specify both import vpc flags and configure vpc flags")
 	}
 	if (o.importVPC.isSet() || o.adjustVPC.isSet()) && o.defaultConfig {
 		return fmt.Errorf("cannot import or configure vpc if --%s is set", defaultConfigFlag)
 	}
+	return o.adjustVPC.validate()
+}
+
+// validateAdjustedVPC returns an error if the subnets of the VPC created by Copilot
+// don't fit in the VPC CIDR range or don't match the availability zones.
+func (o *initEnvOpts) validateAdjustedVPC() error {
+	conf := o.adjustVPCConfig()
+	if conf == nil {
+		return nil
+	}
+	if n := o.adjustVPC.AZCount; n != 0 && (len(conf.PublicSubnetCIDRs) != n || len(conf.PrivateSubnetCIDRs) != n) {
+		return fmt.Errorf("--%s %d requires %d public and %d private subnet CIDRs", azCountFlag, n, n, n)
+	}
+	if err := conf.Validate(); err != nil {
+		return fmt.Errorf("validate VPC configuration: %w", err)
+	}
+	return o.validateAZs(conf)
+}
+
+// validateAZs returns an error if the region of the environment doesn't have the availability zones of the VPC,
+// either the zones passed with --override-az-names or as many zones as subnets in each tier.
+func (o *initEnvOpts) validateAZs(conf *config.AdjustVPC) error {
+	if o.ec2Client == nil {
+		o.ec2Client = ec2.New(o.sess)
+	}
+	names, err := o.ec2Client.AvailabilityZoneNames()
+	if err != nil {
+		return fmt.Errorf("get availability zones: %w", err)
+	}
+	if len(o.adjustVPC.AZNames) == 0 {
+		// The template places the subnets of each tier in the first zones of the region.
+		n := len(conf.PublicSubnetCIDRs)
+		if len(conf.PrivateSubnetCIDRs) > n {
+			n = len(conf.PrivateSubnetCIDRs)
+		}
+		if n > len(names) {
+			return fmt.Errorf("the VPC needs %d availability zones but region %s only has %d: %s",
+				n, aws.StringValue(o.sess.Config.Region), len(names), strings.Join(names, ", "))
+		}
+		return nil
+	}
+	available := make(map[string]bool)
+	for _, name := range names {
+		available[name] = true
+	}
+	for _, name := range o.adjustVPC.AZNames {
+		if !available[name] {
+			return fmt.Errorf("availability zone %s in --%s is not available in region %s, choose from: %s",
+				name, azNamesFlag, aws.StringValue(o.sess.Config.Region), strings.Join(names, ", "))
+		}
+	}
 	return nil
 }
 
@@ -333,7 +417,7 @@ func (o *initEnvOpts) askCustomizedResources() error {
 	if o.importVPC.isSet() {
 		return o.askImportResources()
 	}
-	if o.adjustVPC.isSet() {
+	if o.adjustVPC.isSet() || o.adjustVPC.hasAZs() {
 		return o.askAdjustResources()
 	}
 	adjustOrImport, err := o.prompt.SelectOne(
@@ -384,6 +468,11 @@ To learn more about the issue:
 https://aws.amazon.com/premiumsupport/knowledge-center/ecs-pull-container-api-error-ecr/`)
 		return fmt.Errorf("VPC %s has no DNS support enabled", o.importVPC.ID)
 	}
+	if o.vpcEndpoints {
+		if err := o.warnMissingVPCEndpoints(); err != nil {
+			return err
+		}
+	}
 	if o.importVPC.PublicSubnetIDs == nil {
 		publicSubnets, err := o.selVPC.PublicSubnets(envInitPublicSubnetsSelectPrompt, "", o.importVPC.ID)
 		if err != nil {
@@ -411,6 +500,33 @@ https://aws.amazon.com/premiumsupport/knowledge-center/ecs-pull-container-api-er
 	return nil
 }
 
+// warnMissingVPCEndpoints logs a warning if the imported VPC doesn't have the endpoints that the tasks need.
+func (o *initEnvOpts) warnMissingVPCEndpoints() error {
+	services, err := o.ec2Client.VPCEndpointServices(o.importVPC.ID)
+	if err != nil {
+		return fmt.Errorf("list endpoints of VPC %s: %w", o.importVPC.ID, err)
+	}
+	existing := make(map[string]bool)
+	for _, service := range services {
+		existing[service] = true
+	}
+	region := aws.StringValue(o.sess.Config.Region)
+	var missing []string
+	for _, service := range envVPCEndpointServices {
+		name := fmt.Sprintf("com.amazonaws.%s.%s", region, service)
+		if !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	log.Warningf(`VPC %s does not have endpoints for: %s.
+Services in private subnets without a NAT gateway can't pull images or send logs without them.
+`, o.importVPC.ID, strings.Join(missing, ", "))
+	return nil
+}
+
 func (o *initEnvOpts) askAdjustResources() error {
 	if o.adjustVPC.CIDR.String() == emptyIPNet.String() {
 		vpcCIDRString, err := o.prompt.Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, validateCIDR,
@@ -424,9 +540,11 @@ func (o *initEnvOpts) askAdjustResources() error {
 		}
 		o.adjustVPC.CIDR = *vpcCIDR
 	}
+	// Leave the default subnets empty if the VPC is too small for one /24 public and private subnet in each zone.
+	defaultPublicCIDRs, defaultPrivateCIDRs, _ := stack.DefaultSubnetCIDRs(o.adjustVPC.CIDR.String(), o.azCount())
 	if o.adjustVPC.PublicSubnetCIDRs == nil {
 		publicCIDR, err := o.prompt.Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, validateCIDRSlice,
-			prompt.WithDefaultInput(stack.DefaultPublicSubnetCIDRs))
+			prompt.WithDefaultInput(strings.Join(defaultPublicCIDRs, ",")))
 		if err != nil {
 			return fmt.Errorf("get public subnet CIDRs: %w", err)
 		}
@@ -434,7 +552,7 @@ func (o *initEnvOpts) askAdjustResources() error {
 	}
 	if o.adjustVPC.PrivateSubnetCIDRs == nil {
 		privateCIDR, err := o.prompt.Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, validateCIDRSlice,
-			prompt.WithDefaultInput(stack.DefaultPrivateSubnetCIDRs))
+			prompt.WithDefaultInput(strings.Join(defaultPrivateCIDRs, ",")))
 		if err != nil {
 			return fmt.Errorf("get private subnet CIDRs: %w", err)
 		}
@@ -455,14 +573,46 @@ func (o *initEnvOpts) importVPCConfig() *config.ImportVPC {
 }
 
 func (o *initEnvOpts) adjustVPCConfig() *config.AdjustVPC {
-	if o.defaultConfig || !o.adjustVPC.isSet() {
+	if o.importVPC.isSet() {
 		return nil
 	}
+	if o.defaultConfig || !o.adjustVPC.isSet() {
+		return o.defaultVPCConfig()
+	}
 	return &config.AdjustVPC{
 		CIDR:               o.adjustVPC.CIDR.String(),
 		PrivateSubnetCIDRs: o.adjustVPC.PrivateSubnetCIDRs,
 		PublicSubnetCIDRs:  o.adjustVPC.PublicSubnetCIDRs,
+		AZs:                o.adjustVPC.AZNames,
+	}
+}
+
+// defaultVPCConfig returns the default CIDR ranges spread over the availability zones of the environment.
+// If the environment uses the default two zones, returns nil.
+func (o *initEnvOpts) defaultVPCConfig() *config.AdjustVPC {
+	azCount := o.azCount()
+	if azCount == stack.DefaultAZCount && len(o.adjustVPC.AZNames) == 0 {
+		return nil
+	}
+	// The default VPC CIDR fits the subnets of the maximum number of zones.
+	public, private, _ := stack.DefaultSubnetCIDRs(stack.DefaultVPCCIDR, azCount)
+	return &config.AdjustVPC{
+		CIDR:               stack.DefaultVPCCIDR,
+		PrivateSubnetCIDRs: private,
+		PublicSubnetCIDRs:  public,
+		AZs:                o.adjustVPC.AZNames,
+	}
+}
+
+// azCount returns the number of availability zones of the VPC created by Copilot.
+func (o *initEnvOpts) azCount() int {
+	switch {
+	case o.adjustVPC.AZCount != 0:
+		return o.adjustVPC.AZCount
+	case len(o.adjustVPC.AZNames) != 0:
+		return len(o.adjustVPC.AZNames)
 	}
+	return stack.DefaultAZCount
 }
 
 func (o *initEnvOpts) deployEnv(app *config.Application) error {
@@ -479,6 +629,8 @@ func (o *initEnvOpts) deployEnv(app *config.Application) error {
 		AdditionalTags:           app.Tags,
 		AdjustVPCConfig:          o.adjustVPCConfig(),
 		ImportVPCConfig:          o.importVPCConfig(),
+		VPCEndpoints:             o.vpcEndpoints,
+		ImportCertARNs:           o.importCertARNs,
 	}
 
 	o.prog.Start(fmt.Sprintf(fmtDeployEnvStart, color.HighlightUserInput(o.name)))
@@ -612,10 +764,17 @@ func buildEnvInitCmd() *cobra.Command {
   /code --import-public-subnets subnet-013e8b691862966cf,subnet -014661ebb7ab8681a \
   /code --import-private-subnets subnet-055fafef48fb3c547,subnet-00c9e76f288363e7f
 
+  Creates an environment whose services reach AWS services through VPC endpoints.
+  /code $ copilot env init --name test --profile default --default-config --vpc-endpoints
+
   Creates an environment with overrided CIDRs.
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates an environment whose VPC spans three availability zones.
+  /code $ copilot env init --name test --profile default --default-config \
+  /code --az-count 3 --override-az-names us-west-2a,us-west-2b,us-west-2c`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -643,12 +802,16 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.importVPC.ID, vpcIDFlag, "", vpcIDFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PublicSubnetIDs, publicSubnetsFlag, nil, publicSubnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.importVPC.PrivateSubnetIDs, privateSubnetsFlag, nil, privateSubnetsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.importCertARNs, importCertARNsFlag, nil, importCertARNsFlagDescription)
 
 	cmd.Flags().IPNetVar(&vars.adjustVPC.CIDR, vpcCIDRFlag, net.IPNet{}, vpcCIDRFlagDescription)
 	// TODO: use IPNetSliceVar when it is available (https://github.com/spf13/pflag/issues/273).
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PublicSubnetCIDRs, publicSubnetCIDRsFlag, nil, publicSubnetCIDRsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.adjustVPC.PrivateSubnetCIDRs, privateSubnetCIDRsFlag, nil, privateSubnetCIDRsFlagDescription)
+	cmd.Flags().IntVar(&vars.adjustVPC.AZCount, azCountFlag, 0, azCountFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.adjustVPC.AZNames, azNamesFlag, nil, azNamesFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.vpcEndpoints, vpcEndpointsFlag, false, vpcEndpointsFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -660,16 +823,20 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
 	flags.AddFlag(cmd.Flags().Lookup(prodEnvFlag))
+	flags.AddFlag(cmd.Flags().Lookup(vpcEndpointsFlag))
 
 	resourcesImportFlag := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(vpcIDFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(publicSubnetsFlag))
 	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(privateSubnetsFlag))
+	resourcesImportFlag.AddFlag(cmd.Flags().Lookup(importCertARNsFlag))
 
 	resourcesConfigFlag := pflag.NewFlagSet("Configure Default Resources", pflag.ContinueOnError)
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(vpcCIDRFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(publicSubnetCIDRsFlag))
 	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(privateSubnetCIDRsFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(azCountFlag))
+	resourcesConfigFlag.AddFlag(cmd.Flags().Lookup(azNamesFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
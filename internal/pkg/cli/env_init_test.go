@@ -41,6 +41,9 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inPublicIDs   []string
 		inVPCCIDR     net.IPNet
 		inPublicCIDRs []string
+		inCertARNs    []string
+		inAZCount     int
+		inAZNames     []string
 
 		inProfileName     string
 		inAccessKeyID     string
@@ -86,6 +89,36 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 			wantedErrMsg: fmt.Sprintf("cannot import or configure vpc if --%s is set", defaultConfigFlag),
 		},
+		"should err if a certificate ARN is invalid": {
+			inEnvName:  "test-pdx",
+			inAppName:  "phonetool",
+			inCertARNs: []string{"mycert"},
+
+			wantedErrMsg: "mycert is not a valid ACM certificate ARN",
+		},
+		"should err if the number of availability zones is out of range": {
+			inEnvName: "test",
+			inAppName: "phonetool",
+			inAZCount: 7,
+
+			wantedErrMsg: "--az-count must be between 2 and 6",
+		},
+		"should err if the number of availability zones doesn't match their names": {
+			inEnvName: "test",
+			inAppName: "phonetool",
+			inAZCount: 3,
+			inAZNames: []string{"us-west-2a", "us-west-2b"},
+
+			wantedErrMsg: "--az-count 3 does not match the 2 zones in --override-az-names",
+		},
+		"should err if availability zones are set with an imported VPC": {
+			inEnvName: "test",
+			inAppName: "phonetool",
+			inVPCID:   "mockID",
+			inAZCount: 3,
+
+			wantedErrMsg: "cannot specify both import vpc flags and configure vpc flags",
+		},
 		"should err if both profile and access key id are set": {
 			inAppName:     "phonetool",
 			inEnvName:     "test",
@@ -122,13 +155,16 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 					adjustVPC: adjustVPCVars{
 						PublicSubnetCIDRs: tc.inPublicCIDRs,
 						CIDR:              tc.inVPCCIDR,
+						AZCount:           tc.inAZCount,
+						AZNames:           tc.inAZNames,
 					},
 					importVPC: importVPCVars{
 						PublicSubnetIDs: tc.inPublicIDs,
 						ID:              tc.inVPCID,
 					},
-					appName: tc.inAppName,
-					profile: tc.inProfileName,
+					appName:        tc.inAppName,
+					profile:        tc.inProfileName,
+					importCertARNs: tc.inCertARNs,
 					tempCreds: tempCredsVars{
 						AccessKeyID:     tc.inAccessKeyID,
 						SecretAccessKey: tc.inSecretAccessKey,
@@ -152,11 +188,12 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 
 func TestInitEnvOpts_Ask(t *testing.T) {
 	const (
-		mockEnv         = "test"
-		mockProfile     = "default"
-		mockVPCCIDR     = "10.10.10.10/24"
-		mockSubnetCIDRs = "10.10.10.10/24,10.10.10.10/24"
-		mockRegion      = "us-west-2"
+		mockEnv                = "test"
+		mockProfile            = "default"
+		mockVPCCIDR            = "10.10.0.0/16"
+		mockPublicSubnetCIDRs  = "10.10.0.0/24,10.10.1.0/24"
+		mockPrivateSubnetCIDRs = "10.10.2.0/24,10.10.3.0/24"
+		mockRegion             = "us-west-2"
 	)
 	mockErr := errors.New("some error")
 	mockSession := &session.Session{
@@ -173,6 +210,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inDefault       bool
 		inImportVPCVars importVPCVars
 		inAdjustVPCVars adjustVPCVars
+		inVPCEndpoints  bool
 
 		setupMocks func(mocks initEnvMocks)
 
@@ -363,6 +401,37 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
 			},
 		},
+		"fail to list the endpoints of an imported VPC": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID"},
+				PublicSubnetIDs:  []string{"mockPublicSubnetID"},
+			},
+			inVPCEndpoints: true,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().VPCEndpointServices("mockVPCID").Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list endpoints of VPC mockVPCID: some error"),
+		},
+		"success with importing a VPC with missing endpoints": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inImportVPCVars: importVPCVars{
+				ID:               "mockVPCID",
+				PrivateSubnetIDs: []string{"mockPrivateSubnetID"},
+				PublicSubnetIDs:  []string{"mockPublicSubnetID"},
+			},
+			inVPCEndpoints: true,
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().HasDNSSupport("mockVPCID").Return(true, nil)
+				m.ec2Client.EXPECT().VPCEndpointServices("mockVPCID").Return([]string{"com.amazonaws.us-west-2.s3"}, nil)
+			},
+		},
 		"fail to get VPC CIDR": {
 			inEnv:     mockEnv,
 			inProfile: mockProfile,
@@ -399,7 +468,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
-					Return(mockSubnetCIDRs, nil)
+					Return(mockPublicSubnetCIDRs, nil)
 				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return("", mockErr)
 			},
@@ -415,9 +484,10 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
 					Return(mockVPCCIDR, nil)
 				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
-					Return(mockSubnetCIDRs, nil)
+					Return(mockPublicSubnetCIDRs, nil)
 				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
-					Return(mockSubnetCIDRs, nil)
+					Return(mockPrivateSubnetCIDRs, nil)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return([]string{"us-west-2a", "us-west-2b"}, nil)
 			},
 		},
 		"success with adjusting default env config with flags": {
@@ -428,13 +498,118 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					IP:   net.IP{10, 1, 232, 0},
 					Mask: net.IPMask{255, 255, 255, 0},
 				},
-				PrivateSubnetCIDRs: []string{"mockPrivateCIDR"},
-				PublicSubnetCIDRs:  []string{"mockPublicCIDR"},
+				PrivateSubnetCIDRs: []string{"10.1.232.128/25"},
+				PublicSubnetCIDRs:  []string{"10.1.232.0/25"},
 			},
 			setupMocks: func(m initEnvMocks) {
 				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any()).Times(0)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return([]string{"us-west-2a", "us-west-2b"}, nil)
+			},
+		},
+		"success with availability zones and default config": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inDefault: true,
+			inAdjustVPCVars: adjustVPCVars{
+				AZNames: []string{"us-west-2a", "us-west-2b", "us-west-2c"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.prompt.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return([]string{"us-west-2a", "us-west-2b", "us-west-2c", "us-west-2d"}, nil)
+			},
+		},
+		"error if fail to get the availability zones of the region": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inDefault: true,
+			inAdjustVPCVars: adjustVPCVars{
+				AZNames: []string{"us-west-2a", "us-west-2b"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return(nil, mockErr)
+			},
+			wantedError: errors.New("get availability zones: some error"),
+		},
+		"error if an availability zone is not available in the region": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inDefault: true,
+			inAdjustVPCVars: adjustVPCVars{
+				AZNames: []string{"us-west-2a", "us-east-1b"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return([]string{"us-west-2a", "us-west-2b"}, nil)
+			},
+			wantedError: errors.New("availability zone us-east-1b in --override-az-names is not available in region us-west-2, choose from: us-west-2a, us-west-2b"),
+		},
+		"prompts for the subnets of each availability zone": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				AZCount: 3,
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().SelectOne(envInitDefaultEnvConfirmPrompt, gomock.Any(), gomock.Any()).Times(0)
+				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockVPCCIDR, nil)
+				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return("10.10.0.0/24,10.10.1.0/24,10.10.2.0/24", nil)
+				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return("10.10.3.0/24,10.10.4.0/24,10.10.5.0/24", nil)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return([]string{"us-west-2a", "us-west-2b", "us-west-2c"}, nil)
+			},
+		},
+		"error if the region has fewer availability zones than --az-count": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inDefault: true,
+			inAdjustVPCVars: adjustVPCVars{
+				AZCount: 4,
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.ec2Client.EXPECT().AvailabilityZoneNames().Return([]string{"us-west-2a", "us-west-2b", "us-west-2c"}, nil)
+			},
+			wantedError: errors.New("the VPC needs 4 availability zones but region us-west-2 only has 3: us-west-2a, us-west-2b, us-west-2c"),
+		},
+		"error if the subnets don't match the number of availability zones": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				AZCount: 3,
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
+				m.prompt.EXPECT().Get(envInitVPCCIDRPrompt, envInitVPCCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockVPCCIDR, nil)
+				m.prompt.EXPECT().Get(envInitPublicCIDRPrompt, envInitPublicCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockPublicSubnetCIDRs, nil)
+				m.prompt.EXPECT().Get(envInitPrivateCIDRPrompt, envInitPrivateCIDRPromptHelp, gomock.Any(), gomock.Any()).
+					Return(mockPrivateSubnetCIDRs, nil)
+			},
+			wantedError: errors.New("--az-count 3 requires 3 public and 3 private subnet CIDRs"),
+		},
+		"error if a subnet is outside of the VPC CIDR": {
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inAdjustVPCVars: adjustVPCVars{
+				CIDR: net.IPNet{
+					IP:   net.IP{10, 1, 0, 0},
+					Mask: net.IPMask{255, 255, 0, 0},
+				},
+				PrivateSubnetCIDRs: []string{"10.1.1.0/24"},
+				PublicSubnetCIDRs:  []string{"10.2.0.0/24"},
+			},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(gomock.Any()).Return(mockSession, nil)
 			},
+			wantedError: errors.New("validate VPC configuration: subnet CIDR 10.2.0.0/24 is not within the VPC CIDR 10.1.0.0/16"),
 		},
 	}
 
@@ -463,6 +638,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 					defaultConfig: tc.inDefault,
 					adjustVPC:     tc.inAdjustVPCVars,
 					importVPC:     tc.inImportVPCVars,
+					vpcEndpoints:  tc.inVPCEndpoints,
 				},
 				sessProvider: mocks.sessProvider,
 				selVPC:       mocks.selVPC,
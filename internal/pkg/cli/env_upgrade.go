@@ -7,12 +7,19 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 	"golang.org/x/mod/semver"
 )
@@ -23,6 +30,14 @@ const (
 	envUpgradeEnvPrompt = "Which environment do you want to upgrade?"
 	envUpgradeEnvHelp   = `Upgrades the AWS CloudFormation template for your environment
 to support the latest Copilot features.`
+
+	fmtEnvUpgradeStart    = "Upgrading environment %s from version %s to %s."
+	fmtEnvUpgradeFailed   = "Failed to upgrade environment %s to version %s.\n"
+	fmtEnvUpgradeComplete = "Upgraded environment %s to version %s.\n"
+
+	fmtEnvUpgradeCustomVPCSkipped = `Skip upgrading environment %s since its VPC was customized and Copilot did not store the configuration.
+Add the VPC configuration under "network.vpc" in copilot/environments/%s/manifest.yml and run "env upgrade" again.
+`
 )
 
 // envUpgradeVars holds flag values.
@@ -37,12 +52,18 @@ type envUpgradeVars struct {
 type envUpgradeOpts struct {
 	envUpgradeVars
 
-	store environmentStore
-	sel   appEnvSelector
+	store       store
+	deployStore deployedEnvironmentLister
+	ws          wsEnvironmentReader
+	sel         appEnvSelector
+	identity    identityService
+	prog        progress
 
 	// Constructors for clients that can be initialized only at runtime.
 	// These functions are overriden in tests to provide mocks.
 	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newEnvVPCDescriber  func(app, env string) (envVPCDescriber, error)
+	newEnvUpgrader      func(env *config.Environment) (envTemplateUpgrader, error)
 }
 
 func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
@@ -50,11 +71,28 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("connect to config store: %v", err)
 	}
+	deployStore, err := deploy.NewStore(store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %v", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %v", err)
+	}
+	sessProvider := sessions.NewProvider()
+	defaultSession, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
 	return &envUpgradeOpts{
 		envUpgradeVars: vars,
 
-		store: store,
-		sel:   selector.NewSelect(prompt.New(), store),
+		store:       store,
+		deployStore: deployStore,
+		ws:          ws,
+		sel:         selector.NewSelect(prompt.New(), store),
+		identity:    identity.New(defaultSession),
+		prog:        termprogress.NewSpinner(),
 
 		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
 			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
@@ -67,6 +105,24 @@ func newEnvUpgradeOpts(vars envUpgradeVars) (*envUpgradeOpts, error) {
 			}
 			return d, nil
 		},
+		newEnvVPCDescriber: func(app, env string) (envVPCDescriber, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env, app, err)
+			}
+			return d, nil
+		},
+		newEnvUpgrader: func(env *config.Environment) (envTemplateUpgrader, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %v", env.ManagerRoleARN, env.Region, err)
+			}
+			return deploycfn.New(sess), nil
+		},
 	}, nil
 }
 
@@ -137,35 +193,120 @@ func (o *envUpgradeOpts) listEnvsToUpgrade() ([]string, error) {
 	return names, nil
 }
 
-func (o *envUpgradeOpts) upgrade(env string) error {
-	yes, err := o.shouldUpgrade(env)
+func (o *envUpgradeOpts) upgrade(name string) error {
+	version, err := o.templateVersion(name)
 	if err != nil {
 		return err
 	}
-	if !yes {
+	if !o.shouldUpgrade(name, version) {
 		return nil
 	}
-	// If the environment's version is a legacy version,
-	// and the template was generated by customizing the VPC,
-	// and the customization configuration is not stored in SSM (see #1433),
-	// then we cannot upgrade the environment without re-asking for the VPC configuration.
-	return nil
+	env, err := o.store.GetEnvironment(o.appName, name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %v", name, err)
+	}
+	mft, err := o.envManifest(name)
+	if err != nil {
+		return err
+	}
+	if version == deploy.LegacyEnvTemplateVersion && !hasVPCConfig(env, mft) {
+		// If the environment's version is a legacy version,
+		// and the template was generated by customizing the VPC,
+		// and the customization configuration is not stored in SSM (see #1433),
+		// then we cannot upgrade the environment without re-asking for the VPC configuration.
+		isDefault, err := o.hasDefaultVPC(name)
+		if err != nil {
+			return err
+		}
+		if !isDefault {
+			log.Warningf(fmtEnvUpgradeCustomVPCSkipped, name, name)
+			return nil
+		}
+	}
+	// The environment is upgraded the same way "env deploy" updates it, so that the new template
+	// keeps the VPC stored by "env init" and the features enabled in the manifest.
+	deployer := &deployEnvOpts{
+		deployEnvVars: deployEnvVars{
+			appName: o.appName,
+			name:    name,
+		},
+		store:               o.store,
+		deployStore:         o.deployStore,
+		identity:            o.identity,
+		newEnvVersionGetter: o.newEnvVersionGetter,
+	}
+	in, err := deployer.deployInput(env, mft)
+	if err != nil {
+		return err
+	}
+	upgrader, err := o.newEnvUpgrader(env)
+	if err != nil {
+		return err
+	}
+	o.prog.Start(fmt.Sprintf(fmtEnvUpgradeStart, color.HighlightUserInput(name), version, deploy.LatestEnvTemplateVersion))
+	if err := deployer.deploy(upgrader, in); err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvUpgradeFailed, color.HighlightUserInput(name), deploy.LatestEnvTemplateVersion))
+		return fmt.Errorf("upgrade environment %s: %v", name, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtEnvUpgradeComplete, color.HighlightUserInput(name), deploy.LatestEnvTemplateVersion))
+	return deployer.updateCustomConfig(env, in)
 }
 
-func (o *envUpgradeOpts) shouldUpgrade(env string) (bool, error) {
-	envTpl, err := o.newEnvVersionGetter(o.appName, env)
+// envManifest returns the manifest of the environment in the workspace.
+// If there is none, it returns nil and the environment is upgraded with its stored configuration only.
+func (o *envUpgradeOpts) envManifest(name string) (*manifest.Environment, error) {
+	raw, err := o.ws.ReadEnvironmentManifest(name)
+	if err != nil {
+		var errNoManifest *workspace.ErrEnvironmentManifestNotFound
+		if errors.As(err, &errNoManifest) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal environment %s manifest: %v", name, err)
+	}
+	return mft, nil
+}
+
+func (o *envUpgradeOpts) hasDefaultVPC(env string) (bool, error) {
+	d, err := o.newEnvVPCDescriber(o.appName, env)
 	if err != nil {
 		return false, err
 	}
+	isDefault, err := d.HasDefaultVPC()
+	if err != nil {
+		return false, fmt.Errorf("check if environment %s in app %s has the default VPC: %v", env, o.appName, err)
+	}
+	return isDefault, nil
+}
+
+// hasVPCConfig returns true if the environment's VPC is configured either in its stored configuration or in its manifest.
+func hasVPCConfig(env *config.Environment, mft *manifest.Environment) bool {
+	if env.CustomConfig != nil && (env.CustomConfig.ImportVPC != nil || env.CustomConfig.VPCConfig != nil) {
+		return true
+	}
+	return mft != nil && (mft.Network.VPC.ImportedVPC() != nil || mft.Network.VPC.ManagedVPC() != nil)
+}
+
+func (o *envUpgradeOpts) templateVersion(env string) (string, error) {
+	envTpl, err := o.newEnvVersionGetter(o.appName, env)
+	if err != nil {
+		return "", err
+	}
 	version, err := envTpl.Version()
 	if err != nil {
-		return false, fmt.Errorf("get template version of environment %s in app %s: %v", env, o.appName, err)
+		return "", fmt.Errorf("get template version of environment %s in app %s: %v", env, o.appName, err)
 	}
+	return version, nil
+}
 
+func (o *envUpgradeOpts) shouldUpgrade(env, version string) bool {
 	diff := semver.Compare(version, deploy.LatestEnvTemplateVersion)
 	if diff < 0 {
 		// Newer version available.
-		return true, nil
+		return true
 	}
 
 	msg := fmt.Sprintf("Environment %s is already on the latest version %s, skip upgrade.", env, deploy.LatestEnvTemplateVersion)
@@ -174,7 +315,21 @@ func (o *envUpgradeOpts) shouldUpgrade(env string) (bool, error) {
 Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplateVersion, version)
 	}
 	log.Debugln(msg)
-	return false, nil
+	return false
+}
+
+// validateEnvVersion returns an error if the environment's template is older than the latest version,
+// and therefore misses the resources and permissions that the commands of this version of Copilot rely on.
+func validateEnvVersion(getter versionGetter, app, env string) error {
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %v", env, app, err)
+	}
+	if semver.Compare(version, deploy.LatestEnvTemplateVersion) < 0 {
+		return fmt.Errorf("environment %s is on template version %s, run %s to upgrade it to %s",
+			env, version, color.HighlightCode(fmt.Sprintf("copilot env upgrade --app %s --name %s", app, env)), deploy.LatestEnvTemplateVersion)
+	}
+	return nil
 }
 
 // buildEnvUpgradeCmd builds the command to update environment(s) to the latest version of
@@ -182,9 +337,15 @@ Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplate
 func buildEnvUpgradeCmd() *cobra.Command {
 	vars := envUpgradeVars{}
 	cmd := &cobra.Command{
-		Use:    "upgrade",
-		Short:  "Upgrades the template of an environment to the latest version.",
-		Hidden: true,
+		Use:   "upgrade",
+		Short: "Upgrades the template of an environment to the latest version.",
+		Long: `Upgrades the template of an environment to the latest version.
+The environment keeps the configuration of "env init" and of its manifest in copilot/environments/{name}/manifest.yml.`,
+		Example: `
+  Upgrade the "test" environment.
+  /code $ copilot env upgrade --name test
+  Upgrade all the environments of the application.
+  /code $ copilot env upgrade --all`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newEnvUpgradeOpts(vars)
 			if err != nil {
@@ -5,11 +5,15 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -21,7 +25,7 @@ func TestEnvUpgradeOpts_Validate(t *testing.T) {
 	}{
 		"should not error if the environment exists and a name is provided": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
-				m := mocks.NewMockenvironmentStore(ctrl)
+				m := mocks.NewMockstore(ctrl)
 				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, nil)
 
 				return &envUpgradeOpts{
@@ -35,7 +39,7 @@ func TestEnvUpgradeOpts_Validate(t *testing.T) {
 		},
 		"should throw a config.ErrNoSuchEnvironment if the environment is not found": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
-				m := mocks.NewMockenvironmentStore(ctrl)
+				m := mocks.NewMockstore(ctrl)
 				m.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Return(nil, &config.ErrNoSuchEnvironment{
 					ApplicationName: "phonetool",
 					EnvironmentName: "test",
@@ -56,7 +60,7 @@ func TestEnvUpgradeOpts_Validate(t *testing.T) {
 		},
 		"should throw a wrapped error on unexpected config failure": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
-				m := mocks.NewMockenvironmentStore(ctrl)
+				m := mocks.NewMockstore(ctrl)
 				m.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
 
 				return &envUpgradeOpts{
@@ -190,7 +194,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 	}{
 		"should skip upgrading if the environment version is already at least latest": {
 			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
-				mockStore := mocks.NewMockenvironmentStore(ctrl)
+				mockStore := mocks.NewMockstore(ctrl)
 				mockStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
 					{
 						Name: "test",
@@ -214,6 +218,227 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"should upgrade the environment with its stored configuration if there is no manifest": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+					CustomConfig: &config.CustomizeEnv{
+						ImportVPC: &config.ImportVPC{
+							ID:               "vpc-3f139646",
+							PublicSubnetIDs:  []string{"subnet-11111"},
+							PrivateSubnetIDs: []string{"subnet-22222"},
+						},
+					},
+				}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockWs := mocks.NewMockwsEnvironmentReader(ctrl)
+				mockWs.EXPECT().ReadEnvironmentManifest("test").Return(nil, &workspace.ErrEnvironmentManifestNotFound{EnvName: "test"})
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v1.0.0", nil).Times(2)
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(fmt.Sprintf(fmtEnvUpgradeStart, "test", "v1.0.0", deploy.LatestEnvTemplateVersion))
+				mockProg.EXPECT().Stop(gomock.Any())
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
+					AppName:                  "phonetool",
+					Name:                     "test",
+					ToolsAccountPrincipalARN: "arn:aws:iam::123456789012:root",
+					ImportVPCConfig: &config.ImportVPC{
+						ID:               "vpc-3f139646",
+						PublicSubnetIDs:  []string{"subnet-11111"},
+						PrivateSubnetIDs: []string{"subnet-22222"},
+					},
+					Version: deploy.LatestEnvTemplateVersion,
+				}).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store:    mockStore,
+					ws:       mockWs,
+					identity: mockIdentity,
+					prog:     mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newEnvUpgrader: func(_ *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+		},
+		"should keep the load balanced web services of a legacy environment and the manifest features": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+				}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockStore.EXPECT().ListServices("phonetool").Return([]*config.Workload{
+					{Name: "frontend", Type: manifest.LoadBalancedWebServiceType},
+				}, nil)
+				mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+				mockDeployStore.EXPECT().IsServiceDeployed("phonetool", "test", "frontend").Return(true, nil)
+				mockWs := mocks.NewMockwsEnvironmentReader(ctrl)
+				mockWs.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+observability:
+  container_insights: true
+`), nil)
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{RootUserARN: "arn:aws:iam::123456789012:root"}, nil)
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil).Times(2)
+				mockEnvVPC := mocks.NewMockenvVPCDescriber(ctrl)
+				mockEnvVPC.EXPECT().HasDefaultVPC().Return(true, nil)
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeLegacyEnvironment(&deploy.CreateEnvironmentInput{
+					AppName:                  "phonetool",
+					Name:                     "test",
+					ToolsAccountPrincipalARN: "arn:aws:iam::123456789012:root",
+					EnableContainerInsights:  true,
+					Version:                  deploy.LatestEnvTemplateVersion,
+				}, "frontend").Return(nil)
+				mockStore.EXPECT().UpdateEnvironment(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+					CustomConfig: &config.CustomizeEnv{
+						EnableContainerInsights: true,
+					},
+				}).Return(nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store:       mockStore,
+					deployStore: mockDeployStore,
+					ws:          mockWs,
+					identity:    mockIdentity,
+					prog:        mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newEnvVPCDescriber: func(_, _ string) (envVPCDescriber, error) {
+						return mockEnvVPC, nil
+					},
+					newEnvUpgrader: func(_ *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+		},
+		"should skip a legacy environment whose customized VPC configuration isn't stored": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+				}, nil)
+				mockWs := mocks.NewMockwsEnvironmentReader(ctrl)
+				mockWs.EXPECT().ReadEnvironmentManifest("test").Return(nil, &workspace.ErrEnvironmentManifestNotFound{EnvName: "test"})
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+				mockEnvVPC := mocks.NewMockenvVPCDescriber(ctrl)
+				mockEnvVPC.EXPECT().HasDefaultVPC().Return(false, nil)
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: mockStore,
+					ws:    mockWs,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newEnvVPCDescriber: func(_, _ string) (envVPCDescriber, error) {
+						return mockEnvVPC, nil
+					},
+				}
+			},
+		},
+		"should wrap error if fail to check the VPC of a legacy environment": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+				}, nil)
+				mockWs := mocks.NewMockwsEnvironmentReader(ctrl)
+				mockWs.EXPECT().ReadEnvironmentManifest("test").Return(nil, &workspace.ErrEnvironmentManifestNotFound{EnvName: "test"})
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+				mockEnvVPC := mocks.NewMockenvVPCDescriber(ctrl)
+				mockEnvVPC.EXPECT().HasDefaultVPC().Return(false, errors.New("some error"))
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store: mockStore,
+					ws:    mockWs,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newEnvVPCDescriber: func(_, _ string) (envVPCDescriber, error) {
+						return mockEnvVPC, nil
+					},
+				}
+			},
+			wantedErr: errors.New("check if environment test in app phonetool has the default VPC: some error"),
+		},
+		"should wrap error if fail to upgrade the environment stack": {
+			given: func(ctrl *gomock.Controller) *envUpgradeOpts {
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+					App:  "phonetool",
+					Name: "test",
+				}, nil)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				mockWs := mocks.NewMockwsEnvironmentReader(ctrl)
+				mockWs.EXPECT().ReadEnvironmentManifest("test").Return(nil, &workspace.ErrEnvironmentManifestNotFound{EnvName: "test"})
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{}, nil)
+				mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+				mockEnvTpl.EXPECT().Version().Return("v1.0.0", nil).Times(2)
+				mockProg := mocks.NewMockprogress(ctrl)
+				mockProg.EXPECT().Start(gomock.Any())
+				mockProg.EXPECT().Stop(gomock.Any())
+				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeEnvironment(gomock.Any()).Return(errors.New("some error"))
+
+				return &envUpgradeOpts{
+					envUpgradeVars: envUpgradeVars{
+						appName: "phonetool",
+						name:    "test",
+					},
+					store:    mockStore,
+					ws:       mockWs,
+					identity: mockIdentity,
+					prog:     mockProg,
+					newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+						return mockEnvTpl, nil
+					},
+					newEnvUpgrader: func(_ *config.Environment) (envTemplateUpgrader, error) {
+						return mockUpgrader, nil
+					},
+				}
+			},
+			wantedErr: errors.New("upgrade environment test: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -232,3 +457,41 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEnvVersion(t *testing.T) {
+	testCases := map[string]struct {
+		version    string
+		versionErr error
+
+		wantedErr error
+	}{
+		"should not error if the environment is on the latest version": {
+			version: deploy.LatestEnvTemplateVersion,
+		},
+		"should return an error with the upgrade command if the environment is on an older version": {
+			version:   deploy.LegacyEnvTemplateVersion,
+			wantedErr: fmt.Errorf("environment test is on template version %s, run `copilot env upgrade --app phonetool --name test` to upgrade it to %s", deploy.LegacyEnvTemplateVersion, deploy.LatestEnvTemplateVersion),
+		},
+		"should wrap error if fail to get the version": {
+			versionErr: errors.New("some error"),
+			wantedErr:  errors.New("get template version of environment test in app phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockversionGetter(ctrl)
+			m.EXPECT().Version().Return(tc.version, tc.versionErr)
+
+			err := validateEnvVersion(m, "phonetool", "test")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
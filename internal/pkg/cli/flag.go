@@ -67,18 +67,28 @@ const (
 	subnetsFlag        = "subnets"
 	securityGroupsFlag = "security-groups"
 	envVarsFlag        = "env-vars"
+	envFileFlag        = "env-file"
+	secretsFlag        = "secrets"
 	commandFlag        = "command"
+	entrypointFlag     = "entrypoint"
 	taskDefaultFlag    = "default"
+	waitFlag           = "wait"
+	fromSvcFlag        = "from-svc"
+	spotFlag           = "spot"
 
 	vpcIDFlag          = "import-vpc-id"
 	publicSubnetsFlag  = "import-public-subnets"
 	privateSubnetsFlag = "import-private-subnets"
+	importCertARNsFlag = "import-cert-arns"
 
 	vpcCIDRFlag            = "override-vpc-cidr"
 	publicSubnetCIDRsFlag  = "override-public-cidrs"
 	privateSubnetCIDRsFlag = "override-private-cidrs"
+	azCountFlag            = "az-count"
+	azNamesFlag            = "override-az-names"
 
 	defaultConfigFlag = "default-config"
+	vpcEndpointsFlag  = "vpc-endpoints"
 
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
@@ -88,6 +98,13 @@ const (
 	retriesFlag  = "retries"
 	timeoutFlag  = "timeout"
 	scheduleFlag = "schedule"
+
+	diffFlag       = "diff"
+	rollbackToFlag = "to"
+	taskIDFlag     = "task-id"
+	containerFlag  = "container"
+
+	fromComposeFlag = "from-compose"
 )
 
 // Short flag names.
@@ -122,6 +139,8 @@ var (
 	subnetsFlagDescription = fmt.Sprintf(`Optional. The subnet IDs for the task to use. Can be specified multiple times.
 Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, taskDefaultFlag)
 	securityGroupsFlagDescription = fmt.Sprintf(`Optional. The security group IDs for the task to use. Can be specified multiple times.
+Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
+	taskGroupDefaultFlagDescription = fmt.Sprintf(`Optional. Manage the task groups that run in your default cluster.
 Cannot be specified with '%s' or '%s'.`, appFlag, envFlag)
 	taskDefaultFlagDescription = fmt.Sprintf(`Optional. Run tasks in default cluster and default subnets. 
 Cannot be specified with '%s', '%s' or '%s'.`, appFlag, envFlag, subnetsFlag)
@@ -168,8 +187,10 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	domainNameFlagDescription        = "Optional. Your existing custom domain name."
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
+	jobResourcesFlagDescription      = "Optional. Show the resources in your job."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
 	localSvcFlagDescription          = "Only show services in the workspace."
+	localJobFlagDescription          = "Only show jobs in the workspace."
 	envProfilesFlagDescription       = "Optional. Environments and the profile to use to delete the environment."
 	deleteSecretFlagDescription      = "Deletes AWS Secrets Manager secret associated with a pipeline source repository."
 	svcPortFlagDescription           = "Optional. The port on which your service listens."
@@ -192,21 +213,48 @@ Must be of the format '<keyName>:<dataType>'.`
 	taskRoleFlagDescription      = "Optional. The ARN of the role for the task to use."
 	executionRoleFlagDescription = "Optional. The ARN of the role that grants the container agent permission to make AWS API calls."
 	envVarsFlagDescription       = "Optional. Environment variables specified by key=value separated with commas."
-	commandFlagDescription       = `Optional. The command that is passed to "docker run" to override the default command.`
-	taskGroupFlagDescription     = `Optional. The group name of the task. 
+	commandFlagDescription       = `Optional. The command that is passed to "docker run" to override the default command.
+Either arguments separated by spaces, or a JSON array of arguments. For example: '["sh", "-c", "echo hello"]'.`
+	envFileFlagDescription = `Optional. Path to a file with an environment variable on each line, as KEY=VALUE.
+Variables passed with --env-vars take precedence.`
+	secretsFlagDescription = `Optional. Secrets to inject as environment variables, specified by key=value separated with commas.
+The value is the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret.`
+	entrypointFlagDescription = `Optional. The entrypoint that is passed to "docker run" to override the default entrypoint.
+Either arguments separated by spaces, or a JSON array of arguments.`
+	taskGroupFlagDescription = `Optional. The group name of the task. 
 Tasks with the same group name share the same set of resources. 
 (default directory name)`
-	taskImageTagFlagDescription = `Optional. The container image tag in addition to "latest".`
+	taskGroupNameFlagDescription = "Name of the task group."
+	taskImageTagFlagDescription  = `Optional. The container image tag in addition to "latest".`
+	taskFollowFlagDescription    = `Optional. Stream the logs of the tasks until they stop.
+Exits with the exit code of the first essential container that failed.`
+	fromSvcFlagDescription = `Optional. Name of a deployed service whose image, task role, execution role,
+environment variables, secrets, subnets and security groups the task reuses. Requires an application and an environment.`
+	spotFlagDescription = `Optional. Run the tasks on Fargate Spot instead of Fargate.
+The cluster must have the FARGATE_SPOT capacity provider.`
+	waitFlagDescription = `Optional. Wait until the tasks stop without streaming their logs.
+Exits with the exit code of the first essential container that failed.`
+	taskTimeoutFlagDescription = `Optional. Maximum time to wait for the tasks to stop with --follow or --wait.
+Accepts valid Go duration strings. For example: "30m", "1h30m". Defaults to no timeout.`
 
 	vpcIDFlagDescription          = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription  = "Optional. Use existing public subnet IDs."
 	privateSubnetsFlagDescription = "Optional. Use existing private subnet IDs."
+	importCertARNsFlagDescription = "Optional. Apply existing ACM certificates to the HTTPS listener."
 
 	vpcCIDRFlagDescription            = "Optional. Global CIDR to use for VPC (default 10.0.0.0/16)."
 	publicSubnetCIDRsFlagDescription  = "Optional. CIDR to use for public subnets (default 10.0.0.0/24,10.0.1.0/24)."
 	privateSubnetCIDRsFlagDescription = "Optional. CIDR to use for private subnets (default 10.0.2.0/24,10.0.3.0/24)."
+	azCountFlagDescription            = `Optional. Number of availability zones of the VPC, between 2 and 6 (default 2).
+One public and one private subnet are created in each zone.
+The region must have at least that many zones.`
+	azNamesFlagDescription = `Optional. Names of the availability zones of the subnets, for example us-west-2a,us-west-2b,us-west-2c.
+The i-th public and private subnets are placed in the i-th zone.`
 
 	defaultConfigFlagDescription = "Optional. Skip prompting and use default environment configuration."
+	vpcEndpointsFlagDescription  = `Optional. Create VPC endpoints so that services in private subnets
+can reach AWS services without a NAT gateway.
+For an imported VPC, check that the endpoints exist instead.`
 
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
 	secretAccessKeyFlagDescription = "Optional. An AWS secret access key."
@@ -221,4 +269,17 @@ Accepts cron expressions of the format (M H DoM M DoW) and schedule definition s
 For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".`
 
 	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
+
+	diffFlagDescription = `Optional. Preview the changes to the service's resources
+and confirm them before deploying.`
+	rollbackToFlagDescription = `Optional. The image tag, image, or task definition revision
+of a previous deployment to roll back to.`
+	execTaskIDFlagDescription = `Optional. ID of the task to run the command in.
+Defaults to a running task of the service.`
+	execContainerFlagDescription = `Optional. Name of the container to run the command in.
+Defaults to the service's main container.`
+	execCommandFlagDescription = `Optional. The command to run in the container.`
+
+	fromComposeFlagDescription = `Optional. Path to a Docker Compose file.
+Creates a service for each Compose service instead of prompting for a single service.`
 )
@@ -45,11 +45,13 @@ type initVars struct {
 	dockerfilePath string
 	imageTag       string
 	port           uint16
+	composeFile    string
 }
 
 type initOpts struct {
-	ShouldDeploy          bool // true means we should create a test environment and deploy the service to it. Defaults to false.
-	promptForShouldDeploy bool // true means that the user set the ShouldDeploy flag explicitly.
+	ShouldDeploy          bool   // true means we should create a test environment and deploy the service to it. Defaults to false.
+	promptForShouldDeploy bool   // true means that the user set the ShouldDeploy flag explicitly.
+	composeFile           string // Path to a Docker Compose file to create services from instead of running "svc init".
 
 	// Sub-commands to execute.
 	initAppCmd   actionCommand
@@ -57,6 +59,8 @@ type initOpts struct {
 	initEnvCmd   actionCommand
 	deploySvcCmd actionCommand
 
+	importComposeCmd actionCommand
+
 	// Pointers to flag values part of sub-commands.
 	// Since the sub-commands implement the actionCommand interface, without pointers to their internal fields
 	// we have to resort to type-casting the interface. These pointers simplify data access.
@@ -65,6 +69,7 @@ type initOpts struct {
 	svcName        *string
 	svcPort        *uint16
 	dockerfilePath *string
+	composeSvcs    *[]string // Names of the services created from the Compose file.
 
 	prompt prompter
 }
@@ -153,19 +158,35 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		sessProvider: sessProvider,
 	}
 
+	importComposeCmd := &importComposeOpts{
+		importComposeVars: importComposeVars{
+			appName:     vars.appName,
+			composeFile: vars.composeFile,
+		},
+		fs:          &afero.Afero{Fs: afero.NewOsFs()},
+		ws:          ws,
+		store:       ssm,
+		appDeployer: deployer,
+		prog:        spin,
+	}
+
 	return &initOpts{
 		ShouldDeploy: vars.shouldDeploy,
+		composeFile:  vars.composeFile,
 
 		initAppCmd:   initAppCmd,
 		initSvcCmd:   initSvcCmd,
 		initEnvCmd:   initEnvCmd,
 		deploySvcCmd: deploySvcCmd,
 
+		importComposeCmd: importComposeCmd,
+
 		appName:        &initAppCmd.name,
 		svcType:        &initSvcCmd.serviceType,
 		svcName:        &initSvcCmd.name,
 		svcPort:        &initSvcCmd.port,
 		dockerfilePath: &initSvcCmd.dockerfilePath,
+		composeSvcs:    &importComposeCmd.svcNames,
 
 		prompt: prompt,
 	}, nil
@@ -181,6 +202,9 @@ to help you get set up with an application on ECS. An application is a collectio
 containerized services that operate together.`))
 	log.Infoln()
 
+	if o.composeFile != "" {
+		return o.runFromCompose()
+	}
 	if err := o.loadApp(); err != nil {
 		return err
 	}
@@ -205,6 +229,45 @@ containerized services that operate together.`))
 	return o.deploySvc()
 }
 
+// runFromCompose executes "app init", creates a service for each service of the Compose file,
+// and then deploys the services to a test environment.
+func (o *initOpts) runFromCompose() error {
+	if *o.svcName != "" || *o.svcType != "" || *o.dockerfilePath != "" || *o.svcPort != 0 {
+		return fmt.Errorf("--%s cannot be specified with --%s, --%s, --%s or --%s", fromComposeFlag, svcFlag, svcTypeFlag, dockerFileFlag, svcPortFlag)
+	}
+	if err := o.loadApp(); err != nil {
+		return err
+	}
+	if importCmd, ok := o.importComposeCmd.(*importComposeOpts); ok {
+		// Set the application name from app init to the import command.
+		importCmd.appName = *o.appName
+	}
+	if err := o.importComposeCmd.Validate(); err != nil {
+		return err
+	}
+
+	log.Infof("Ok great, we'll set up the services of %s in application %s.\n",
+		color.HighlightResource(o.composeFile), color.HighlightUserInput(*o.appName))
+	log.Infoln()
+	if err := o.initAppCmd.Execute(); err != nil {
+		return fmt.Errorf("execute app init: %w", err)
+	}
+	if err := o.importComposeCmd.Execute(); err != nil {
+		return fmt.Errorf("import compose file %s: %w", o.composeFile, err)
+	}
+
+	if err := o.deployEnv(); err != nil {
+		return err
+	}
+	for _, name := range *o.composeSvcs {
+		*o.svcName = name
+		if err := o.deploySvc(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *initOpts) loadApp() error {
 	if err := o.initAppCmd.Ask(); err != nil {
 		return fmt.Errorf("ask app init: %w", err)
@@ -293,7 +356,11 @@ func BuildInitCmd() *cobra.Command {
 				log.Info("\nNo problem, you can deploy your service later:\n")
 				log.Infof("- Run %s to create your staging environment.\n",
 					color.HighlightCode(fmt.Sprintf("copilot env init --name %s --profile %s --app %s", defaultEnvironmentName, defaultEnvironmentProfile, *opts.appName)))
-				for _, followup := range opts.initSvcCmd.RecommendedActions() {
+				followups := opts.initSvcCmd.RecommendedActions()
+				if opts.composeFile != "" {
+					followups = opts.importComposeCmd.RecommendedActions()
+				}
+				for _, followup := range followups {
 					log.Infof("- %s\n", followup)
 				}
 			}
@@ -307,6 +374,7 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.shouldDeploy, deployFlag, false, deployTestFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.composeFile, fromComposeFlag, "", fromComposeFlagDescription)
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.GettingStarted,
@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/compose"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+)
+
+type importComposeVars struct {
+	appName     string
+	composeFile string
+}
+
+// importComposeOpts creates a service in the workspace for each service of a Docker Compose file.
+type importComposeOpts struct {
+	importComposeVars
+
+	fs          afero.Fs
+	ws          svcDirManifestWriter
+	store       store
+	appDeployer appDeployer
+	prog        progress
+
+	// Outputs stored on successful actions.
+	svcNames []string
+}
+
+// Validate returns an error if the Compose file doesn't exist.
+func (o *importComposeOpts) Validate() error {
+	exists, err := afero.Exists(o.fs, o.composeFile)
+	if err != nil {
+		return fmt.Errorf("check if compose file %s exists: %w", o.composeFile, err)
+	}
+	if !exists {
+		return fmt.Errorf("compose file %s does not exist", o.composeFile)
+	}
+	return nil
+}
+
+// Ask is a no-op, the Compose file is passed with a flag.
+func (o *importComposeOpts) Ask() error {
+	return nil
+}
+
+// Execute writes a manifest for each service of the Compose file and stores the services in SSM.
+func (o *importComposeOpts) Execute() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	workloads, err := o.workloads()
+	if err != nil {
+		return err
+	}
+	for _, wkld := range workloads {
+		if err := o.createService(app, wkld); err != nil {
+			return err
+		}
+		o.svcNames = append(o.svcNames, wkld.Name)
+	}
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *importComposeOpts) RecommendedActions() []string {
+	var actions []string
+	for _, name := range o.svcNames {
+		actions = append(actions, fmt.Sprintf("Run %s to deploy your service %s to a %s environment.",
+			color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s --env %s", name, defaultEnvironmentName)),
+			color.HighlightUserInput(name), defaultEnvironmentName))
+	}
+	return actions
+}
+
+// workloads returns the Copilot workloads converted from the Compose file, and logs the configuration that can't be converted.
+func (o *importComposeOpts) workloads() ([]*compose.Workload, error) {
+	content, err := afero.ReadFile(o.fs, o.composeFile)
+	if err != nil {
+		return nil, fmt.Errorf("read compose file %s: %w", o.composeFile, err)
+	}
+	project, err := compose.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse compose file %s: %w", o.composeFile, err)
+	}
+	dir, err := o.relativeComposeDir()
+	if err != nil {
+		return nil, err
+	}
+	workloads, warnings, err := project.Workloads(dir)
+	if err != nil {
+		return nil, fmt.Errorf("convert compose file %s: %w", o.composeFile, err)
+	}
+	for _, warning := range warnings {
+		log.Warningln(warning)
+	}
+	if len(workloads) == 0 {
+		return nil, fmt.Errorf("compose file %s does not have any services with a %s section", o.composeFile, color.HighlightCode("build"))
+	}
+	return workloads, nil
+}
+
+// relativeComposeDir returns the path from the workspace root to the directory of the Compose file.
+func (o *importComposeOpts) relativeComposeDir() (string, error) {
+	copilotDirPath, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return "", fmt.Errorf("get copilot directory: %w", err)
+	}
+	absPath, err := filepath.Abs(o.composeFile)
+	if err != nil {
+		return "", fmt.Errorf("get absolute path: %w", err)
+	}
+	dir, err := filepath.Rel(filepath.Dir(copilotDirPath), filepath.Dir(absPath))
+	if err != nil {
+		return "", fmt.Errorf("find relative path from workspace root to compose file: %w", err)
+	}
+	if strings.HasPrefix(dir, "..") {
+		return "", fmt.Errorf("compose file %s must be inside the workspace", o.composeFile)
+	}
+	return dir, nil
+}
+
+func (o *importComposeOpts) createService(app *config.Application, wkld *compose.Workload) error {
+	manifestPath, err := o.ws.WriteServiceManifest(wkld.Manifest, wkld.Name)
+	if err != nil {
+		e, ok := err.(*workspace.ErrFileExists)
+		if !ok {
+			return fmt.Errorf("write manifest for service %s: %w", wkld.Name, err)
+		}
+		log.Infof("Manifest file for service %s already exists at %s, skipping writing it.\n", color.HighlightUserInput(wkld.Name), color.HighlightResource(e.FileName))
+	} else {
+		log.Successf("Wrote the manifest for %s %s at %s\n", wkld.Type, color.HighlightUserInput(wkld.Name), color.HighlightResource(manifestPath))
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtAddSvcToAppStart, wkld.Name))
+	if err := o.appDeployer.AddServiceToApp(app, wkld.Name); err != nil {
+		o.prog.Stop(log.Serrorf(fmtAddSvcToAppFailed, wkld.Name))
+		return fmt.Errorf("add service %s to application %s: %w", wkld.Name, o.appName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtAddSvcToAppComplete, wkld.Name))
+
+	if err := o.store.CreateService(&config.Workload{
+		App:  o.appName,
+		Name: wkld.Name,
+		Type: wkld.Type,
+	}); err != nil {
+		return fmt.Errorf("saving service %s: %w", wkld.Name, err)
+	}
+	return nil
+}
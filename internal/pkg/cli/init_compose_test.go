@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportComposeOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inComposeFile string
+
+		wantedErr error
+	}{
+		"errors if the compose file does not exist": {
+			inComposeFile: "/ws/compose.yml",
+			wantedErr:     errors.New("compose file /ws/compose.yml does not exist"),
+		},
+		"success": {
+			inComposeFile: "/ws/docker-compose.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile("/ws/docker-compose.yml", []byte("services:"), 0644))
+			opts := &importComposeOpts{
+				importComposeVars: importComposeVars{
+					composeFile: tc.inComposeFile,
+				},
+				fs: fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type importComposeMocks struct {
+	ws          *mocks.MocksvcDirManifestWriter
+	store       *mocks.Mockstore
+	appDeployer *mocks.MockappDeployer
+	prog        *mocks.Mockprogress
+}
+
+func TestImportComposeOpts_Execute(t *testing.T) {
+	const mockCompose = `
+services:
+  web:
+    build: ./web
+    ports:
+      - "8080:80"
+  api:
+    build: ./api
+    expose:
+      - 8080
+  db:
+    image: postgres
+`
+	mockApp := &config.Application{Name: "phonetool"}
+	testCases := map[string]struct {
+		inComposeFile string
+		setupMocks    func(m importComposeMocks)
+
+		wantedSvcNames []string
+		wantedErr      error
+	}{
+		"errors if the compose file is outside of the workspace": {
+			inComposeFile: "/other/docker-compose.yml",
+			setupMocks: func(m importComposeMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			wantedErr: errors.New("compose file /other/docker-compose.yml must be inside the workspace"),
+		},
+		"errors if fail to add a service to the application": {
+			inComposeFile: "/ws/docker-compose.yml",
+			setupMocks: func(m importComposeMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.ws.EXPECT().WriteServiceManifest(gomock.Any(), "api").Return("/ws/copilot/api/manifest.yml", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.appDeployer.EXPECT().AddServiceToApp(mockApp, "api").Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: errors.New("add service api to application phonetool: some error"),
+		},
+		"creates a service for each compose service with a build section": {
+			inComposeFile: "/ws/docker-compose.yml",
+			setupMocks: func(m importComposeMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.ws.EXPECT().WriteServiceManifest(gomock.Any(), "api").Return("/ws/copilot/api/manifest.yml", nil)
+				m.ws.EXPECT().WriteServiceManifest(gomock.Any(), "web").Return("", &workspace.ErrFileExists{FileName: "/ws/copilot/web/manifest.yml"})
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.appDeployer.EXPECT().AddServiceToApp(mockApp, gomock.Any()).Return(nil).Times(2)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+				m.store.EXPECT().CreateService(&config.Workload{
+					App:  "phonetool",
+					Name: "api",
+					Type: manifest.BackendServiceType,
+				}).Return(nil)
+				m.store.EXPECT().CreateService(&config.Workload{
+					App:  "phonetool",
+					Name: "web",
+					Type: manifest.LoadBalancedWebServiceType,
+				}).Return(nil)
+			},
+			wantedSvcNames: []string{"api", "web"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			require.NoError(t, fs.WriteFile(tc.inComposeFile, []byte(mockCompose), 0644))
+			m := importComposeMocks{
+				ws:          mocks.NewMocksvcDirManifestWriter(ctrl),
+				store:       mocks.NewMockstore(ctrl),
+				appDeployer: mocks.NewMockappDeployer(ctrl),
+				prog:        mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &importComposeOpts{
+				importComposeVars: importComposeVars{
+					appName:     "phonetool",
+					composeFile: tc.inComposeFile,
+				},
+				fs:          fs,
+				ws:          m.ws,
+				store:       m.store,
+				appDeployer: m.appDeployer,
+				prog:        m.prog,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcNames, opts.svcNames)
+		})
+	}
+}
@@ -16,6 +16,9 @@ func TestInitOpts_Run(t *testing.T) {
 	testCases := map[string]struct {
 		inShouldDeploy          bool
 		inPromptForShouldDeploy bool
+		inComposeFile           string
+		inComposeSvcs           []string
+		inSvcName               string
 
 		expect      func(opts *initOpts)
 		wantedError string
@@ -124,6 +127,40 @@ func TestInitOpts_Run(t *testing.T) {
 					Return(false, nil)
 			},
 		},
+		"returns error if service flags are specified with a compose file": {
+			inComposeFile: "docker-compose.yml",
+			inSvcName:     "frontend",
+			expect:        func(opts *initOpts) {},
+			wantedError:   "--from-compose cannot be specified with --svc, --svc-type, --dockerfile or --port",
+		},
+		"returns execute error for compose file": {
+			inComposeFile: "docker-compose.yml",
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.importComposeCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.importComposeCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(errors.New("my error"))
+				opts.initSvcCmd.(*climocks.MockactionCommand).EXPECT().Execute().Times(0)
+			},
+			wantedError: "import compose file docker-compose.yml: my error",
+		},
+		"deploys every service of the compose file": {
+			inComposeFile:  "docker-compose.yml",
+			inComposeSvcs:  []string{"api", "frontend"},
+			inShouldDeploy: true,
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.importComposeCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.importComposeCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+
+				opts.initEnvCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil).Times(2)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil).Times(2)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -132,17 +169,21 @@ func TestInitOpts_Run(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			var mockAppName, mockSvcName, mockSvcType string
+			var mockAppName, mockSvcType, mockDockerfilePath string
 			var mockAppPort uint16
+			mockSvcName := tc.inSvcName
 			opts := &initOpts{
 				ShouldDeploy:          tc.inShouldDeploy,
 				promptForShouldDeploy: tc.inPromptForShouldDeploy,
+				composeFile:           tc.inComposeFile,
 
 				initAppCmd:   climocks.NewMockactionCommand(ctrl),
 				initSvcCmd:   climocks.NewMockactionCommand(ctrl),
 				initEnvCmd:   climocks.NewMockactionCommand(ctrl),
 				deploySvcCmd: climocks.NewMockactionCommand(ctrl),
 
+				importComposeCmd: climocks.NewMockactionCommand(ctrl),
+
 				prompt: climocks.NewMockprompter(ctrl),
 
 				// These fields are used for logging, the values are not important for tests.
@@ -150,6 +191,9 @@ func TestInitOpts_Run(t *testing.T) {
 				svcName: &mockSvcName,
 				svcType: &mockSvcType,
 				svcPort: &mockAppPort,
+
+				dockerfilePath: &mockDockerfilePath,
+				composeSvcs:    &tc.inComposeSvcs,
 			}
 			tc.expect(opts)
 
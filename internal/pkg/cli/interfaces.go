@@ -10,8 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
@@ -81,6 +83,7 @@ type applicationDeleter interface {
 
 type environmentStore interface {
 	environmentCreator
+	environmentUpdater
 	environmentGetter
 	environmentLister
 	environmentDeleter
@@ -90,6 +93,10 @@ type environmentCreator interface {
 	CreateEnvironment(env *config.Environment) error
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentGetter interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 }
@@ -258,10 +265,20 @@ type wsSvcDirReader interface {
 	copilotDirGetter
 }
 
-type wsJobDirReader interface {
+type wsJobLister interface {
 	JobNames() ([]string, error)
 }
 
+type wsJobDirReader interface {
+	wsJobLister
+	ReadJobManifest(jobName string) ([]byte, error)
+	copilotDirGetter
+}
+
+type wsEnvironmentReader interface {
+	ReadEnvironmentManifest(envName string) ([]byte, error)
+}
+
 type wsPipelineReader interface {
 	wsServiceLister
 	wsPipelineManifestReader
@@ -277,6 +294,22 @@ type wsAddonManager interface {
 	wsSvcReader
 }
 
+type localContainerRunner interface {
+	Build(args *docker.BuildArguments) error
+	RunContainer(in *docker.RunOptions) error
+	StopContainer(name string) error
+	CreateNetwork(name string) error
+	RemoveNetwork(name string) error
+}
+
+type secretGetter interface {
+	GetSecret(name string) (string, error)
+}
+
+type envOutputsDescriber interface {
+	EnvOutputs() (map[string]string, error)
+}
+
 type artifactUploader interface {
 	PutArtifact(bucket, fileName string, data io.Reader) (string, error)
 }
@@ -291,6 +324,54 @@ type environmentDeployer interface {
 	StreamEnvironmentCreation(env *deploy.CreateEnvironmentInput) (<-chan []deploy.ResourceEvent, <-chan deploy.CreateEnvironmentResponse)
 	DeleteEnvironment(appName, envName string) error
 	GetEnvironment(appName, envName string) (*config.Environment, error)
+	ManagedELBAccessLogsBucket(appName, envName string) (string, error)
+}
+
+type envTemplateUpgrader interface {
+	UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error
+	UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error
+}
+
+type svcDeploymentsDescriber interface {
+	Params() (map[string]string, error)
+	Deployments(limit int) ([]describe.ServiceDeployment, error)
+}
+
+type svcRollbacker interface {
+	StreamServiceRollback(stackName string, params map[string]string, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error)
+}
+
+type serviceARNDescriber interface {
+	ServiceARN() (*ecs.ServiceArn, error)
+}
+
+type ecsCommandExecutor interface {
+	ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error)
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+	ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error)
+}
+
+type deniedActionsChecker interface {
+	DeniedActions(principalARN string, actions []string) ([]string, error)
+}
+
+type stateMachineARNDescriber interface {
+	StateMachineARN() (string, error)
+}
+
+type serviceTaskConfigDescriber interface {
+	TaskConfig() (*describe.ServiceTaskConfig, error)
+}
+
+type stateMachineExecutor interface {
+	Execute(stateMachineARN string) (string, error)
+}
+
+type svcDeployer interface {
+	StreamServiceDeployment(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error)
+	CreateServiceChangeSet(conf deploycfn.StackConfiguration, opts ...cloudformation.StackOption) (*deploycfn.ChangeSet, error)
+	StreamChangeSetExecution(cs *deploycfn.ChangeSet) (<-chan []deploy.ResourceEvent, <-chan error)
+	DeleteChangeSet(cs *deploycfn.ChangeSet) error
 }
 
 type svcDeleter interface {
@@ -337,6 +418,30 @@ type taskRunner interface {
 	Run() ([]*task.Task, error)
 }
 
+type taskWatcher interface {
+	WaitUntilStopped(tasks []*task.Task) error
+	ContainerExits(tasks []*task.Task) ([]*task.ContainerExit, error)
+}
+
+type taskStackLister interface {
+	ListTaskStacks(appName, envName string) ([]deploy.TaskStackInfo, error)
+	ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error)
+}
+
+type taskStackDeleter interface {
+	DeleteTask(name string) error
+}
+
+type taskGroupManager interface {
+	RunningTasks() ([]*task.Task, error)
+	StoppedTasks() ([]*task.Task, error)
+	Stop(reason string) ([]*task.Task, error)
+}
+
+type logGroupDeleter interface {
+	DeleteLogGroup(logGroup string) error
+}
+
 type defaultClusterGetter interface {
 	HasDefaultCluster() (bool, error)
 }
@@ -368,6 +473,10 @@ type versionGetter interface {
 	Version() (string, error)
 }
 
+type envVPCDescriber interface {
+	HasDefaultVPC() (bool, error)
+}
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 	ListPipelineNamesByTags(tags map[string]string) ([]string, error)
@@ -398,6 +507,7 @@ type appEnvSelector interface {
 type configSelector interface {
 	appEnvSelector
 	Service(prompt, help, app string) (string, error)
+	Job(prompt, help, app string) (string, error)
 }
 
 type deploySelector interface {
@@ -432,4 +542,6 @@ type credsSelector interface {
 
 type ec2Client interface {
 	HasDNSSupport(vpcID string) (bool, error)
+	VPCEndpointServices(vpcID string) ([]string, error)
+	AvailabilityZoneNames() ([]string, error)
 }
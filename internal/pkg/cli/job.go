@@ -20,8 +20,12 @@ Jobs are Amazon ECS tasks which run on a fixed schedule.`,
 	}
 
 	cmd.AddCommand(buildJobInitCmd())
-	// cmd.AddCommand(BuildJobPackageCmd())
+	cmd.AddCommand(buildJobListCmd())
+	cmd.AddCommand(buildJobShowCmd())
+	cmd.AddCommand(buildJobPackageCmd())
 	cmd.AddCommand(buildJobDeployCmd())
+	cmd.AddCommand(buildJobRunCmd())
+	cmd.AddCommand(buildJobLogsCmd())
 	cmd.AddCommand(buildJobDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
@@ -4,15 +4,27 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 
-	"github.com/aws/copilot-cli/internal/pkg/term/log"
-
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -31,15 +43,25 @@ type deployJobVars struct {
 type deployJobOpts struct {
 	deployJobVars
 
-	store        store
-	ws           wsJobDirReader
-	unmarshal    func(in []byte) (interface{}, error)
-	cmd          runner
-	sessProvider sessionProvider
+	store              store
+	ws                 wsJobDirReader
+	imageBuilderPusher imageBuilderPusher
+	unmarshal          func(in []byte) (interface{}, error)
+	s3                 artifactUploader
+	cmd                runner
+	addons             templater
+	appCFN             appResourcesGetter
+	jobCFN             svcDeployer
+	sessProvider       sessionProvider
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
+
+	// cached variables
+	targetApp         *config.Application
+	targetEnvironment *config.Environment
+	targetJob         *config.Workload
 }
 
 func newJobDeployOpts(vars deployJobVars) (*deployJobOpts, error) {
@@ -99,9 +121,40 @@ func (o *deployJobOpts) Ask() error {
 	return nil
 }
 
-// Execute builds and pushes the container image for the job.
+// Execute builds and pushes the container image for the job, then deploys the job's stack to the environment.
 func (o *deployJobOpts) Execute() error {
-	return nil
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	o.targetEnvironment = env
+
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return err
+	}
+	o.targetApp = app
+
+	job, err := o.store.GetJob(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get job configuration: %w", err)
+	}
+	o.targetJob = job
+
+	if err := o.configureClients(); err != nil {
+		return err
+	}
+
+	if err := o.pushToECRRepo(); err != nil {
+		return err
+	}
+
+	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
+	if err != nil {
+		return err
+	}
+
+	return o.deployJob(addonsURL)
 }
 
 // RecommendedActions returns follow-up actions the user can take after successfully executing the command.
@@ -179,6 +232,191 @@ func (o *deployJobOpts) askImageTag() error {
 	return nil
 }
 
+func (o *deployJobOpts) configureClients() error {
+	defaultSessEnvRegion, err := o.sessProvider.DefaultWithRegion(o.targetEnvironment.Region)
+	if err != nil {
+		return fmt.Errorf("create ECR session with region %s: %w", o.targetEnvironment.Region, err)
+	}
+
+	envSession, err := o.sessProvider.FromRole(o.targetEnvironment.ManagerRoleARN, o.targetEnvironment.Region)
+	if err != nil {
+		return fmt.Errorf("assuming environment manager role: %w", err)
+	}
+
+	// ECR client against tools account profile AND target environment region
+	repoName := fmt.Sprintf("%s/%s", o.appName, o.name)
+	registry := ecr.New(defaultSessEnvRegion)
+	o.imageBuilderPusher, err = repository.New(repoName, registry)
+	if err != nil {
+		return fmt.Errorf("initiate image builder pusher: %w", err)
+	}
+
+	o.s3 = s3.New(defaultSessEnvRegion)
+
+	// CF client against env account profile AND target environment region
+	o.jobCFN = cloudformation.New(envSession)
+
+	addonsSvc, err := addon.New(o.name)
+	if err != nil {
+		return fmt.Errorf("initiate addons service: %w", err)
+	}
+	o.addons = addonsSvc
+
+	// client to retrieve an application's resources created with CloudFormation
+	defaultSess, err := o.sessProvider.Default()
+	if err != nil {
+		return fmt.Errorf("create default session: %w", err)
+	}
+	o.appCFN = cloudformation.New(defaultSess)
+	return nil
+}
+
+func (o *deployJobOpts) pushToECRRepo() error {
+	dockerBuildInput, err := o.getBuildArgs()
+	if err != nil {
+		return err
+	}
+
+	if err := o.imageBuilderPusher.BuildAndPush(docker.New(), dockerBuildInput); err != nil {
+		return fmt.Errorf("build and push image: %w", err)
+	}
+	return nil
+}
+
+func (o *deployJobOpts) getBuildArgs() (*docker.BuildArguments, error) {
+	type dfArgs interface {
+		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+	}
+
+	mft, err := o.manifest()
+	if err != nil {
+		return nil, err
+	}
+	job, ok := mft.(dfArgs)
+	if !ok {
+		return nil, fmt.Errorf("job %s does not have required method Build()", o.name)
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return nil, fmt.Errorf("get copilot directory: %w", err)
+	}
+	wsRoot := filepath.Dir(copilotDir)
+
+	args := job.BuildArgs(wsRoot)
+	return &docker.BuildArguments{
+		Dockerfile: *args.Dockerfile,
+		Context:    *args.Context,
+		Args:       args.Args,
+		ImageTag:   o.imageTag,
+		Builder:    aws.StringValue(args.Builder),
+		Env:        args.Env,
+	}, nil
+}
+
+// pushAddonsTemplateToS3Bucket generates the addons template for the job and pushes it to S3.
+// If the job doesn't have any addons, it returns the empty string and no errors.
+// If the job has addons, it returns the URL of the S3 object storing the addons template.
+func (o *deployJobOpts) pushAddonsTemplateToS3Bucket() (string, error) {
+	template, err := o.addons.Template()
+	if err != nil {
+		var notExistErr *addon.ErrDirNotExist
+		if errors.As(err, &notExistErr) {
+			// addons doesn't exist for job, the url is empty.
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve addons template: %w", err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return "", fmt.Errorf("get app resources: %w", err)
+	}
+
+	reader := strings.NewReader(template)
+	url, err := o.s3.PutArtifact(resources.S3Bucket, fmt.Sprintf(config.AddonsCfnTemplateNameFormat, o.name), reader)
+	if err != nil {
+		return "", fmt.Errorf("put addons artifact to bucket %s: %w", resources.S3Bucket, err)
+	}
+	return url, nil
+}
+
+func (o *deployJobOpts) manifest() (interface{}, error) {
+	raw, err := o.ws.ReadJobManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read job %s manifest from workspace: %w", o.name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal job %s manifest: %w", o.name, err)
+	}
+	return mft, nil
+}
+
+func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, error) {
+	resources, err := o.appCFN.GetAppResourcesByRegion(o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get application %s resources from region %s: %w", o.targetApp.Name, o.targetEnvironment.Region, err)
+	}
+	repoURL, ok := resources.RepositoryURLs[o.name]
+	if !ok {
+		return nil, &errRepoNotFound{
+			svcName:      o.name,
+			envRegion:    o.targetEnvironment.Region,
+			appAccountID: o.targetApp.AccountID,
+		}
+	}
+	return &stack.RuntimeConfig{
+		ImageRepoURL:      repoURL,
+		ImageTag:          o.imageTag,
+		AddonsTemplateURL: addonsURL,
+		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
+	}, nil
+}
+
+func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.StackConfiguration, error) {
+	mft, err := o.manifest()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := o.runtimeConfig(addonsURL)
+	if err != nil {
+		return nil, err
+	}
+	var conf cloudformation.StackConfiguration
+	switch t := mft.(type) {
+	case *manifest.ScheduledJob:
+		conf, err = stack.NewScheduledJob(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+	default:
+		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create stack configuration: %w", err)
+	}
+	return conf, nil
+}
+
+func (o *deployJobOpts) deployJob(addonsURL string) error {
+	conf, err := o.stackConfiguration(addonsURL)
+	if err != nil {
+		return err
+	}
+	o.spinner.Start(
+		fmt.Sprintf("Deploying %s to %s.",
+			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.name), color.HighlightUserInput(o.imageTag)),
+			color.HighlightUserInput(o.targetEnvironment.Name)))
+
+	// Display updates while the deployment is happening.
+	stackEvents, responses := o.jobCFN.StreamServiceDeployment(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	for stackEvent := range stackEvents {
+		o.spinner.Events(humanizeWorkloadEvents(stackEvent))
+	}
+	if err := <-responses; err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to deploy job.\n"))
+		return fmt.Errorf("deploy job: %w", err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Deployed %s to %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.targetEnvironment.Name)))
+	return nil
+}
+
 // buildJobDeployCmd builds the `job deploy` subcommand.
 func buildJobDeployCmd() *cobra.Command {
 	vars := deployJobVars{}
@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobListAppNamePrompt     = "Which application's jobs would you like to list?"
+	jobListAppNameHelpPrompt = "An application groups all of your jobs together."
+)
+
+type listJobVars struct {
+	appName             string
+	shouldOutputJSON    bool
+	shouldShowLocalJobs bool
+}
+
+type listJobOpts struct {
+	listJobVars
+
+	// Interfaces to dependencies.
+	store store
+	ws    wsJobLister
+	w     io.Writer
+	sel   appSelector
+}
+
+func newListJobOpts(vars listJobVars) (*listJobOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, err
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, err
+	}
+	return &listJobOpts{
+		listJobVars: vars,
+
+		store: store,
+		ws:    ws,
+		w:     os.Stdout,
+		sel:   selector.NewSelect(prompt.New(), store),
+	}, nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *listJobOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+
+	name, err := o.sel.Application(jobListAppNamePrompt, jobListAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application name: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+// Execute lists the jobs in the application.
+func (o *listJobOpts) Execute() error {
+	// Ensure the application actually exists before we try to list its jobs.
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application: %w", err)
+	}
+
+	jobs, err := o.store.ListJobs(o.appName)
+	if err != nil {
+		return err
+	}
+
+	if o.shouldShowLocalJobs {
+		localNames, err := o.ws.JobNames()
+		if err != nil {
+			return fmt.Errorf("get local job names: %w", err)
+		}
+		jobs = filterWorkloadsByName(jobs, localNames)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(jobs)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		o.humanOutput(jobs)
+	}
+	return nil
+}
+
+func (o *listJobOpts) humanOutput(jobs []*config.Workload) {
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\n", "Name", "Type")
+	nameLengthMax := len("Name")
+	typeLengthMax := len("Type")
+	for _, job := range jobs {
+		nameLengthMax = int(math.Max(float64(nameLengthMax), float64(len(job.Name))))
+		typeLengthMax = int(math.Max(float64(typeLengthMax), float64(len(job.Type))))
+	}
+	fmt.Fprintf(writer, "%s\t%s\n", strings.Repeat("-", nameLengthMax), strings.Repeat("-", typeLengthMax))
+	for _, job := range jobs {
+		fmt.Fprintf(writer, "%s\t%s\n", job.Name, job.Type)
+	}
+	writer.Flush()
+}
+
+func (o *listJobOpts) jsonOutput(jobs []*config.Workload) (string, error) {
+	type out struct {
+		Jobs []*config.Workload `json:"jobs"`
+	}
+	b, err := json.Marshal(out{Jobs: jobs})
+	if err != nil {
+		return "", fmt.Errorf("marshal jobs: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildJobListCmd builds the command for listing jobs in an application.
+func buildJobListCmd() *cobra.Command {
+	vars := listJobVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists all the jobs in an application.",
+		Example: `
+  Lists all the jobs for the "myapp" application.
+  /code $ copilot job ls --app myapp`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListJobOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldShowLocalJobs, localFlag, false, localJobFlagDescription)
+	return cmd
+}
@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListJobOpts_Execute(t *testing.T) {
+	mockError := fmt.Errorf("error")
+
+	testCases := map[string]struct {
+		vars    listJobVars
+		mocking func(mockStore *mocks.Mockstore, mockWs *mocks.MockwsJobLister)
+
+		expectedErr     error
+		expectedContent string
+	}{
+		"with json outputs": {
+			vars: listJobVars{
+				shouldOutputJSON: true,
+				appName:          "coolapp",
+			},
+			mocking: func(mockStore *mocks.Mockstore, _ *mocks.MockwsJobLister) {
+				mockStore.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("coolapp").Return([]*config.Workload{
+					{Name: "mailer"},
+					{Name: "reporter"},
+				}, nil)
+			},
+			expectedContent: "{\"jobs\":[{\"app\":\"\",\"name\":\"mailer\",\"type\":\"\"},{\"app\":\"\",\"name\":\"reporter\",\"type\":\"\"}]}\n",
+		},
+		"with human outputs": {
+			vars: listJobVars{
+				appName: "coolapp",
+			},
+			mocking: func(mockStore *mocks.Mockstore, _ *mocks.MockwsJobLister) {
+				mockStore.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("coolapp").Return([]*config.Workload{
+					{Name: "mailer", Type: "Scheduled Job"},
+					{Name: "reporter", Type: "Scheduled Job"},
+				}, nil)
+			},
+			expectedContent: "Name                Type\n--------            -------------\nmailer              Scheduled Job\nreporter            Scheduled Job\n",
+		},
+		"with invalid app name": {
+			vars: listJobVars{
+				appName: "coolapp",
+			},
+			mocking: func(mockStore *mocks.Mockstore, _ *mocks.MockwsJobLister) {
+				mockStore.EXPECT().GetApplication("coolapp").Return(nil, mockError)
+				mockStore.EXPECT().ListJobs(gomock.Any()).Times(0)
+			},
+			expectedErr: fmt.Errorf("get application: %w", mockError),
+		},
+		"with failed call to list": {
+			vars: listJobVars{
+				appName: "coolapp",
+			},
+			mocking: func(mockStore *mocks.Mockstore, _ *mocks.MockwsJobLister) {
+				mockStore.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("coolapp").Return(nil, mockError)
+			},
+			expectedErr: mockError,
+		},
+		"with failed call to list local jobs": {
+			vars: listJobVars{
+				shouldShowLocalJobs: true,
+				appName:             "coolapp",
+			},
+			mocking: func(mockStore *mocks.Mockstore, mockWs *mocks.MockwsJobLister) {
+				mockStore.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("coolapp").Return([]*config.Workload{
+					{Name: "mailer", Type: "Scheduled Job"},
+				}, nil)
+				mockWs.EXPECT().JobNames().Return(nil, mockError)
+			},
+			expectedErr: fmt.Errorf("get local job names: %w", mockError),
+		},
+		"with local flag enabled": {
+			vars: listJobVars{
+				shouldShowLocalJobs: true,
+				appName:             "coolapp",
+			},
+			mocking: func(mockStore *mocks.Mockstore, mockWs *mocks.MockwsJobLister) {
+				mockStore.EXPECT().GetApplication("coolapp").Return(&config.Application{}, nil)
+				mockStore.EXPECT().ListJobs("coolapp").Return([]*config.Workload{
+					{Name: "mailer", Type: "Scheduled Job"},
+					{Name: "reporter", Type: "Scheduled Job"},
+				}, nil)
+				mockWs.EXPECT().JobNames().Return([]string{"mailer"}, nil)
+			},
+			expectedContent: "Name                Type\n------              -------------\nmailer              Scheduled Job\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsJobLister(ctrl)
+			tc.mocking(mockStore, mockWs)
+
+			b := &bytes.Buffer{}
+			opts := listJobOpts{
+				listJobVars: tc.vars,
+				store:       mockStore,
+				ws:          mockWs,
+				w:           b,
+			}
+
+			err := opts.Execute()
+
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedContent, b.String())
+			}
+		})
+	}
+}
+
+func TestListJobOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+
+		mockSel func(m *mocks.MockappSelector)
+
+		wantedApp string
+	}{
+		"with no flags set": {
+			mockSel: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(jobListAppNamePrompt, jobListAppNameHelpPrompt).Return("myapp", nil)
+			},
+			wantedApp: "myapp",
+		},
+		"with app flag set": {
+			mockSel: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Times(0)
+			},
+			inApp:     "myapp",
+			wantedApp: "myapp",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockappSelector(ctrl)
+			tc.mockSel(mockSel)
+
+			opts := &listJobOpts{
+				listJobVars: listJobVars{
+					appName: tc.inApp,
+				},
+				sel: mockSel,
+			}
+
+			err := opts.Ask()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName, "expected application names to match")
+		})
+	}
+}
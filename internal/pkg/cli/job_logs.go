@@ -0,0 +1,259 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobLogAppNamePrompt     = "Which application does your job belong to?"
+	jobLogAppNameHelpPrompt = "An application groups all of your jobs together."
+	jobLogNamePrompt        = "Which job's logs would you like to show?"
+	jobLogNameHelpPrompt    = "The logs of a deployed job will be shown."
+	jobLogEnvNamePrompt     = "Which environment is the job %s deployed to?"
+	jobLogEnvNameHelpPrompt = "The logs of the job in this environment will be shown."
+)
+
+type jobLogsVars struct {
+	shouldOutputJSON bool
+	follow           bool
+	limit            int
+	jobName          string
+	envName          string
+	appName          string
+	humanStartTime   string
+	humanEndTime     string
+	taskIDs          []string
+	since            time.Duration
+}
+
+type jobLogsOpts struct {
+	jobLogsVars
+
+	// internal states
+	startTime *int64
+	endTime   *int64
+
+	w           io.Writer
+	configStore store
+	sel         configSelector
+	logsSvc     logEventsWriter
+	initLogsSvc func() error // Overriden in tests.
+}
+
+func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment config store: %w", err)
+	}
+	opts := &jobLogsOpts{
+		jobLogsVars: vars,
+		w:           log.OutputWriter,
+		configStore: configStore,
+		sel:         selector.NewConfigSelect(prompt.New(), configStore),
+	}
+	opts.initLogsSvc = func() error {
+		env, err := opts.configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment: %w", err)
+		}
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		// Jobs write to a log group of the same format as services.
+		opts.logsSvc = ecslogging.NewServiceClient(sess, opts.appName, opts.envName, opts.jobName)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *jobLogsOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.configStore.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.jobName != "" {
+			if _, err := o.configStore.GetJob(o.appName, o.jobName); err != nil {
+				return err
+			}
+		}
+		if o.envName != "" {
+			if _, err := o.configStore.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.since != 0 && o.humanStartTime != "" {
+		return errors.New("only one of --since or --start-time may be used")
+	}
+
+	if o.humanEndTime != "" && o.follow {
+		return errors.New("only one of --follow or --end-time may be used")
+	}
+
+	if o.since != 0 {
+		if o.since < 0 {
+			return fmt.Errorf("--since must be greater than 0")
+		}
+		o.startTime = parseSince(o.since)
+	}
+
+	if o.humanStartTime != "" {
+		startTime, err := parseRFC3339(o.humanStartTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--start-time" flag: %w`, o.humanStartTime, err)
+		}
+		o.startTime = aws.Int64(startTime)
+	}
+
+	if o.humanEndTime != "" {
+		endTime, err := parseRFC3339(o.humanEndTime)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--end-time" flag: %w`, o.humanEndTime, err)
+		}
+		o.endTime = aws.Int64(endTime)
+	}
+
+	if o.limit != 0 && (o.limit < cwGetLogEventsLimitMin || o.limit > cwGetLogEventsLimitMax) {
+		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
+	}
+
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *jobLogsOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askJobName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute outputs logs of the job.
+func (o *jobLogsOpts) Execute() error {
+	if err := o.initLogsSvc(); err != nil {
+		return err
+	}
+	eventsWriter := ecslogging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = ecslogging.WriteJSONLogs
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	err := o.logsSvc.WriteLogEvents(ecslogging.WriteLogEventsOpts{
+		Follow:    o.follow,
+		Limit:     limit,
+		EndTime:   o.endTime,
+		StartTime: o.startTime,
+		TaskIDs:   o.taskIDs,
+		OnEvents:  eventsWriter,
+	})
+	if err != nil {
+		return fmt.Errorf("write log events for job %s: %w", o.jobName, err)
+	}
+	return nil
+}
+
+func (o *jobLogsOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(jobLogAppNamePrompt, jobLogAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *jobLogsOpts) askJobName() error {
+	if o.jobName != "" {
+		return nil
+	}
+	job, err := o.sel.Job(jobLogNamePrompt, jobLogNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select job for application %s: %w", o.appName, err)
+	}
+	o.jobName = job
+	return nil
+}
+
+func (o *jobLogsOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(jobLogEnvNamePrompt, color.HighlightUserInput(o.jobName)), jobLogEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildJobLogsCmd builds the command for displaying job logs in an application.
+func buildJobLogsCmd() *cobra.Command {
+	vars := jobLogsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Displays logs of a deployed job.",
+
+		Example: `
+  Displays logs of the job "my-job" in environment "test".
+  /code $ copilot job logs -n my-job -e test
+  Displays logs in the last hour.
+  /code $ copilot job logs --since 1h
+  Displays logs from 2006-01-02T15:04:05 to 2006-01-02T15:05:05.
+  /code $ copilot job logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00
+  Displays logs from specific task IDs.
+  /code $ copilot job logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
+  Displays logs in real time.
+  /code $ copilot job logs --follow`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobLogOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
+	cmd.Flags().StringVar(&vars.humanEndTime, endTimeFlag, "", endTimeFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, 0, sinceFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
+	return cmd
+}
@@ -0,0 +1,259 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobLogs_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp       string
+		inputJob       string
+		inputEnv       string
+		inputLimit     int
+		inputFollow    bool
+		inputStartTime string
+		inputEndTime   string
+		inputSince     time.Duration
+
+		mockstore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"with no flag set": {
+			mockstore: func(m *mocks.Mockstore) {},
+		},
+		"valid app, job and environment": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			inputEnv: "test",
+			mockstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("my-app", "my-job").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+			},
+		},
+		"invalid job name": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			mockstore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("my-app", "my-job").Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns error if since and startTime flags are set together": {
+			inputSince:     time.Minute,
+			inputStartTime: "1970-01-01T01:01:01+00:00",
+			mockstore:      func(m *mocks.Mockstore) {},
+			wantedError:    fmt.Errorf("only one of --since or --start-time may be used"),
+		},
+		"returns error if follow and endTime flags are set together": {
+			inputFollow:  true,
+			inputEndTime: "1971-01-01T01:01:01+00:00",
+			mockstore:    func(m *mocks.Mockstore) {},
+			wantedError:  fmt.Errorf("only one of --follow or --end-time may be used"),
+		},
+		"returns error if invalid start time flag value": {
+			inputStartTime: "badStartTime",
+			mockstore:      func(m *mocks.Mockstore) {},
+			wantedError:    fmt.Errorf("invalid argument badStartTime for \"--start-time\" flag: reading time value badStartTime: parsing time \"badStartTime\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"badStartTime\" as \"2006\""),
+		},
+		"returns error if invalid since flag value": {
+			inputSince:  -time.Minute,
+			mockstore:   func(m *mocks.Mockstore) {},
+			wantedError: fmt.Errorf("--since must be greater than 0"),
+		},
+		"returns error if limit value is out of bounds": {
+			inputLimit:  10001,
+			mockstore:   func(m *mocks.Mockstore) {},
+			wantedError: fmt.Errorf("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockstore(mockStore)
+
+			opts := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					appName:        tc.inputApp,
+					jobName:        tc.inputJob,
+					envName:        tc.inputEnv,
+					limit:          tc.inputLimit,
+					follow:         tc.inputFollow,
+					humanStartTime: tc.inputStartTime,
+					humanEndTime:   tc.inputEndTime,
+					since:          tc.inputSince,
+				},
+				configStore: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobLogs_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"with all flags": {
+			inputApp:   "my-app",
+			inputJob:   "my-job",
+			inputEnv:   "test",
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+			wantedApp:  "my-app",
+			wantedJob:  "my-job",
+			wantedEnv:  "test",
+		},
+		"prompts for app, job and environment": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(jobLogAppNamePrompt, jobLogAppNameHelpPrompt).Return("my-app", nil),
+					m.EXPECT().Job(jobLogNamePrompt, jobLogNameHelpPrompt, "my-app").Return("my-job", nil),
+					m.EXPECT().Environment(gomock.Any(), jobLogEnvNameHelpPrompt, "my-app").Return("test", nil),
+				)
+			},
+			wantedApp: "my-app",
+			wantedJob: "my-job",
+			wantedEnv: "test",
+		},
+		"returns error if fail to select job": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Job(gomock.Any(), gomock.Any(), "my-app").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select job for application my-app: some error"),
+		},
+		"returns error if fail to select environment": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "my-app").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					appName: tc.inputApp,
+					jobName: tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedJob, opts.jobName)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestJobLogs_Execute(t *testing.T) {
+	mockStartTime := int64(123456789)
+	mockLimit := int64(10)
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MocklogEventsWriter)
+
+		wantedError error
+	}{
+		"success": {
+			setupMocks: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param ecslogging.WriteLogEventsOpts) {
+					require.Equal(t, &mockStartTime, param.StartTime)
+					require.Equal(t, &mockLimit, param.Limit)
+					require.Equal(t, []string{"mockTaskID"}, param.TaskIDs)
+				}).Return(nil)
+			},
+		},
+		"returns error if fail to get event logs": {
+			setupMocks: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("write log events for job mockJob: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLogsSvc := mocks.NewMocklogEventsWriter(ctrl)
+			tc.setupMocks(mockLogsSvc)
+
+			opts := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					jobName: "mockJob",
+					limit:   10,
+					taskIDs: []string{"mockTaskID"},
+				},
+				startTime:   &mockStartTime,
+				logsSvc:     mockLogsSvc,
+				initLogsSvc: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageJobOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+		inJobName string
+
+		setupMocks func(ws *mocks.MockwsJobLister, store *mocks.Mockstore)
+
+		wantedErrorS string
+	}{
+		"invalid workspace": {
+			setupMocks: func(ws *mocks.MockwsJobLister, store *mocks.Mockstore) {
+				ws.EXPECT().JobNames().Times(0)
+				store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErrorS: "could not find an application attached to this workspace, please run `app init` first",
+		},
+		"error while fetching jobs": {
+			inAppName: "phonetool",
+			inJobName: "report",
+			setupMocks: func(ws *mocks.MockwsJobLister, store *mocks.Mockstore) {
+				ws.EXPECT().JobNames().Return(nil, errors.New("some error"))
+			},
+			wantedErrorS: "list jobs in the workspace: some error",
+		},
+		"error when job not in workspace": {
+			inAppName: "phonetool",
+			inJobName: "report",
+			setupMocks: func(ws *mocks.MockwsJobLister, store *mocks.Mockstore) {
+				ws.EXPECT().JobNames().Return([]string{"mailer"}, nil)
+			},
+			wantedErrorS: "job 'report' does not exist in the workspace",
+		},
+		"error while fetching environment": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(ws *mocks.MockwsJobLister, store *mocks.Mockstore) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, &config.ErrNoSuchEnvironment{
+					ApplicationName: "phonetool",
+					EnvironmentName: "test",
+				})
+			},
+			wantedErrorS: (&config.ErrNoSuchEnvironment{
+				ApplicationName: "phonetool",
+				EnvironmentName: "test",
+			}).Error(),
+		},
+		"valid job and environment": {
+			inAppName: "phonetool",
+			inJobName: "report",
+			inEnvName: "test",
+			setupMocks: func(ws *mocks.MockwsJobLister, store *mocks.Mockstore) {
+				ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWorkspace := mocks.NewMockwsJobLister(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockWorkspace, mockStore)
+
+			opts := &packageJobOpts{
+				packageJobVars: packageJobVars{
+					name:    tc.inJobName,
+					envName: tc.inEnvName,
+					appName: tc.inAppName,
+				},
+				ws:    mockWorkspace,
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErrorS != "" {
+				require.EqualError(t, err, tc.wantedErrorS)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPackageJobOpts_Ask(t *testing.T) {
+	const testAppName = "phonetool"
+	testCases := map[string]struct {
+		inJobName string
+		inEnvName string
+		inTag     string
+
+		expectSelector func(m *mocks.MockwsSelector)
+		expectPrompt   func(m *mocks.Mockprompter)
+		expectRunner   func(m *mocks.Mockrunner)
+
+		wantedJobName string
+		wantedEnvName string
+		wantedTag     string
+	}{
+		"prompt for all options": {
+			expectRunner: func(m *mocks.Mockrunner) {
+				m.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("not a git repo"))
+			},
+			expectSelector: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Job(jobPackageJobNamePrompt, "").Return("report", nil)
+				m.EXPECT().Environment(jobPackageEnvNamePrompt, "", testAppName).Return("test", nil)
+			},
+			expectPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(inputImageTagPrompt, "", gomock.Any()).Return("v1.0.0", nil)
+			},
+
+			wantedJobName: "report",
+			wantedEnvName: "test",
+			wantedTag:     "v1.0.0",
+		},
+		"don't prompt": {
+			inJobName: "report",
+			inEnvName: "test",
+			inTag:     "v1.0.0",
+
+			expectSelector: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Job(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectRunner: func(m *mocks.Mockrunner) {},
+
+			wantedJobName: "report",
+			wantedEnvName: "test",
+			wantedTag:     "v1.0.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockwsSelector(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockRunner := mocks.NewMockrunner(ctrl)
+			tc.expectSelector(mockSelector)
+			tc.expectPrompt(mockPrompt)
+			tc.expectRunner(mockRunner)
+
+			opts := &packageJobOpts{
+				packageJobVars: packageJobVars{
+					name:    tc.inJobName,
+					envName: tc.inEnvName,
+					tag:     tc.inTag,
+					appName: testAppName,
+				},
+				sel:    mockSelector,
+				prompt: mockPrompt,
+				runner: mockRunner,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJobName, opts.name)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+			require.Equal(t, tc.wantedTag, opts.tag)
+		})
+	}
+}
+
+func TestPackageJobOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		newPackageCmd func(ctrl *gomock.Controller) func(*packageJobOpts) error
+
+		wantedErr error
+	}{
+		"returns error if fail to create the package command": {
+			newPackageCmd: func(ctrl *gomock.Controller) func(*packageJobOpts) error {
+				return func(*packageJobOpts) error {
+					return errors.New("some error")
+				}
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"calls the package command": {
+			newPackageCmd: func(ctrl *gomock.Controller) func(*packageJobOpts) error {
+				return func(o *packageJobOpts) error {
+					m := mocks.NewMockexecutor(ctrl)
+					m.EXPECT().Execute().Return(nil)
+					o.packageCmd = m
+					return nil
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := &packageJobOpts{
+				packageJobVars: packageJobVars{
+					name:    "report",
+					envName: "test",
+					appName: "phonetool",
+				},
+				newPackageCmd: tc.newPackageCmd(ctrl),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobRunAppNamePrompt     = "Which application does your job belong to?"
+	jobRunAppNameHelpPrompt = "An application groups all of your jobs together."
+	jobRunJobNamePrompt     = "Which job would you like to run?"
+	jobRunJobNameHelpPrompt = "The job runs once, outside of its schedule."
+	jobRunEnvNamePrompt     = "Which environment would you like to run %s in?"
+	jobRunEnvNameHelpPrompt = "The job must already be deployed to this environment."
+)
+
+type runJobVars struct {
+	appName string
+	jobName string
+	envName string
+}
+
+type runJobOpts struct {
+	runJobVars
+
+	store store
+	sel   configSelector
+
+	describer   stateMachineARNDescriber
+	executor    stateMachineExecutor
+	initClients func() error // Overriden in tests.
+}
+
+func newRunJobOpts(vars runJobVars) (*runJobOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	opts := &runJobOpts{
+		runJobVars: vars,
+		store:      configStore,
+		sel:        selector.NewConfigSelect(prompt.New(), configStore),
+	}
+	opts.initClients = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", opts.envName, err)
+		}
+		d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.jobName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create describer for job %s: %w", opts.jobName, err)
+		}
+		opts.describer = d
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("assume environment manager role: %w", err)
+		}
+		opts.executor = stepfunctions.New(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *runJobOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.jobName != "" {
+		if _, err := o.store.GetJob(o.appName, o.jobName); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *runJobOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askJobName(); err != nil {
+		return err
+	}
+	return o.askEnvName()
+}
+
+// Execute starts an execution of the state machine that runs the job.
+func (o *runJobOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	stateMachineARN, err := o.describer.StateMachineARN()
+	if err != nil {
+		return fmt.Errorf("get state machine of job %s in environment %s: %w", o.jobName, o.envName, err)
+	}
+	if _, err := o.executor.Execute(stateMachineARN); err != nil {
+		return fmt.Errorf("run job %s: %w", o.jobName, err)
+	}
+	log.Successf("Invoked job %s in environment %s.\n", color.HighlightUserInput(o.jobName), color.HighlightUserInput(o.envName))
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *runJobOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to follow the logs of the job.",
+			color.HighlightCode(fmt.Sprintf("copilot job logs -n %s -e %s --follow", o.jobName, o.envName))),
+	}
+}
+
+func (o *runJobOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(jobRunAppNamePrompt, jobRunAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *runJobOpts) askJobName() error {
+	if o.jobName != "" {
+		return nil
+	}
+	job, err := o.sel.Job(jobRunJobNamePrompt, jobRunJobNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select job for application %s: %w", o.appName, err)
+	}
+	o.jobName = job
+	return nil
+}
+
+func (o *runJobOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(jobRunEnvNamePrompt, color.HighlightUserInput(o.jobName)), jobRunEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildJobRunCmd builds the command for running a job once outside of its schedule.
+func buildJobRunCmd() *cobra.Command {
+	vars := runJobVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Invokes a deployed job once, outside of its schedule.",
+
+		Example: `
+  Runs the job "report" in the "test" environment.
+  /code $ copilot job run -n report -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunJobOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	return cmd
+}
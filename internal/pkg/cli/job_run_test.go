@@ -0,0 +1,226 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRun_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid app, job and environment": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			inputEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("my-app", "my-job").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+			},
+		},
+		"invalid app name": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"invalid environment name": {
+			inputApp: "my-app",
+			inputEnv: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &runJobOpts{
+				runJobVars: runJobVars{
+					appName: tc.inputApp,
+					jobName: tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobRun_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"prompts for app, job and environment": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(jobRunAppNamePrompt, jobRunAppNameHelpPrompt).Return("my-app", nil),
+					m.EXPECT().Job(jobRunJobNamePrompt, jobRunJobNameHelpPrompt, "my-app").Return("my-job", nil),
+					m.EXPECT().Environment(gomock.Any(), jobRunEnvNameHelpPrompt, "my-app").Return("test", nil),
+				)
+			},
+			wantedApp: "my-app",
+			wantedJob: "my-job",
+			wantedEnv: "test",
+		},
+		"skips prompting if flags are set": {
+			inputApp:   "my-app",
+			inputJob:   "my-job",
+			inputEnv:   "test",
+			setupMocks: func(m *mocks.MockconfigSelector) {},
+			wantedApp:  "my-app",
+			wantedJob:  "my-job",
+			wantedEnv:  "test",
+		},
+		"returns error if fail to select application": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"returns error if fail to select job": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Job(gomock.Any(), gomock.Any(), "my-app").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select job for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &runJobOpts{
+				runJobVars: runJobVars{
+					appName: tc.inputApp,
+					jobName: tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedJob, opts.jobName)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestJobRun_Execute(t *testing.T) {
+	const mockARN = "arn:aws:states:us-west-2:123456789:stateMachine:my-app-test-my-job"
+	testCases := map[string]struct {
+		setupMocks func(d *mocks.MockstateMachineARNDescriber, e *mocks.MockstateMachineExecutor)
+
+		wantedError error
+	}{
+		"returns error if fail to get the state machine": {
+			setupMocks: func(d *mocks.MockstateMachineARNDescriber, e *mocks.MockstateMachineExecutor) {
+				d.EXPECT().StateMachineARN().Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get state machine of job my-job in environment test: some error"),
+		},
+		"returns error if fail to execute the state machine": {
+			setupMocks: func(d *mocks.MockstateMachineARNDescriber, e *mocks.MockstateMachineExecutor) {
+				d.EXPECT().StateMachineARN().Return(mockARN, nil)
+				e.EXPECT().Execute(mockARN).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("run job my-job: some error"),
+		},
+		"success": {
+			setupMocks: func(d *mocks.MockstateMachineARNDescriber, e *mocks.MockstateMachineExecutor) {
+				d.EXPECT().StateMachineARN().Return(mockARN, nil)
+				e.EXPECT().Execute(mockARN).Return("mockExecutionARN", nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDescriber := mocks.NewMockstateMachineARNDescriber(ctrl)
+			mockExecutor := mocks.NewMockstateMachineExecutor(ctrl)
+			tc.setupMocks(mockDescriber, mockExecutor)
+
+			opts := &runJobOpts{
+				runJobVars: runJobVars{
+					appName: "my-app",
+					jobName: "my-job",
+					envName: "test",
+				},
+				describer:   mockDescriber,
+				executor:    mockExecutor,
+				initClients: func() error { return nil },
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
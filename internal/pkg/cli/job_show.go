@@ -0,0 +1,180 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobShowAppNamePrompt     = "Which application's job would you like to show?"
+	jobShowAppNameHelpPrompt = "An application groups all of your jobs together."
+	jobShowJobNamePrompt     = "Which job of %s would you like to show?"
+	jobShowJobNameHelpPrompt = "The details of a job will be shown (e.g., schedule, timeout, retries)."
+)
+
+type showJobVars struct {
+	shouldOutputJSON      bool
+	shouldOutputResources bool
+	appName               string
+	jobName               string
+}
+
+type showJobOpts struct {
+	showJobVars
+
+	w             io.Writer
+	store         store
+	describer     describer
+	sel           configSelector
+	initDescriber func() error // Overriden in tests.
+}
+
+func newShowJobOpts(vars showJobVars) (*showJobOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+
+	opts := &showJobOpts{
+		showJobVars: vars,
+		store:       ssmStore,
+		w:           log.OutputWriter,
+		sel:         selector.NewConfigSelect(prompt.New(), ssmStore),
+	}
+	opts.initDescriber = func() error {
+		d, err := describe.NewScheduledJobDescriber(describe.NewScheduledJobConfig{
+			App:             opts.appName,
+			Job:             opts.jobName,
+			ConfigStore:     ssmStore,
+			DeployStore:     deployStore,
+			EnableResources: opts.shouldOutputResources,
+		})
+		if err != nil {
+			return fmt.Errorf("creating describer for job %s in application %s: %w", opts.jobName, opts.appName, err)
+		}
+		opts.describer = d
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *showJobOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.jobName != "" {
+		if _, err := o.store.GetJob(o.appName, o.jobName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *showJobOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askJobName()
+}
+
+// Execute shows the configuration of the job in each environment it's deployed to.
+func (o *showJobOpts) Execute() error {
+	if o.jobName == "" {
+		return nil
+	}
+	if err := o.initDescriber(); err != nil {
+		return err
+	}
+	job, err := o.describer.Describe()
+	if err != nil {
+		return fmt.Errorf("describe job %s: %w", o.jobName, err)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := job.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	} else {
+		fmt.Fprint(o.w, job.HumanString())
+	}
+	return nil
+}
+
+func (o *showJobOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	appName, err := o.sel.Application(jobShowAppNamePrompt, jobShowAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application name: %w", err)
+	}
+	o.appName = appName
+	return nil
+}
+
+func (o *showJobOpts) askJobName() error {
+	if o.jobName != "" {
+		return nil
+	}
+	jobName, err := o.sel.Job(fmt.Sprintf(jobShowJobNamePrompt, color.HighlightUserInput(o.appName)),
+		jobShowJobNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select job for application %s: %w", o.appName, err)
+	}
+	o.jobName = jobName
+	return nil
+}
+
+// buildJobShowCmd builds the command for showing jobs in an application.
+func buildJobShowCmd() *cobra.Command {
+	vars := showJobVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows info about a deployed job per environment.",
+		Long:  "Shows info about a deployed job, including its schedule, timeout, retries, capacity and related resources per environment.",
+
+		Example: `
+  Shows info about the job "report"
+  /code $ copilot job show -n report`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowJobOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, jobResourcesFlagDescription)
+	return cmd
+}
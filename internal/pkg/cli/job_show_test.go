@@ -0,0 +1,238 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobShow_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid app name and job name": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.Mockstore) {
+				gomock.InOrder(
+					m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil),
+					m.EXPECT().GetJob("my-app", "my-job").Return(&config.Workload{Name: "my-job"}, nil),
+				)
+			},
+		},
+		"invalid app name": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+		"invalid job name": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.Mockstore) {
+				gomock.InOrder(
+					m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil),
+					m.EXPECT().GetJob("my-app", "my-job").Return(nil, errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+
+			opts := &showJobOpts{
+				showJobVars: showJobVars{
+					appName: tc.inputApp,
+					jobName: tc.inputJob,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobShow_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+
+		setupMocks func(m *mocks.MockconfigSelector)
+
+		wantedApp   string
+		wantedJob   string
+		wantedError error
+	}{
+		"with all flags": {
+			inputApp: "my-app",
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().Job(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedApp: "my-app",
+			wantedJob: "my-job",
+		},
+		"prompts for app and job": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(jobShowAppNamePrompt, jobShowAppNameHelpPrompt).Return("my-app", nil),
+					m.EXPECT().Job(gomock.Any(), jobShowJobNameHelpPrompt, "my-app").Return("my-job", nil),
+				)
+			},
+			wantedApp: "my-app",
+			wantedJob: "my-job",
+		},
+		"returns error if fail to select application": {
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select application name: some error"),
+		},
+		"returns error if fail to select job": {
+			inputApp: "my-app",
+			setupMocks: func(m *mocks.MockconfigSelector) {
+				m.EXPECT().Job(gomock.Any(), gomock.Any(), "my-app").Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select job for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockconfigSelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			opts := &showJobOpts{
+				showJobVars: showJobVars{
+					appName: tc.inputApp,
+					jobName: tc.inputJob,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName, "expected app name to match")
+				require.Equal(t, tc.wantedJob, opts.jobName, "expected job name to match")
+			}
+		})
+	}
+}
+
+func TestJobShow_Execute(t *testing.T) {
+	job := mockDescribeData{
+		data: "mockData",
+		err:  errors.New("some error"),
+	}
+	testCases := map[string]struct {
+		inputJob         string
+		shouldOutputJSON bool
+
+		setupMocks func(m *mocks.Mockdescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"noop if job name is empty": {
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Times(0)
+			},
+		},
+		"success": {
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(&job, nil)
+			},
+			wantedContent: "mockData",
+		},
+		"return error if fail to generate JSON output": {
+			inputJob:         "my-job",
+			shouldOutputJSON: true,
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(&job, nil)
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+		"return error if fail to describe job": {
+			inputJob: "my-job",
+			setupMocks: func(m *mocks.Mockdescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe job my-job: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			b := &bytes.Buffer{}
+			mockDescriber := mocks.NewMockdescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+
+			opts := &showJobOpts{
+				showJobVars: showJobVars{
+					jobName:          tc.inputJob,
+					shouldOutputJSON: tc.shouldOutputJSON,
+					appName:          "my-app",
+				},
+				describer:     mockDescriber,
+				initDescriber: func() error { return nil },
+				w:             b,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String(), "expected output content match")
+			}
+		})
+	}
+}
@@ -9,8 +9,10 @@ import (
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	docker "github.com/aws/copilot-cli/internal/pkg/docker"
@@ -536,6 +538,20 @@ func (mr *MockenvironmentStoreMockRecorder) CreateEnvironment(env interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).CreateEnvironment), env)
 }
 
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // GetEnvironment mocks base method
 func (m *MockenvironmentStore) GetEnvironment(appName, environmentName string) (*config.Environment, error) {
 	m.ctrl.T.Helper()
@@ -617,6 +633,43 @@ func (mr *MockenvironmentCreatorMockRecorder) CreateEnvironment(env interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockenvironmentCreator)(nil).CreateEnvironment), env)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentGetter is a mock of environmentGetter interface
 type MockenvironmentGetter struct {
 	ctrl     *gomock.Controller
@@ -825,6 +878,20 @@ func (mr *MockstoreMockRecorder) CreateEnvironment(env interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*Mockstore)(nil).CreateEnvironment), env)
 }
 
+// UpdateEnvironment mocks base method
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
 // GetEnvironment mocks base method
 func (m *Mockstore) GetEnvironment(appName, environmentName string) (*config.Environment, error) {
 	m.ctrl.T.Helper()
@@ -2488,6 +2555,44 @@ func (mr *MockwsSvcDirReaderMockRecorder) CopilotDirPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsSvcDirReader)(nil).CopilotDirPath))
 }
 
+// MockwsJobLister is a mock of wsJobLister interface
+type MockwsJobLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsJobListerMockRecorder
+}
+
+// MockwsJobListerMockRecorder is the mock recorder for MockwsJobLister
+type MockwsJobListerMockRecorder struct {
+	mock *MockwsJobLister
+}
+
+// NewMockwsJobLister creates a new mock instance
+func NewMockwsJobLister(ctrl *gomock.Controller) *MockwsJobLister {
+	mock := &MockwsJobLister{ctrl: ctrl}
+	mock.recorder = &MockwsJobListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsJobLister) EXPECT() *MockwsJobListerMockRecorder {
+	return m.recorder
+}
+
+// JobNames mocks base method
+func (m *MockwsJobLister) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames
+func (mr *MockwsJobListerMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsJobLister)(nil).JobNames))
+}
+
 // MockwsJobDirReader is a mock of wsJobDirReader interface
 type MockwsJobDirReader struct {
 	ctrl     *gomock.Controller
@@ -2526,6 +2631,74 @@ func (mr *MockwsJobDirReaderMockRecorder) JobNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsJobDirReader)(nil).JobNames))
 }
 
+// ReadJobManifest mocks base method
+func (m *MockwsJobDirReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest
+func (mr *MockwsJobDirReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsJobDirReader)(nil).ReadJobManifest), jobName)
+}
+
+// CopilotDirPath mocks base method
+func (m *MockwsJobDirReader) CopilotDirPath() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopilotDirPath")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopilotDirPath indicates an expected call of CopilotDirPath
+func (mr *MockwsJobDirReaderMockRecorder) CopilotDirPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockwsJobDirReader)(nil).CopilotDirPath))
+}
+
+// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface
+type MockwsEnvironmentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentReaderMockRecorder
+}
+
+// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader
+type MockwsEnvironmentReaderMockRecorder struct {
+	mock *MockwsEnvironmentReader
+}
+
+// NewMockwsEnvironmentReader creates a new mock instance
+func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
+	mock := &MockwsEnvironmentReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method
+func (m *MockwsEnvironmentReader) ReadEnvironmentManifest(envName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", envName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest
+func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), envName)
+}
+
 // MockwsPipelineReader is a mock of wsPipelineReader interface
 type MockwsPipelineReader struct {
 	ctrl     *gomock.Controller
@@ -2699,6 +2872,175 @@ func (mr *MockwsAddonManagerMockRecorder) ReadServiceManifest(svcName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsAddonManager)(nil).ReadServiceManifest), svcName)
 }
 
+// MocklocalContainerRunner is a mock of localContainerRunner interface
+type MocklocalContainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalContainerRunnerMockRecorder
+}
+
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
+}
+
+// NewMocklocalContainerRunner creates a new mock instance
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method
+func (m *MocklocalContainerRunner) Build(args *docker.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build
+func (mr *MocklocalContainerRunnerMockRecorder) Build(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), args)
+}
+
+// RunContainer mocks base method
+func (m *MocklocalContainerRunner) RunContainer(in *docker.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunContainer", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunContainer indicates an expected call of RunContainer
+func (mr *MocklocalContainerRunnerMockRecorder) RunContainer(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).RunContainer), in)
+}
+
+// StopContainer mocks base method
+func (m *MocklocalContainerRunner) StopContainer(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopContainer", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopContainer indicates an expected call of StopContainer
+func (mr *MocklocalContainerRunnerMockRecorder) StopContainer(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).StopContainer), name)
+}
+
+// CreateNetwork mocks base method
+func (m *MocklocalContainerRunner) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork
+func (mr *MocklocalContainerRunnerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).CreateNetwork), name)
+}
+
+// RemoveNetwork mocks base method
+func (m *MocklocalContainerRunner) RemoveNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveNetwork), name)
+}
+
+// MocksecretGetter is a mock of secretGetter interface
+type MocksecretGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretGetterMockRecorder
+}
+
+// MocksecretGetterMockRecorder is the mock recorder for MocksecretGetter
+type MocksecretGetterMockRecorder struct {
+	mock *MocksecretGetter
+}
+
+// NewMocksecretGetter creates a new mock instance
+func NewMocksecretGetter(ctrl *gomock.Controller) *MocksecretGetter {
+	mock := &MocksecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksecretGetter) EXPECT() *MocksecretGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecret mocks base method
+func (m *MocksecretGetter) GetSecret(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecret indicates an expected call of GetSecret
+func (mr *MocksecretGetterMockRecorder) GetSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MocksecretGetter)(nil).GetSecret), name)
+}
+
+// MockenvOutputsDescriber is a mock of envOutputsDescriber interface
+type MockenvOutputsDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsDescriberMockRecorder
+}
+
+// MockenvOutputsDescriberMockRecorder is the mock recorder for MockenvOutputsDescriber
+type MockenvOutputsDescriberMockRecorder struct {
+	mock *MockenvOutputsDescriber
+}
+
+// NewMockenvOutputsDescriber creates a new mock instance
+func NewMockenvOutputsDescriber(ctrl *gomock.Controller) *MockenvOutputsDescriber {
+	mock := &MockenvOutputsDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvOutputsDescriber) EXPECT() *MockenvOutputsDescriberMockRecorder {
+	return m.recorder
+}
+
+// EnvOutputs mocks base method
+func (m *MockenvOutputsDescriber) EnvOutputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvOutputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvOutputs indicates an expected call of EnvOutputs
+func (mr *MockenvOutputsDescriberMockRecorder) EnvOutputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvOutputs", reflect.TypeOf((*MockenvOutputsDescriber)(nil).EnvOutputs))
+}
+
 // MockartifactUploader is a mock of artifactUploader interface
 type MockartifactUploader struct {
 	ctrl     *gomock.Controller
@@ -2855,84 +3197,601 @@ func (mr *MockenvironmentDeployerMockRecorder) GetEnvironment(appName, envName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockenvironmentDeployer)(nil).GetEnvironment), appName, envName)
 }
 
-// MocksvcDeleter is a mock of svcDeleter interface
-type MocksvcDeleter struct {
+// ManagedELBAccessLogsBucket mocks base method
+func (m *MockenvironmentDeployer) ManagedELBAccessLogsBucket(appName, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManagedELBAccessLogsBucket", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagedELBAccessLogsBucket indicates an expected call of ManagedELBAccessLogsBucket
+func (mr *MockenvironmentDeployerMockRecorder) ManagedELBAccessLogsBucket(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedELBAccessLogsBucket", reflect.TypeOf((*MockenvironmentDeployer)(nil).ManagedELBAccessLogsBucket), appName, envName)
+}
+
+// MockenvTemplateUpgrader is a mock of envTemplateUpgrader interface
+type MockenvTemplateUpgrader struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcDeleterMockRecorder
+	recorder *MockenvTemplateUpgraderMockRecorder
 }
 
-// MocksvcDeleterMockRecorder is the mock recorder for MocksvcDeleter
-type MocksvcDeleterMockRecorder struct {
-	mock *MocksvcDeleter
+// MockenvTemplateUpgraderMockRecorder is the mock recorder for MockenvTemplateUpgrader
+type MockenvTemplateUpgraderMockRecorder struct {
+	mock *MockenvTemplateUpgrader
 }
 
-// NewMocksvcDeleter creates a new mock instance
-func NewMocksvcDeleter(ctrl *gomock.Controller) *MocksvcDeleter {
-	mock := &MocksvcDeleter{ctrl: ctrl}
-	mock.recorder = &MocksvcDeleterMockRecorder{mock}
+// NewMockenvTemplateUpgrader creates a new mock instance
+func NewMockenvTemplateUpgrader(ctrl *gomock.Controller) *MockenvTemplateUpgrader {
+	mock := &MockenvTemplateUpgrader{ctrl: ctrl}
+	mock.recorder = &MockenvTemplateUpgraderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcDeleter) EXPECT() *MocksvcDeleterMockRecorder {
+func (m *MockenvTemplateUpgrader) EXPECT() *MockenvTemplateUpgraderMockRecorder {
 	return m.recorder
 }
 
-// DeleteService mocks base method
-func (m *MocksvcDeleter) DeleteService(in deploy.DeleteWorkloadInput) error {
+// UpgradeEnvironment mocks base method
+func (m *MockenvTemplateUpgrader) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteService", in)
+	ret := m.ctrl.Call(m, "UpgradeEnvironment", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteService indicates an expected call of DeleteService
-func (mr *MocksvcDeleterMockRecorder) DeleteService(in interface{}) *gomock.Call {
+// UpgradeEnvironment indicates an expected call of UpgradeEnvironment
+func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeEnvironment(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MocksvcDeleter)(nil).DeleteService), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeEnvironment), in)
 }
 
-// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
-type MocksvcRemoverFromApp struct {
+// UpgradeLegacyEnvironment mocks base method
+func (m *MockenvTemplateUpgrader) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{in}
+	for _, a := range lbWebServices {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpgradeLegacyEnvironment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpgradeLegacyEnvironment indicates an expected call of UpgradeLegacyEnvironment
+func (mr *MockenvTemplateUpgraderMockRecorder) UpgradeLegacyEnvironment(in interface{}, lbWebServices ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{in}, lbWebServices...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeLegacyEnvironment", reflect.TypeOf((*MockenvTemplateUpgrader)(nil).UpgradeLegacyEnvironment), varargs...)
+}
+
+// MocksvcDeploymentsDescriber is a mock of svcDeploymentsDescriber interface
+type MocksvcDeploymentsDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcRemoverFromAppMockRecorder
+	recorder *MocksvcDeploymentsDescriberMockRecorder
 }
 
-// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
-type MocksvcRemoverFromAppMockRecorder struct {
-	mock *MocksvcRemoverFromApp
+// MocksvcDeploymentsDescriberMockRecorder is the mock recorder for MocksvcDeploymentsDescriber
+type MocksvcDeploymentsDescriberMockRecorder struct {
+	mock *MocksvcDeploymentsDescriber
 }
 
-// NewMocksvcRemoverFromApp creates a new mock instance
-func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
-	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
-	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+// NewMocksvcDeploymentsDescriber creates a new mock instance
+func NewMocksvcDeploymentsDescriber(ctrl *gomock.Controller) *MocksvcDeploymentsDescriber {
+	mock := &MocksvcDeploymentsDescriber{ctrl: ctrl}
+	mock.recorder = &MocksvcDeploymentsDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+func (m *MocksvcDeploymentsDescriber) EXPECT() *MocksvcDeploymentsDescriberMockRecorder {
 	return m.recorder
 }
 
-// RemoveServiceFromApp mocks base method
-func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+// Params mocks base method
+func (m *MocksvcDeploymentsDescriber) Params() (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
-func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+// Params indicates an expected call of Params
+func (mr *MocksvcDeploymentsDescriberMockRecorder) Params() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MocksvcDeploymentsDescriber)(nil).Params))
 }
 
-// MockimageRemover is a mock of imageRemover interface
-type MockimageRemover struct {
-	ctrl     *gomock.Controller
-	recorder *MockimageRemoverMockRecorder
+// Deployments mocks base method
+func (m *MocksvcDeploymentsDescriber) Deployments(limit int) ([]describe.ServiceDeployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deployments", limit)
+	ret0, _ := ret[0].([]describe.ServiceDeployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deployments indicates an expected call of Deployments
+func (mr *MocksvcDeploymentsDescriberMockRecorder) Deployments(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployments", reflect.TypeOf((*MocksvcDeploymentsDescriber)(nil).Deployments), limit)
+}
+
+// MocksvcRollbacker is a mock of svcRollbacker interface
+type MocksvcRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRollbackerMockRecorder
+}
+
+// MocksvcRollbackerMockRecorder is the mock recorder for MocksvcRollbacker
+type MocksvcRollbackerMockRecorder struct {
+	mock *MocksvcRollbacker
+}
+
+// NewMocksvcRollbacker creates a new mock instance
+func NewMocksvcRollbacker(ctrl *gomock.Controller) *MocksvcRollbacker {
+	mock := &MocksvcRollbacker{ctrl: ctrl}
+	mock.recorder = &MocksvcRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRollbacker) EXPECT() *MocksvcRollbackerMockRecorder {
+	return m.recorder
+}
+
+// StreamServiceRollback mocks base method
+func (m *MocksvcRollbacker) StreamServiceRollback(stackName string, params map[string]string, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{stackName, params}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamServiceRollback", varargs...)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// StreamServiceRollback indicates an expected call of StreamServiceRollback
+func (mr *MocksvcRollbackerMockRecorder) StreamServiceRollback(stackName, params interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{stackName, params}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamServiceRollback", reflect.TypeOf((*MocksvcRollbacker)(nil).StreamServiceRollback), varargs...)
+}
+
+// MockserviceARNDescriber is a mock of serviceARNDescriber interface
+type MockserviceARNDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceARNDescriberMockRecorder
+}
+
+// MockserviceARNDescriberMockRecorder is the mock recorder for MockserviceARNDescriber
+type MockserviceARNDescriberMockRecorder struct {
+	mock *MockserviceARNDescriber
+}
+
+// NewMockserviceARNDescriber creates a new mock instance
+func NewMockserviceARNDescriber(ctrl *gomock.Controller) *MockserviceARNDescriber {
+	mock := &MockserviceARNDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceARNDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceARNDescriber) EXPECT() *MockserviceARNDescriberMockRecorder {
+	return m.recorder
+}
+
+// ServiceARN mocks base method
+func (m *MockserviceARNDescriber) ServiceARN() (*ecs.ServiceArn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceARN")
+	ret0, _ := ret[0].(*ecs.ServiceArn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceARN indicates an expected call of ServiceARN
+func (mr *MockserviceARNDescriberMockRecorder) ServiceARN() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockserviceARNDescriber)(nil).ServiceARN))
+}
+
+// MockecsCommandExecutor is a mock of ecsCommandExecutor interface
+type MockecsCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsCommandExecutorMockRecorder
+}
+
+// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor
+type MockecsCommandExecutorMockRecorder struct {
+	mock *MockecsCommandExecutor
+}
+
+// NewMockecsCommandExecutor creates a new mock instance
+func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
+	mock := &MockecsCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ServiceTasks mocks base method
+func (m *MockecsCommandExecutor) ServiceTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTasks", clusterName, serviceName)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTasks indicates an expected call of ServiceTasks
+func (mr *MockecsCommandExecutorMockRecorder) ServiceTasks(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTasks", reflect.TypeOf((*MockecsCommandExecutor)(nil).ServiceTasks), clusterName, serviceName)
+}
+
+// TaskDefinition mocks base method
+func (m *MockecsCommandExecutor) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MockecsCommandExecutorMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsCommandExecutor)(nil).TaskDefinition), taskDefName)
+}
+
+// ExecuteCommand mocks base method
+func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) (*ecs.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(*ecs.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand
+func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// MockdeniedActionsChecker is a mock of deniedActionsChecker interface
+type MockdeniedActionsChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeniedActionsCheckerMockRecorder
+}
+
+// MockdeniedActionsCheckerMockRecorder is the mock recorder for MockdeniedActionsChecker
+type MockdeniedActionsCheckerMockRecorder struct {
+	mock *MockdeniedActionsChecker
+}
+
+// NewMockdeniedActionsChecker creates a new mock instance
+func NewMockdeniedActionsChecker(ctrl *gomock.Controller) *MockdeniedActionsChecker {
+	mock := &MockdeniedActionsChecker{ctrl: ctrl}
+	mock.recorder = &MockdeniedActionsCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockdeniedActionsChecker) EXPECT() *MockdeniedActionsCheckerMockRecorder {
+	return m.recorder
+}
+
+// DeniedActions mocks base method
+func (m *MockdeniedActionsChecker) DeniedActions(principalARN string, actions []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeniedActions", principalARN, actions)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeniedActions indicates an expected call of DeniedActions
+func (mr *MockdeniedActionsCheckerMockRecorder) DeniedActions(principalARN, actions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeniedActions", reflect.TypeOf((*MockdeniedActionsChecker)(nil).DeniedActions), principalARN, actions)
+}
+
+// MockstateMachineARNDescriber is a mock of stateMachineARNDescriber interface
+type MockstateMachineARNDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstateMachineARNDescriberMockRecorder
+}
+
+// MockstateMachineARNDescriberMockRecorder is the mock recorder for MockstateMachineARNDescriber
+type MockstateMachineARNDescriberMockRecorder struct {
+	mock *MockstateMachineARNDescriber
+}
+
+// NewMockstateMachineARNDescriber creates a new mock instance
+func NewMockstateMachineARNDescriber(ctrl *gomock.Controller) *MockstateMachineARNDescriber {
+	mock := &MockstateMachineARNDescriber{ctrl: ctrl}
+	mock.recorder = &MockstateMachineARNDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstateMachineARNDescriber) EXPECT() *MockstateMachineARNDescriberMockRecorder {
+	return m.recorder
+}
+
+// StateMachineARN mocks base method
+func (m *MockstateMachineARNDescriber) StateMachineARN() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineARN")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineARN indicates an expected call of StateMachineARN
+func (mr *MockstateMachineARNDescriberMockRecorder) StateMachineARN() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineARN", reflect.TypeOf((*MockstateMachineARNDescriber)(nil).StateMachineARN))
+}
+
+// MockserviceTaskConfigDescriber is a mock of serviceTaskConfigDescriber interface
+type MockserviceTaskConfigDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceTaskConfigDescriberMockRecorder
+}
+
+// MockserviceTaskConfigDescriberMockRecorder is the mock recorder for MockserviceTaskConfigDescriber
+type MockserviceTaskConfigDescriberMockRecorder struct {
+	mock *MockserviceTaskConfigDescriber
+}
+
+// NewMockserviceTaskConfigDescriber creates a new mock instance
+func NewMockserviceTaskConfigDescriber(ctrl *gomock.Controller) *MockserviceTaskConfigDescriber {
+	mock := &MockserviceTaskConfigDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceTaskConfigDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockserviceTaskConfigDescriber) EXPECT() *MockserviceTaskConfigDescriberMockRecorder {
+	return m.recorder
+}
+
+// TaskConfig mocks base method
+func (m *MockserviceTaskConfigDescriber) TaskConfig() (*describe.ServiceTaskConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskConfig")
+	ret0, _ := ret[0].(*describe.ServiceTaskConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskConfig indicates an expected call of TaskConfig
+func (mr *MockserviceTaskConfigDescriberMockRecorder) TaskConfig() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskConfig", reflect.TypeOf((*MockserviceTaskConfigDescriber)(nil).TaskConfig))
+}
+
+// MockstateMachineExecutor is a mock of stateMachineExecutor interface
+type MockstateMachineExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockstateMachineExecutorMockRecorder
+}
+
+// MockstateMachineExecutorMockRecorder is the mock recorder for MockstateMachineExecutor
+type MockstateMachineExecutorMockRecorder struct {
+	mock *MockstateMachineExecutor
+}
+
+// NewMockstateMachineExecutor creates a new mock instance
+func NewMockstateMachineExecutor(ctrl *gomock.Controller) *MockstateMachineExecutor {
+	mock := &MockstateMachineExecutor{ctrl: ctrl}
+	mock.recorder = &MockstateMachineExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstateMachineExecutor) EXPECT() *MockstateMachineExecutorMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockstateMachineExecutor) Execute(stateMachineARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", stateMachineARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockstateMachineExecutorMockRecorder) Execute(stateMachineARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockstateMachineExecutor)(nil).Execute), stateMachineARN)
+}
+
+// MocksvcDeployer is a mock of svcDeployer interface
+type MocksvcDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcDeployerMockRecorder
+}
+
+// MocksvcDeployerMockRecorder is the mock recorder for MocksvcDeployer
+type MocksvcDeployerMockRecorder struct {
+	mock *MocksvcDeployer
+}
+
+// NewMocksvcDeployer creates a new mock instance
+func NewMocksvcDeployer(ctrl *gomock.Controller) *MocksvcDeployer {
+	mock := &MocksvcDeployer{ctrl: ctrl}
+	mock.recorder = &MocksvcDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcDeployer) EXPECT() *MocksvcDeployerMockRecorder {
+	return m.recorder
+}
+
+// StreamServiceDeployment mocks base method
+func (m *MocksvcDeployer) StreamServiceDeployment(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamServiceDeployment", varargs...)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// StreamServiceDeployment indicates an expected call of StreamServiceDeployment
+func (mr *MocksvcDeployerMockRecorder) StreamServiceDeployment(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamServiceDeployment", reflect.TypeOf((*MocksvcDeployer)(nil).StreamServiceDeployment), varargs...)
+}
+
+// CreateServiceChangeSet mocks base method
+func (m *MocksvcDeployer) CreateServiceChangeSet(conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) (*cloudformation0.ChangeSet, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateServiceChangeSet", varargs...)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceChangeSet indicates an expected call of CreateServiceChangeSet
+func (mr *MocksvcDeployerMockRecorder) CreateServiceChangeSet(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceChangeSet", reflect.TypeOf((*MocksvcDeployer)(nil).CreateServiceChangeSet), varargs...)
+}
+
+// StreamChangeSetExecution mocks base method
+func (m *MocksvcDeployer) StreamChangeSetExecution(cs *cloudformation0.ChangeSet) (<-chan []deploy.ResourceEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamChangeSetExecution", cs)
+	ret0, _ := ret[0].(<-chan []deploy.ResourceEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// StreamChangeSetExecution indicates an expected call of StreamChangeSetExecution
+func (mr *MocksvcDeployerMockRecorder) StreamChangeSetExecution(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChangeSetExecution", reflect.TypeOf((*MocksvcDeployer)(nil).StreamChangeSetExecution), cs)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MocksvcDeployer) DeleteChangeSet(cs *cloudformation0.ChangeSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", cs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MocksvcDeployerMockRecorder) DeleteChangeSet(cs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MocksvcDeployer)(nil).DeleteChangeSet), cs)
+}
+
+// MocksvcDeleter is a mock of svcDeleter interface
+type MocksvcDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcDeleterMockRecorder
+}
+
+// MocksvcDeleterMockRecorder is the mock recorder for MocksvcDeleter
+type MocksvcDeleterMockRecorder struct {
+	mock *MocksvcDeleter
+}
+
+// NewMocksvcDeleter creates a new mock instance
+func NewMocksvcDeleter(ctrl *gomock.Controller) *MocksvcDeleter {
+	mock := &MocksvcDeleter{ctrl: ctrl}
+	mock.recorder = &MocksvcDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcDeleter) EXPECT() *MocksvcDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteService mocks base method
+func (m *MocksvcDeleter) DeleteService(in deploy.DeleteWorkloadInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteService", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteService indicates an expected call of DeleteService
+func (mr *MocksvcDeleterMockRecorder) DeleteService(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteService", reflect.TypeOf((*MocksvcDeleter)(nil).DeleteService), in)
+}
+
+// MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface
+type MocksvcRemoverFromApp struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcRemoverFromAppMockRecorder
+}
+
+// MocksvcRemoverFromAppMockRecorder is the mock recorder for MocksvcRemoverFromApp
+type MocksvcRemoverFromAppMockRecorder struct {
+	mock *MocksvcRemoverFromApp
+}
+
+// NewMocksvcRemoverFromApp creates a new mock instance
+func NewMocksvcRemoverFromApp(ctrl *gomock.Controller) *MocksvcRemoverFromApp {
+	mock := &MocksvcRemoverFromApp{ctrl: ctrl}
+	mock.recorder = &MocksvcRemoverFromAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocksvcRemoverFromApp) EXPECT() *MocksvcRemoverFromAppMockRecorder {
+	return m.recorder
+}
+
+// RemoveServiceFromApp mocks base method
+func (m *MocksvcRemoverFromApp) RemoveServiceFromApp(app *config.Application, svcName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveServiceFromApp", app, svcName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveServiceFromApp indicates an expected call of RemoveServiceFromApp
+func (mr *MocksvcRemoverFromAppMockRecorder) RemoveServiceFromApp(app, svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveServiceFromApp", reflect.TypeOf((*MocksvcRemoverFromApp)(nil).RemoveServiceFromApp), app, svcName)
+}
+
+// MockimageRemover is a mock of imageRemover interface
+type MockimageRemover struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRemoverMockRecorder
 }
 
 // MockimageRemoverMockRecorder is the mock recorder for MockimageRemover
@@ -3330,6 +4189,253 @@ func (mr *MocktaskRunnerMockRecorder) Run() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocktaskRunner)(nil).Run))
 }
 
+// MocktaskWatcher is a mock of taskWatcher interface
+type MocktaskWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskWatcherMockRecorder
+}
+
+// MocktaskWatcherMockRecorder is the mock recorder for MocktaskWatcher
+type MocktaskWatcherMockRecorder struct {
+	mock *MocktaskWatcher
+}
+
+// NewMocktaskWatcher creates a new mock instance
+func NewMocktaskWatcher(ctrl *gomock.Controller) *MocktaskWatcher {
+	mock := &MocktaskWatcher{ctrl: ctrl}
+	mock.recorder = &MocktaskWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskWatcher) EXPECT() *MocktaskWatcherMockRecorder {
+	return m.recorder
+}
+
+// WaitUntilStopped mocks base method
+func (m *MocktaskWatcher) WaitUntilStopped(tasks []*task.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilStopped", tasks)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilStopped indicates an expected call of WaitUntilStopped
+func (mr *MocktaskWatcherMockRecorder) WaitUntilStopped(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilStopped", reflect.TypeOf((*MocktaskWatcher)(nil).WaitUntilStopped), tasks)
+}
+
+// ContainerExits mocks base method
+func (m *MocktaskWatcher) ContainerExits(tasks []*task.Task) ([]*task.ContainerExit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerExits", tasks)
+	ret0, _ := ret[0].([]*task.ContainerExit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerExits indicates an expected call of ContainerExits
+func (mr *MocktaskWatcherMockRecorder) ContainerExits(tasks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerExits", reflect.TypeOf((*MocktaskWatcher)(nil).ContainerExits), tasks)
+}
+
+// MocktaskStackLister is a mock of taskStackLister interface
+type MocktaskStackLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStackListerMockRecorder
+}
+
+// MocktaskStackListerMockRecorder is the mock recorder for MocktaskStackLister
+type MocktaskStackListerMockRecorder struct {
+	mock *MocktaskStackLister
+}
+
+// NewMocktaskStackLister creates a new mock instance
+func NewMocktaskStackLister(ctrl *gomock.Controller) *MocktaskStackLister {
+	mock := &MocktaskStackLister{ctrl: ctrl}
+	mock.recorder = &MocktaskStackListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskStackLister) EXPECT() *MocktaskStackListerMockRecorder {
+	return m.recorder
+}
+
+// ListTaskStacks mocks base method
+func (m *MocktaskStackLister) ListTaskStacks(appName, envName string) ([]deploy.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskStacks", appName, envName)
+	ret0, _ := ret[0].([]deploy.TaskStackInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskStacks indicates an expected call of ListTaskStacks
+func (mr *MocktaskStackListerMockRecorder) ListTaskStacks(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskStacks", reflect.TypeOf((*MocktaskStackLister)(nil).ListTaskStacks), appName, envName)
+}
+
+// ListDefaultTaskStacks mocks base method
+func (m *MocktaskStackLister) ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDefaultTaskStacks")
+	ret0, _ := ret[0].([]deploy.TaskStackInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDefaultTaskStacks indicates an expected call of ListDefaultTaskStacks
+func (mr *MocktaskStackListerMockRecorder) ListDefaultTaskStacks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDefaultTaskStacks", reflect.TypeOf((*MocktaskStackLister)(nil).ListDefaultTaskStacks))
+}
+
+// MocktaskStackDeleter is a mock of taskStackDeleter interface
+type MocktaskStackDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStackDeleterMockRecorder
+}
+
+// MocktaskStackDeleterMockRecorder is the mock recorder for MocktaskStackDeleter
+type MocktaskStackDeleterMockRecorder struct {
+	mock *MocktaskStackDeleter
+}
+
+// NewMocktaskStackDeleter creates a new mock instance
+func NewMocktaskStackDeleter(ctrl *gomock.Controller) *MocktaskStackDeleter {
+	mock := &MocktaskStackDeleter{ctrl: ctrl}
+	mock.recorder = &MocktaskStackDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskStackDeleter) EXPECT() *MocktaskStackDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteTask mocks base method
+func (m *MocktaskStackDeleter) DeleteTask(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask
+func (mr *MocktaskStackDeleterMockRecorder) DeleteTask(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MocktaskStackDeleter)(nil).DeleteTask), name)
+}
+
+// MocktaskGroupManager is a mock of taskGroupManager interface
+type MocktaskGroupManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskGroupManagerMockRecorder
+}
+
+// MocktaskGroupManagerMockRecorder is the mock recorder for MocktaskGroupManager
+type MocktaskGroupManagerMockRecorder struct {
+	mock *MocktaskGroupManager
+}
+
+// NewMocktaskGroupManager creates a new mock instance
+func NewMocktaskGroupManager(ctrl *gomock.Controller) *MocktaskGroupManager {
+	mock := &MocktaskGroupManager{ctrl: ctrl}
+	mock.recorder = &MocktaskGroupManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocktaskGroupManager) EXPECT() *MocktaskGroupManagerMockRecorder {
+	return m.recorder
+}
+
+// RunningTasks mocks base method
+func (m *MocktaskGroupManager) RunningTasks() ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunningTasks")
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTasks indicates an expected call of RunningTasks
+func (mr *MocktaskGroupManagerMockRecorder) RunningTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasks", reflect.TypeOf((*MocktaskGroupManager)(nil).RunningTasks))
+}
+
+// StoppedTasks mocks base method
+func (m *MocktaskGroupManager) StoppedTasks() ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedTasks")
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedTasks indicates an expected call of StoppedTasks
+func (mr *MocktaskGroupManagerMockRecorder) StoppedTasks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedTasks", reflect.TypeOf((*MocktaskGroupManager)(nil).StoppedTasks))
+}
+
+// Stop mocks base method
+func (m *MocktaskGroupManager) Stop(reason string) ([]*task.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", reason)
+	ret0, _ := ret[0].([]*task.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MocktaskGroupManagerMockRecorder) Stop(reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MocktaskGroupManager)(nil).Stop), reason)
+}
+
+// MocklogGroupDeleter is a mock of logGroupDeleter interface
+type MocklogGroupDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogGroupDeleterMockRecorder
+}
+
+// MocklogGroupDeleterMockRecorder is the mock recorder for MocklogGroupDeleter
+type MocklogGroupDeleterMockRecorder struct {
+	mock *MocklogGroupDeleter
+}
+
+// NewMocklogGroupDeleter creates a new mock instance
+func NewMocklogGroupDeleter(ctrl *gomock.Controller) *MocklogGroupDeleter {
+	mock := &MocklogGroupDeleter{ctrl: ctrl}
+	mock.recorder = &MocklogGroupDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MocklogGroupDeleter) EXPECT() *MocklogGroupDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteLogGroup mocks base method
+func (m *MocklogGroupDeleter) DeleteLogGroup(logGroup string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", logGroup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup
+func (mr *MocklogGroupDeleterMockRecorder) DeleteLogGroup(logGroup interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MocklogGroupDeleter)(nil).DeleteLogGroup), logGroup)
+}
+
 // MockdefaultClusterGetter is a mock of defaultClusterGetter interface
 type MockdefaultClusterGetter struct {
 	ctrl     *gomock.Controller
@@ -3449,6 +4555,21 @@ func (mr *MockdeployerMockRecorder) GetEnvironment(appName, envName interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*Mockdeployer)(nil).GetEnvironment), appName, envName)
 }
 
+// ManagedELBAccessLogsBucket mocks base method
+func (m *Mockdeployer) ManagedELBAccessLogsBucket(appName, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManagedELBAccessLogsBucket", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagedELBAccessLogsBucket indicates an expected call of ManagedELBAccessLogsBucket
+func (mr *MockdeployerMockRecorder) ManagedELBAccessLogsBucket(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedELBAccessLogsBucket", reflect.TypeOf((*Mockdeployer)(nil).ManagedELBAccessLogsBucket), appName, envName)
+}
+
 // DeployApp mocks base method
 func (m *Mockdeployer) DeployApp(in *deploy.CreateAppInput) error {
 	m.ctrl.T.Helper()
@@ -3839,6 +4960,44 @@ func (mr *MockversionGetterMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionGetter)(nil).Version))
 }
 
+// MockenvVPCDescriber is a mock of envVPCDescriber interface
+type MockenvVPCDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvVPCDescriberMockRecorder
+}
+
+// MockenvVPCDescriberMockRecorder is the mock recorder for MockenvVPCDescriber
+type MockenvVPCDescriberMockRecorder struct {
+	mock *MockenvVPCDescriber
+}
+
+// NewMockenvVPCDescriber creates a new mock instance
+func NewMockenvVPCDescriber(ctrl *gomock.Controller) *MockenvVPCDescriber {
+	mock := &MockenvVPCDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvVPCDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockenvVPCDescriber) EXPECT() *MockenvVPCDescriberMockRecorder {
+	return m.recorder
+}
+
+// HasDefaultVPC mocks base method
+func (m *MockenvVPCDescriber) HasDefaultVPC() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDefaultVPC")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDefaultVPC indicates an expected call of HasDefaultVPC
+func (mr *MockenvVPCDescriberMockRecorder) HasDefaultVPC() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDefaultVPC", reflect.TypeOf((*MockenvVPCDescriber)(nil).HasDefaultVPC))
+}
+
 // MockpipelineGetter is a mock of pipelineGetter interface
 type MockpipelineGetter struct {
 	ctrl     *gomock.Controller
@@ -4201,6 +5360,21 @@ func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
 }
 
+// Job mocks base method
+func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job
+func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+}
+
 // MockdeploySelector is a mock of deploySelector interface
 type MockdeploySelector struct {
 	ctrl     *gomock.Controller
@@ -4591,3 +5765,33 @@ func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
 }
+
+// VPCEndpointServices mocks base method
+func (m *Mockec2Client) VPCEndpointServices(vpcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPCEndpointServices", vpcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPCEndpointServices indicates an expected call of VPCEndpointServices
+func (mr *Mockec2ClientMockRecorder) VPCEndpointServices(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPCEndpointServices", reflect.TypeOf((*Mockec2Client)(nil).VPCEndpointServices), vpcID)
+}
+
+// AvailabilityZoneNames mocks base method
+func (m *Mockec2Client) AvailabilityZoneNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailabilityZoneNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailabilityZoneNames indicates an expected call of AvailabilityZoneNames
+func (mr *Mockec2ClientMockRecorder) AvailabilityZoneNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilityZoneNames", reflect.TypeOf((*Mockec2Client)(nil).AvailabilityZoneNames))
+}
@@ -3,7 +3,16 @@
 
 package cli
 
-import termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+)
+
+// stackResourceType is the CloudFormation resource type of the deployed stack itself.
+const stackResourceType = "AWS::CloudFormation::Stack"
 
 // progress is the interface to inform the user that a long operation is taking place.
 type progress interface {
@@ -35,3 +44,56 @@ const (
 	textECSCluster      termprogress.Text = "- ECS Cluster to hold your services "
 	textALB             termprogress.Text = "- Application load balancer to distribute traffic "
 )
+
+// Row descriptions displayed while deploying a workload, keyed by the logical ID of the resource in the template.
+var workloadResourceTexts = map[string]termprogress.Text{
+	"LogGroup":             "- A CloudWatch log group to hold your logs",
+	"ExecutionRole":        "- An IAM role to pull your images and read your secrets",
+	"TaskRole":             "- An IAM role for your containers to make AWS API calls",
+	"TaskDefinition":       "- An ECS task definition to group your containers and run them on ECS",
+	"DiscoveryService":     "- Service discovery for your services to communicate within the VPC",
+	"TargetGroup":          "- A target group to route load balancer traffic to your tasks",
+	"HTTPListenerRule":     "- A load balancer listener rule to forward requests to your target group",
+	"HTTPSListenerRule":    "- A load balancer listener rule to forward requests to your target group",
+	"LoadBalancerDNSAlias": "- A DNS alias record for your load balancer",
+	"Service":              "- An ECS service to run and maintain your tasks in the environment cluster",
+	"AutoScalingTarget":    "- Auto scaling to adjust the number of tasks",
+	"AutoScalingPolicyECSServiceAverageCPUUtilization":    "- Auto scaling to adjust the number of tasks",
+	"AutoScalingPolicyECSServiceAverageMemoryUtilization": "- Auto scaling to adjust the number of tasks",
+	"StateMachine": "- A state machine to run your job with retries and a timeout",
+	"Rule":         "- An event rule to trigger your job on a schedule",
+	"AddonsStack":  "- An addons stack for your additional resources",
+}
+
+// humanizeWorkloadEvents groups raw workload stack events under human-friendly tab-separated texts.
+// A text is displayed only once one of its resources is being deployed, so that unchanged resources are omitted on updates.
+// Resources without a description are displayed only if they fail so that users can see the failure reason.
+func humanizeWorkloadEvents(resourceEvents []deploy.ResourceEvent) []termprogress.TabRow {
+	var order []termprogress.Text
+	logicalNames := make(map[termprogress.Text]map[string]bool)
+	for _, event := range resourceEvents {
+		text, ok := workloadResourceTexts[event.LogicalName]
+		if !ok {
+			if event.Type == stackResourceType || !strings.HasSuffix(event.Status, "FAILED") {
+				continue
+			}
+			text = termprogress.Text(fmt.Sprintf("- %s", event.LogicalName))
+		}
+		if _, ok := logicalNames[text]; !ok {
+			order = append(order, text)
+			logicalNames[text] = make(map[string]bool)
+		}
+		logicalNames[text][event.LogicalName] = true
+	}
+
+	matcher := make(map[termprogress.Text]termprogress.ResourceMatcher)
+	wantedCount := make(map[termprogress.Text]int)
+	for text, names := range logicalNames {
+		names := names
+		matcher[text] = func(resource deploy.Resource) bool {
+			return names[resource.LogicalName]
+		}
+		wantedCount[text] = len(names)
+	}
+	return termprogress.HumanizeResourceEvents(order, resourceEvents, matcher, wantedCount)
+}
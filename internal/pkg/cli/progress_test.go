@@ -0,0 +1,87 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanizeWorkloadEvents(t *testing.T) {
+	testCases := map[string]struct {
+		inEvents []deploy.ResourceEvent
+
+		wantedRows []termprogress.TabRow
+	}{
+		"omits resources that are not being deployed": {
+			inEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{LogicalName: "TaskDefinition", Type: "AWS::ECS::TaskDefinition"},
+					Status:   "UPDATE_COMPLETE",
+				},
+				{
+					Resource: deploy.Resource{LogicalName: "Service", Type: "AWS::ECS::Service"},
+					Status:   "UPDATE_IN_PROGRESS",
+				},
+			},
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", workloadResourceTexts["TaskDefinition"], termprogress.StatusComplete)),
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", workloadResourceTexts["Service"], termprogress.StatusInProgress)),
+			},
+		},
+		"groups resources under the same text until they are all complete": {
+			inEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{LogicalName: "AutoScalingTarget", Type: "AWS::ApplicationAutoScaling::ScalableTarget"},
+					Status:   "CREATE_COMPLETE",
+				},
+				{
+					Resource: deploy.Resource{LogicalName: "AutoScalingPolicyECSServiceAverageCPUUtilization", Type: "AWS::ApplicationAutoScaling::ScalingPolicy"},
+					Status:   "CREATE_IN_PROGRESS",
+				},
+			},
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", workloadResourceTexts["AutoScalingTarget"], termprogress.StatusInProgress)),
+			},
+		},
+		"displays the failure reason of resources": {
+			inEvents: []deploy.ResourceEvent{
+				{
+					Resource:     deploy.Resource{LogicalName: "TargetGroup", Type: "AWS::ElasticLoadBalancingV2::TargetGroup"},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Health check path is invalid",
+				},
+				{
+					Resource: deploy.Resource{LogicalName: "WaitUntilListenerRuleIsCreated", Type: "AWS::CloudFormation::WaitCondition"},
+					Status:   "CREATE_IN_PROGRESS",
+				},
+				{
+					Resource:     deploy.Resource{LogicalName: "HTTPRulePriorityAction", Type: "Custom::RulePriorityFunction"},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Resource creation cancelled",
+				},
+				{
+					Resource: deploy.Resource{LogicalName: "phonetool-test-api", Type: "AWS::CloudFormation::Stack"},
+					Status:   "ROLLBACK_FAILED",
+				},
+			},
+			wantedRows: []termprogress.TabRow{
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", workloadResourceTexts["TargetGroup"], termprogress.StatusFailed)),
+				termprogress.TabRow("  Health check path is invalid\t"),
+				termprogress.TabRow(fmt.Sprintf("%s\t[%s]", "- HTTPRulePriorityAction", termprogress.StatusFailed)),
+				termprogress.TabRow("  Resource creation cancelled\t"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedRows, humanizeWorkloadEvents(tc.inEvents))
+		})
+	}
+}
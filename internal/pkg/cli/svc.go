@@ -27,10 +27,13 @@ Services are long-running Amazon ECS services.`,
 	cmd.AddCommand(buildSvcListCmd())
 	cmd.AddCommand(buildSvcPackageCmd())
 	cmd.AddCommand(buildSvcDeployCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
 	cmd.AddCommand(buildSvcDeleteCmd())
 	cmd.AddCommand(buildSvcShowCmd())
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
+	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcRunLocalCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -6,9 +6,12 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
@@ -16,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
@@ -34,6 +38,11 @@ import (
 
 const (
 	inputImageTagPrompt = "Input an image tag value:"
+
+	fmtDeploySvcChangesPrompt = "Are you sure you want to deploy these changes to %s?"
+	deploySvcChangesHelp      = "Resources that are replaced are deleted and re-created, which can interrupt your service."
+
+	envOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
 )
 
 type deploySvcVars struct {
@@ -42,6 +51,9 @@ type deploySvcVars struct {
 	envName      string
 	imageTag     string
 	resourceTags map[string]string
+
+	showDiff         bool
+	skipConfirmation bool
 }
 
 type deploySvcOpts struct {
@@ -55,12 +67,17 @@ type deploySvcOpts struct {
 	cmd                runner
 	addons             templater
 	appCFN             appResourcesGetter
-	svcCFN             cloudformation.CloudFormation
+	svcCFN             svcDeployer
+	envDescriber       envOutputsDescriber
 	sessProvider       sessionProvider
 
 	spinner progress
 	sel     wsSelector
 	prompt  prompter
+	w       io.Writer
+
+	// Constructors for clients that can be initialized only at runtime.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
 
 	// cached variables
 	targetApp         *config.Application
@@ -90,6 +107,19 @@ func newSvcDeployOpts(vars deploySvcVars) (*deploySvcOpts, error) {
 		prompt:       prompter,
 		cmd:          command.New(),
 		sessProvider: sessions.NewProvider(),
+		w:            log.OutputWriter,
+
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %w", env, app, err)
+			}
+			return d, nil
+		},
 	}, nil
 }
 
@@ -133,6 +163,15 @@ func (o *deploySvcOpts) Execute() error {
 	}
 	o.targetEnvironment = env
 
+	// The service's template imports resources that only the latest environment template exports.
+	envVersion, err := o.newEnvVersionGetter(o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	if err := validateEnvVersion(envVersion, o.appName, o.envName); err != nil {
+		return err
+	}
+
 	app, err := o.store.GetApplication(o.appName)
 	if err != nil {
 		return err
@@ -278,6 +317,17 @@ func (o *deploySvcOpts) configureClients() error {
 	}
 	o.addons = addonsSvc
 
+	envDescriber, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+		App:         o.appName,
+		Env:         o.envName,
+		Svc:         o.name,
+		ConfigStore: o.store,
+	})
+	if err != nil {
+		return fmt.Errorf("create describer for service %s: %w", o.name, err)
+	}
+	o.envDescriber = envDescriber
+
 	// client to retrieve an application's resources created with CloudFormation
 	defaultSess, err := o.sessProvider.Default()
 	if err != nil {
@@ -330,7 +380,7 @@ func (o *deploySvcOpts) getBuildArgs() (*docker.BuildArguments, error) {
 		Context:    *args.Context,
 		Args:       args.Args,
 		ImageTag:   o.imageTag,
-		Builder:    *args.Builder,
+		Builder:    aws.StringValue(args.Builder),
 		Env:        args.Env,
 	}, nil
 }
@@ -406,13 +456,21 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
-		if o.targetApp.RequiresDNSDelegation() {
+		switch {
+		case o.targetApp.RequiresDNSDelegation():
 			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
-		} else {
+		case o.targetEnvironment.HasImportedCerts():
+			conf, err = stack.NewHTTPSLoadBalancedWebServiceWithImportedCerts(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+		default:
 			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 		}
 	case *manifest.BackendService:
+		if err := o.validateInternalALB(t); err != nil {
+			return nil, err
+		}
 		conf, err = stack.NewBackendService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+	case *manifest.WorkerService:
+		conf, err = stack.NewWorkerService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -422,17 +480,112 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	return conf, nil
 }
 
+// validateInternalALB returns an error if the backend service is routed through the internal load balancer
+// of an environment that doesn't have one.
+func (o *deploySvcOpts) validateInternalALB(mft *manifest.BackendService) error {
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	if !envMft.HasInternalRoutingRule() {
+		return nil
+	}
+	outputs, err := o.envDescriber.EnvOutputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", o.envName, err)
+	}
+	if _, ok := outputs[envOutputInternalLoadBalancerDNSName]; !ok {
+		return fmt.Errorf(`service %s sets "http.path" but environment %s has no internal load balancer: set "http.private.enabled: true" in the environment manifest and run %s`,
+			o.name, o.envName, color.HighlightCode(fmt.Sprintf("copilot env deploy --name %s", o.envName)))
+	}
+	return nil
+}
+
 func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 	conf, err := o.stackConfiguration(addonsURL)
 	if err != nil {
 		return err
 	}
+	if o.showDiff {
+		return o.deploySvcWithChangeSet(conf)
+	}
+	o.startDeploySpinner()
+	stackEvents, responses := o.svcCFN.StreamServiceDeployment(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	return o.waitForDeployment(stackEvents, responses)
+}
+
+// deploySvcWithChangeSet creates a change set for the service stack, shows the changes it contains,
+// and executes it once the user confirms the changes.
+func (o *deploySvcOpts) deploySvcWithChangeSet(conf cloudformation.StackConfiguration) error {
+	o.spinner.Start(fmt.Sprintf("Creating a change set for %s in %s.",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.targetEnvironment.Name)))
+	cs, err := o.svcCFN.CreateServiceChangeSet(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	if err != nil {
+		var errChangeSetEmpty *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errChangeSetEmpty) {
+			o.spinner.Stop(log.Ssuccessf("No changes to deploy for service %s.\n", color.HighlightUserInput(o.name)))
+			return nil
+		}
+		o.spinner.Stop(log.Serrorf("Failed to create a change set.\n"))
+		return fmt.Errorf("create change set for service %s: %w", o.name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Created a change set for %s.\n", color.HighlightUserInput(o.name)))
+	o.writeResourceChanges(cs.Changes)
+
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeploySvcChangesPrompt, color.HighlightUserInput(o.targetEnvironment.Name)), deploySvcChangesHelp)
+		if err != nil {
+			return fmt.Errorf("confirm deploying changes to service %s: %w", o.name, err)
+		}
+		if !confirmed {
+			if err := o.svcCFN.DeleteChangeSet(cs); err != nil {
+				return fmt.Errorf("delete change set %s: %w", cs.Name, err)
+			}
+			return errOperationCancelled
+		}
+	}
+	o.startDeploySpinner()
+	stackEvents, responses := o.svcCFN.StreamChangeSetExecution(cs)
+	return o.waitForDeployment(stackEvents, responses)
+}
+
+// writeResourceChanges writes a table of the changes to the service's resources and highlights the ones that are replaced.
+func (o *deploySvcOpts) writeResourceChanges(changes []deploy.ResourceChange) {
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "\n%s\t%s\t%s\t%s\n", "Action", "Resource", "Type", "Replacement")
+	var replaced []string
+	for _, change := range changes {
+		replacement := "-"
+		switch change.Replacement {
+		case "True":
+			replacement = color.Red.Sprint("Yes")
+			replaced = append(replaced, change.LogicalName)
+		case "Conditional":
+			replacement = color.Yellow.Sprint("Conditional")
+			replaced = append(replaced, change.LogicalName)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", change.Action, change.LogicalName, change.Type, replacement)
+	}
+	writer.Flush()
+	fmt.Fprintln(o.w)
+	if len(replaced) != 0 {
+		log.Warningf("The following resources might be deleted and re-created: %s.\n", strings.Join(replaced, ", "))
+	}
+}
+
+func (o *deploySvcOpts) startDeploySpinner() {
 	o.spinner.Start(
 		fmt.Sprintf("Deploying %s to %s.",
 			fmt.Sprintf("%s:%s", color.HighlightUserInput(o.name), color.HighlightUserInput(o.imageTag)),
 			color.HighlightUserInput(o.targetEnvironment.Name)))
+}
 
-	if err := o.svcCFN.DeployService(conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
+// waitForDeployment displays updates while the deployment is happening.
+func (o *deploySvcOpts) waitForDeployment(stackEvents <-chan []deploy.ResourceEvent, responses <-chan error) error {
+	for stackEvent := range stackEvents {
+		o.spinner.Events(humanizeWorkloadEvents(stackEvent))
+	}
+	if err := <-responses; err != nil {
 		o.spinner.Stop(log.Serrorf("Failed to deploy service.\n"))
 		return fmt.Errorf("deploy service: %w", err)
 	}
@@ -441,6 +594,11 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 }
 
 func (o *deploySvcOpts) showAppURI() error {
+	if o.targetSvc.Type == manifest.WorkerServiceType {
+		// Worker services aren't reachable, they consume the messages in their queue.
+		log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
 	type identifier interface {
 		URI(string) (string, error)
 	}
@@ -477,7 +635,7 @@ func (o *deploySvcOpts) showAppURI() error {
 	}
 	switch o.targetSvc.Type {
 	case manifest.BackendServiceType:
-		log.Successf("Deployed %s, its service discovery endpoint is %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(uri))
+		log.Successf("Deployed %s, you can access it from the environment at %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(uri))
 	default:
 		log.Successf("Deployed %s, you can access it at %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(uri))
 	}
@@ -495,7 +653,9 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Previews the changes to the service's resources before deploying them.
+  /code $ copilot svc deploy --name frontend --env prod --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -518,6 +678,8 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 
 	return cmd
 }
@@ -4,13 +4,18 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -191,6 +196,62 @@ func TestSvcDeployOpts_Ask(t *testing.T) {
 	}
 }
 
+func TestSvcDeployOpts_Execute(t *testing.T) {
+	mockEnv := &config.Environment{
+		App:  "phonetool",
+		Name: "test",
+	}
+	testCases := map[string]struct {
+		setupMocks func(store *mocks.Mockstore, version *mocks.MockversionGetter)
+
+		wantedErr error
+	}{
+		"should not deploy the service if the environment is on an older template": {
+			setupMocks: func(store *mocks.Mockstore, version *mocks.MockversionGetter) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				version.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+				store.EXPECT().GetApplication(gomock.Any()).Times(0)
+			},
+			wantedErr: fmt.Errorf("environment test is on template version %s, run `copilot env upgrade --app phonetool --name test` to upgrade it to %s", deploy.LegacyEnvTemplateVersion, deploy.LatestEnvTemplateVersion),
+		},
+		"should wrap error if fail to get the version of the environment": {
+			setupMocks: func(store *mocks.Mockstore, version *mocks.MockversionGetter) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				version.EXPECT().Version().Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get template version of environment test in app phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockVersion := mocks.NewMockversionGetter(ctrl)
+			tc.setupMocks(mockStore, mockVersion)
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					appName: "phonetool",
+					name:    "frontend",
+					envName: "test",
+				},
+				store: mockStore,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					return mockVersion, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.EqualError(t, err, tc.wantedErr.Error())
+		})
+	}
+}
+
 func TestSvcDeployOpts_getDockerfile(t *testing.T) {
 	mockError := errors.New("mockError")
 	mockManifest := []byte(`name: serviceA
@@ -471,3 +532,231 @@ func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcDeployOpts_deploySvcWithChangeSet(t *testing.T) {
+	mockChangeSet := &cloudformation.ChangeSet{
+		Name:      "copilot-1234",
+		StackName: "phonetool-test-frontend",
+		Changes: []deploy.ResourceChange{
+			{
+				Resource: deploy.Resource{
+					LogicalName: "TargetGroup",
+					Type:        "AWS::ElasticLoadBalancingV2::TargetGroup",
+				},
+				Action:      "Modify",
+				Replacement: "True",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(cfn *mocks.MocksvcDeployer, prompt *mocks.Mockprompter)
+
+		wantedErr error
+	}{
+		"returns nil if there are no changes to deploy": {
+			setupMocks: func(cfn *mocks.MocksvcDeployer, prompt *mocks.Mockprompter) {
+				cfn.EXPECT().CreateServiceChangeSet(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("create change set: %w", &awscloudformation.ErrChangeSetEmpty{}))
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"wraps error if the change set can't be created": {
+			setupMocks: func(cfn *mocks.MocksvcDeployer, prompt *mocks.Mockprompter) {
+				cfn.EXPECT().CreateServiceChangeSet(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create change set for service frontend: some error"),
+		},
+		"deletes the change set if the user does not confirm the changes": {
+			setupMocks: func(cfn *mocks.MocksvcDeployer, prompt *mocks.Mockprompter) {
+				cfn.EXPECT().CreateServiceChangeSet(gomock.Any(), gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtDeploySvcChangesPrompt, "test"), deploySvcChangesHelp).Return(false, nil)
+				cfn.EXPECT().DeleteChangeSet(mockChangeSet).Return(nil)
+				cfn.EXPECT().StreamChangeSetExecution(gomock.Any()).Times(0)
+			},
+			wantedErr: errOperationCancelled,
+		},
+		"executes the change set once the user confirms the changes": {
+			setupMocks: func(cfn *mocks.MocksvcDeployer, prompt *mocks.Mockprompter) {
+				cfn.EXPECT().CreateServiceChangeSet(gomock.Any(), gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				cfn.EXPECT().StreamChangeSetExecution(mockChangeSet).Return(mockDeploymentResult(nil))
+			},
+		},
+		"skips the confirmation prompt with --yes": {
+			skipConfirmation: true,
+			setupMocks: func(cfn *mocks.MocksvcDeployer, prompt *mocks.Mockprompter) {
+				cfn.EXPECT().CreateServiceChangeSet(gomock.Any(), gomock.Any()).Return(mockChangeSet, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+				cfn.EXPECT().StreamChangeSetExecution(mockChangeSet).Return(mockDeploymentResult(errors.New("some error")))
+			},
+			wantedErr: errors.New("deploy service: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCFN := mocks.NewMocksvcDeployer(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockSpinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockCFN, mockPrompt)
+
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					name:             "frontend",
+					imageTag:         "v1",
+					showDiff:         true,
+					skipConfirmation: tc.skipConfirmation,
+				},
+				svcCFN:            mockCFN,
+				prompt:            mockPrompt,
+				spinner:           mockSpinner,
+				w:                 &bytes.Buffer{},
+				targetEnvironment: &config.Environment{Name: "test"},
+			}
+
+			// WHEN
+			err := opts.deploySvcWithChangeSet(nil)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSvcDeployOpts_writeResourceChanges(t *testing.T) {
+	// GIVEN
+	buf := &bytes.Buffer{}
+	opts := deploySvcOpts{w: buf}
+
+	// WHEN
+	opts.writeResourceChanges([]deploy.ResourceChange{
+		{
+			Resource: deploy.Resource{
+				LogicalName: "TaskDefinition",
+				Type:        "AWS::ECS::TaskDefinition",
+			},
+			Action:      "Modify",
+			Replacement: "False",
+		},
+		{
+			Resource: deploy.Resource{
+				LogicalName: "TargetGroup",
+				Type:        "AWS::ElasticLoadBalancingV2::TargetGroup",
+			},
+			Action:      "Modify",
+			Replacement: "True",
+		},
+	})
+
+	// THEN
+	require.Equal(t, `
+Action              Resource            Type                                      Replacement
+Modify              TaskDefinition      AWS::ECS::TaskDefinition                  -
+Modify              TargetGroup         AWS::ElasticLoadBalancingV2::TargetGroup  Yes
+
+`, buf.String())
+}
+
+func mockDeploymentResult(err error) (<-chan []deploy.ResourceEvent, <-chan error) {
+	events := make(chan []deploy.ResourceEvent)
+	close(events)
+	resp := make(chan error, 1)
+	resp <- err
+	return events, resp
+}
+
+func TestSvcDeployOpts_validateInternalALB(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest *manifest.BackendService
+		setupMocks func(m *mocks.MockenvOutputsDescriber)
+
+		wantedErr error
+	}{
+		"skips the environment if the service isn't routed through the internal load balancer": {
+			inManifest: &manifest.BackendService{},
+			setupMocks: func(m *mocks.MockenvOutputsDescriber) {
+				m.EXPECT().EnvOutputs().Times(0)
+			},
+		},
+		"returns nil if the environment has an internal load balancer": {
+			inManifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Path: aws.String("api"),
+					},
+				},
+			},
+			setupMocks: func(m *mocks.MockenvOutputsDescriber) {
+				m.EXPECT().EnvOutputs().Return(map[string]string{
+					"InternalLoadBalancerDNSName": "internal-phonetool-test.us-west-2.elb.amazonaws.com",
+				}, nil)
+			},
+		},
+		"wraps error if fail to get the outputs of the environment": {
+			inManifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					RoutingRule: manifest.RoutingRule{
+						Path: aws.String("api"),
+					},
+				},
+			},
+			setupMocks: func(m *mocks.MockenvOutputsDescriber) {
+				m.EXPECT().EnvOutputs().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of environment test: some error"),
+		},
+		"returns an error if the environment has no internal load balancer": {
+			inManifest: &manifest.BackendService{
+				Environments: map[string]*manifest.BackendServiceConfig{
+					"test": {
+						RoutingRule: manifest.RoutingRule{
+							Path: aws.String("api"),
+						},
+					},
+				},
+			},
+			setupMocks: func(m *mocks.MockenvOutputsDescriber) {
+				m.EXPECT().EnvOutputs().Return(map[string]string{
+					"PublicLoadBalancerDNSName": "phonetool-test.us-west-2.elb.amazonaws.com",
+				}, nil)
+			},
+			wantedErr: errors.New(`service api sets "http.path" but environment test has no internal load balancer: set "http.private.enabled: true" in the environment manifest and run ` + "`copilot env deploy --name test`"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockenvOutputsDescriber(ctrl)
+			tc.setupMocks(m)
+			opts := deploySvcOpts{
+				deploySvcVars: deploySvcVars{
+					name:    "api",
+					envName: "test",
+				},
+				envDescriber: m,
+			}
+
+			// WHEN
+			err := opts.validateInternalALB(tc.inManifest)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
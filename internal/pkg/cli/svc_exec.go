@@ -0,0 +1,323 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/command"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcExecAppNamePrompt     = "Which application is the service in?"
+	svcExecAppNameHelpPrompt = "An application groups all of your services together."
+	svcExecNamePrompt        = "Which service would you like to execute a command in?"
+	svcExecNameHelpPrompt    = "The command runs in a running task of the service."
+
+	defaultExecCommand = "/bin/sh"
+
+	ssmPluginBinaryName = "session-manager-plugin"
+	ssmPluginInstallURL = "https://docs.aws.amazon.com/systems-manager/latest/userguide/session-manager-working-with-install-plugin.html"
+	fmtECSEndpoint      = "https://ecs.%s.amazonaws.com"
+
+	taskStatusRunning = "RUNNING"
+)
+
+// ssmMessagesActions are the actions that the task role needs to open a Session Manager session with the ECS agent.
+var ssmMessagesActions = []string{
+	"ssmmessages:CreateControlChannel",
+	"ssmmessages:OpenControlChannel",
+	"ssmmessages:CreateDataChannel",
+	"ssmmessages:OpenDataChannel",
+}
+
+type execSvcVars struct {
+	appName       string
+	name          string
+	envName       string
+	taskID        string
+	containerName string
+	command       string
+}
+
+type execSvcOpts struct {
+	execSvcVars
+
+	store     store
+	sel       deploySelector
+	ssmPlugin runner
+
+	describer   serviceARNDescriber
+	ecs         ecsCommandExecutor
+	iam         deniedActionsChecker
+	initClients func(*execSvcOpts) error
+
+	// cached variables
+	targetEnvironment *config.Environment
+}
+
+func newSvcExecOpts(vars execSvcVars) (*execSvcOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &execSvcOpts{
+		execSvcVars: vars,
+
+		store:     configStore,
+		sel:       selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		ssmPlugin: command.New(),
+		initClients: func(o *execSvcOpts) error {
+			env, err := o.store.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			o.targetEnvironment = env
+			d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.name,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create describer for service %s: %w", o.name, err)
+			}
+			o.describer = d
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume environment manager role: %w", err)
+			}
+			o.ecs = ecs.New(sess)
+			o.iam = iam.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *execSvcOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *execSvcOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute starts an interactive session that runs the command in a container of a running task of the service.
+func (o *execSvcOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	task, err := o.runningTask()
+	if err != nil {
+		return err
+	}
+	if err := o.checkTaskRole(task); err != nil {
+		return err
+	}
+	container := o.containerName
+	if container == "" {
+		container = o.name
+	}
+	target, err := task.SSMTarget(container)
+	if err != nil {
+		return err
+	}
+	taskID, err := ecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	session, err := o.ecs.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   aws.StringValue(task.ClusterArn),
+		Command:   o.command,
+		Task:      taskID,
+		Container: container,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Execute %s in container %s of task %s.\n",
+		color.HighlightCode(o.command), color.HighlightUserInput(container), color.HighlightResource(taskID))
+	return o.startSession(session, target)
+}
+
+func (o *execSvcOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcExecAppNamePrompt, svcExecAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *execSvcOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// runningTask returns the running task of the service whose ID starts with the --task-id flag,
+// or the first running task if the flag is not set.
+func (o *execSvcOpts) runningTask() (*ecs.Task, error) {
+	serviceARN, err := o.describer.ServiceARN()
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service of service %s: %w", o.name, err)
+	}
+	clusterName, err := serviceARN.ClusterName()
+	if err != nil {
+		return nil, fmt.Errorf("get cluster name: %w", err)
+	}
+	serviceName, err := serviceARN.ServiceName()
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service name: %w", err)
+	}
+	tasks, err := o.ecs.ServiceTasks(clusterName, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("get tasks of service %s: %w", o.name, err)
+	}
+	for _, task := range tasks {
+		if aws.StringValue(task.LastStatus) != taskStatusRunning {
+			continue
+		}
+		taskID, err := ecs.TaskID(aws.StringValue(task.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasPrefix(taskID, o.taskID) {
+			return task, nil
+		}
+	}
+	if o.taskID != "" {
+		return nil, fmt.Errorf("no running task of service %s found with ID %s", o.name, o.taskID)
+	}
+	return nil, fmt.Errorf("no running tasks found for service %s in environment %s", o.name, o.envName)
+}
+
+// checkTaskRole returns an error if the task role doesn't allow the ECS agent to open a Session Manager session.
+func (o *execSvcOpts) checkTaskRole(task *ecs.Task) error {
+	taskDef, err := o.ecs.TaskDefinition(aws.StringValue(task.TaskDefinitionArn))
+	if err != nil {
+		return fmt.Errorf("get task definition of service %s: %w", o.name, err)
+	}
+	denied, err := o.iam.DeniedActions(aws.StringValue(taskDef.TaskRoleArn), ssmMessagesActions)
+	if err != nil {
+		return fmt.Errorf("check permissions of the task role: %w", err)
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+	log.Errorf(`The task role of service %s is not allowed to perform %s.
+Set %s in the manifest and run %s to enable executing commands.
+`, o.name, strings.Join(denied, ", "), color.HighlightCode("exec: true"),
+		color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, o.envName)))
+	return fmt.Errorf("execute command is not enabled for service %s", o.name)
+}
+
+func (o *execSvcOpts) startSession(session *ecs.Session, target string) error {
+	sessionJSON, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+	targetJSON, err := json.Marshal(map[string]string{
+		"Target": target,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal session target: %w", err)
+	}
+	region := o.targetEnvironment.Region
+	err = o.ssmPlugin.Run(ssmPluginBinaryName, []string{
+		string(sessionJSON), region, "StartSession", "", string(targetJSON), fmt.Sprintf(fmtECSEndpoint, region),
+	}, command.Stdin(os.Stdin), command.Stdout(os.Stdout), command.Stderr(os.Stderr))
+	if errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("%s is not installed, see %s", ssmPluginBinaryName, ssmPluginInstallURL)
+	}
+	if err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
+// buildSvcExecCmd builds the command for executing a command in a running container of a service.
+func buildSvcExecCmd() *cobra.Command {
+	vars := execSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Execute a command in a running container of a service.",
+		Long: `Execute a command in a running container of a service.
+The service must be deployed with "exec: true" in its manifest.`,
+
+		Example: `
+  Start an interactive shell in a task of the service "api" in the "test" environment.
+  /code $ copilot svc exec -n api -e test
+  Run a command in the "nginx" container of a specific task.
+  /code $ copilot svc exec -n api -e test --task-id 8c38184 --container nginx --command "cat /etc/nginx/nginx.conf"`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcExecOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", execTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", execContainerFlagDescription)
+	cmd.Flags().StringVar(&vars.command, commandFlag, defaultExecCommand, execCommandFlagDescription)
+	return cmd
+}
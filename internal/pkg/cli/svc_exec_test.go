@@ -0,0 +1,299 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcExecOpts_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp     string
+		mockSelector func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcExecAppNamePrompt, svcExecAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcExecAppNamePrompt, svcExecAppNameHelpPrompt).Return("phonetool", nil)
+				m.EXPECT().DeployedService(svcExecNamePrompt, svcExecNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "test",
+						Svc: "api",
+					}, nil)
+			},
+
+			wantedApp: "phonetool",
+			wantedSvc: "api",
+			wantedEnv: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &execSvcOpts{
+				execSvcVars: execSvcVars{
+					appName: tc.inputApp,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+type execSvcMocks struct {
+	describer *mocks.MockserviceARNDescriber
+	ecs       *mocks.MockecsCommandExecutor
+	iam       *mocks.MockdeniedActionsChecker
+	ssmPlugin *mocks.Mockrunner
+}
+
+func TestSvcExecOpts_Execute(t *testing.T) {
+	const (
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789:cluster/phonetool-test-Cluster-9F7Y0RLP60R7"
+		mockTaskDefARN = "arn:aws:ecs:us-west-2:123456789:task-definition/phonetool-test-api:3"
+		mockTaskRole   = "arn:aws:iam::123456789:role/phonetool-test-api-TaskRole"
+	)
+	mockError := errors.New("some error")
+	mockServiceARN := ecs.ServiceArn("arn:aws:ecs:us-west-2:123456789:service/phonetool-test-Cluster-9F7Y0RLP60R7/phonetool-test-api-Service-JSOH5GYBFAIB")
+	mockTask := func(id, status string) *ecs.Task {
+		return &ecs.Task{
+			ClusterArn:        aws.String(mockClusterARN),
+			TaskArn:           aws.String("arn:aws:ecs:us-west-2:123456789:task/phonetool-test-Cluster-9F7Y0RLP60R7/" + id),
+			TaskDefinitionArn: aws.String(mockTaskDefARN),
+			LastStatus:        aws.String(status),
+			Containers: []*awsecs.Container{
+				{
+					Name:      aws.String("api"),
+					RuntimeId: aws.String(id + "-1111111111"),
+				},
+				{
+					Name:      aws.String("nginx"),
+					RuntimeId: aws.String(id + "-2222222222"),
+				},
+			},
+		}
+	}
+	mockSession := &ecs.Session{
+		SessionID:  aws.String("ecs-execute-command-0123"),
+		StreamURL:  aws.String("wss://ssmmessages.us-west-2.amazonaws.com/v1/data-channel/ecs-execute-command-0123"),
+		TokenValue: aws.String("token"),
+	}
+	mockSessionArg := `{"SessionId":"ecs-execute-command-0123","StreamUrl":"wss://ssmmessages.us-west-2.amazonaws.com/v1/data-channel/ecs-execute-command-0123","TokenValue":"token"}`
+
+	testCases := map[string]struct {
+		inTaskID    string
+		inContainer string
+		setupMocks  func(m execSvcMocks)
+
+		wantedError error
+	}{
+		"errors if fail to get the ECS service": {
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(nil, mockError)
+			},
+
+			wantedError: errors.New("get ECS service of service api: some error"),
+		},
+		"errors if there are no running tasks": {
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(&mockServiceARN, nil)
+				m.ecs.EXPECT().ServiceTasks("phonetool-test-Cluster-9F7Y0RLP60R7", "phonetool-test-api-Service-JSOH5GYBFAIB").
+					Return([]*ecs.Task{mockTask("4082490ee6c245e09d2145010aa1ba8d", "PROVISIONING")}, nil)
+			},
+
+			wantedError: errors.New("no running tasks found for service api in environment test"),
+		},
+		"errors if the task with the ID is not running": {
+			inTaskID: "8c38184",
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(&mockServiceARN, nil)
+				m.ecs.EXPECT().ServiceTasks(gomock.Any(), gomock.Any()).
+					Return([]*ecs.Task{mockTask("4082490ee6c245e09d2145010aa1ba8d", "RUNNING")}, nil)
+			},
+
+			wantedError: errors.New("no running task of service api found with ID 8c38184"),
+		},
+		"errors if the task role doesn't allow SSM messages": {
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(&mockServiceARN, nil)
+				m.ecs.EXPECT().ServiceTasks(gomock.Any(), gomock.Any()).
+					Return([]*ecs.Task{mockTask("4082490ee6c245e09d2145010aa1ba8d", "RUNNING")}, nil)
+				m.ecs.EXPECT().TaskDefinition(mockTaskDefARN).Return(&ecs.TaskDefinition{
+					TaskRoleArn: aws.String(mockTaskRole),
+				}, nil)
+				m.iam.EXPECT().DeniedActions(mockTaskRole, ssmMessagesActions).Return([]string{"ssmmessages:CreateControlChannel"}, nil)
+				m.ecs.EXPECT().ExecuteCommand(gomock.Any()).Times(0)
+			},
+
+			wantedError: errors.New("execute command is not enabled for service api"),
+		},
+		"errors if the session manager plugin is not installed": {
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(&mockServiceARN, nil)
+				m.ecs.EXPECT().ServiceTasks(gomock.Any(), gomock.Any()).
+					Return([]*ecs.Task{mockTask("4082490ee6c245e09d2145010aa1ba8d", "RUNNING")}, nil)
+				m.ecs.EXPECT().TaskDefinition(gomock.Any()).Return(&ecs.TaskDefinition{
+					TaskRoleArn: aws.String(mockTaskRole),
+				}, nil)
+				m.iam.EXPECT().DeniedActions(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.ecs.EXPECT().ExecuteCommand(gomock.Any()).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any()).
+					Return(&exec.Error{Name: ssmPluginBinaryName, Err: exec.ErrNotFound})
+			},
+
+			wantedError: fmt.Errorf("%s is not installed, see %s", ssmPluginBinaryName, ssmPluginInstallURL),
+		},
+		"starts a session in the main container of the first running task": {
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(&mockServiceARN, nil)
+				m.ecs.EXPECT().ServiceTasks(gomock.Any(), gomock.Any()).Return([]*ecs.Task{
+					mockTask("1111111111c245e09d2145010aa1ba8d", "STOPPED"),
+					mockTask("4082490ee6c245e09d2145010aa1ba8d", "RUNNING"),
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition(gomock.Any()).Return(&ecs.TaskDefinition{
+					TaskRoleArn: aws.String(mockTaskRole),
+				}, nil)
+				m.iam.EXPECT().DeniedActions(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.ecs.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Command:   "/bin/sh",
+					Task:      "4082490ee6c245e09d2145010aa1ba8d",
+					Container: "api",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().Run(ssmPluginBinaryName, []string{
+					mockSessionArg,
+					"us-west-2",
+					"StartSession",
+					"",
+					`{"Target":"ecs:phonetool-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-1111111111"}`,
+					"https://ecs.us-west-2.amazonaws.com",
+				}, gomock.Any()).Return(nil)
+			},
+		},
+		"starts a session in the container of the task with the ID": {
+			inTaskID:    "8c38184",
+			inContainer: "nginx",
+			setupMocks: func(m execSvcMocks) {
+				m.describer.EXPECT().ServiceARN().Return(&mockServiceARN, nil)
+				m.ecs.EXPECT().ServiceTasks(gomock.Any(), gomock.Any()).Return([]*ecs.Task{
+					mockTask("4082490ee6c245e09d2145010aa1ba8d", "RUNNING"),
+					mockTask("8c381840ee6c245e09d2145010aa1ba8", "RUNNING"),
+				}, nil)
+				m.ecs.EXPECT().TaskDefinition(gomock.Any()).Return(&ecs.TaskDefinition{
+					TaskRoleArn: aws.String(mockTaskRole),
+				}, nil)
+				m.iam.EXPECT().DeniedActions(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.ecs.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Command:   "/bin/sh",
+					Task:      "8c381840ee6c245e09d2145010aa1ba8",
+					Container: "nginx",
+				}).Return(mockSession, nil)
+				m.ssmPlugin.EXPECT().Run(ssmPluginBinaryName, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := execSvcMocks{
+				describer: mocks.NewMockserviceARNDescriber(ctrl),
+				ecs:       mocks.NewMockecsCommandExecutor(ctrl),
+				iam:       mocks.NewMockdeniedActionsChecker(ctrl),
+				ssmPlugin: mocks.NewMockrunner(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &execSvcOpts{
+				execSvcVars: execSvcVars{
+					appName:       "phonetool",
+					name:          "api",
+					envName:       "test",
+					taskID:        tc.inTaskID,
+					containerName: tc.inContainer,
+					command:       "/bin/sh",
+				},
+				ssmPlugin: m.ssmPlugin,
+				initClients: func(o *execSvcOpts) error {
+					o.targetEnvironment = &config.Environment{
+						Name:   "test",
+						Region: "us-west-2",
+					}
+					o.describer = m.describer
+					o.ecs = m.ecs
+					o.iam = m.iam
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
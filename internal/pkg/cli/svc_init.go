@@ -31,7 +31,10 @@ var (
 To learn more see: https://git.io/JfIpv
 
 A %s is a private, non internet-facing service.
-To learn more see: https://git.io/JfIpT`
+To learn more see: https://git.io/JfIpT
+
+A %s is a private service that processes the messages published to the topics it subscribes to.
+To learn more see: https://github.com/aws/copilot-cli/wiki/Manifests#worker-svc`
 
 	fmtWkldInitNamePrompt     = "What do you want to %s this %s?"
 	fmtWkldInitNameHelpPrompt = `The name will uniquely identify this %s within your app %s.
@@ -208,12 +211,12 @@ func (o *initSvcOpts) Execute() error {
 }
 
 func (o *initSvcOpts) createManifest() (string, error) {
-	manifest, err := o.newManifest()
+	mft, err := o.newManifest()
 	if err != nil {
 		return "", err
 	}
 	var manifestExists bool
-	manifestPath, err := o.ws.WriteServiceManifest(manifest, o.name)
+	manifestPath, err := o.ws.WriteServiceManifest(mft, o.name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -232,7 +235,11 @@ func (o *initSvcOpts) createManifest() (string, error) {
 		manifestMsgFmt = "Manifest file for service %s already exists at %s, skipping writing it.\n"
 	}
 	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.name), color.HighlightResource(manifestPath))
-	log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", o.port)))
+	if o.serviceType == manifest.WorkerServiceType {
+		log.Infoln(color.Help("Your manifest contains configurations like your container size and the topics to subscribe to."))
+	} else {
+		log.Infoln(color.Help(fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", o.port)))
+	}
 	log.Infoln()
 
 	return manifestPath, nil
@@ -244,6 +251,8 @@ func (o *initSvcOpts) newManifest() (encoding.BinaryMarshaler, error) {
 		return o.newLoadBalancedWebServiceManifest()
 	case manifest.BackendServiceType:
 		return o.newBackendServiceManifest()
+	case manifest.WorkerServiceType:
+		return o.newWorkerServiceManifest()
 	default:
 		return nil, fmt.Errorf("service type %s doesn't have a manifest", o.serviceType)
 	}
@@ -308,6 +317,31 @@ func (o *initSvcOpts) newBackendServiceManifest() (*manifest.BackendService, err
 	}), nil
 }
 
+func (o *initSvcOpts) newWorkerServiceManifest() (*manifest.WorkerService, error) {
+	var err error
+	var dfPath string
+	var hc *manifest.ContainerHealthCheck
+	if o.dockerfilePath != "" {
+		dfPath, err = relativeDockerfilePath(o.ws, o.dockerfilePath)
+		if err != nil {
+			return nil, err
+		}
+		hc, err = o.parseHealthCheck()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest.NewWorkerService(manifest.WorkerServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       o.name,
+			Dockerfile: dfPath,
+			Builder:    o.buildpackBuilder,
+		},
+		HealthCheck: hc,
+	}), nil
+}
+
 func (o *initSvcOpts) askSvcType() error {
 	if o.serviceType != "" {
 		return nil
@@ -316,6 +350,7 @@ func (o *initSvcOpts) askSvcType() error {
 	help := fmt.Sprintf(fmtSvcInitSvcTypeHelpPrompt,
 		manifest.LoadBalancedWebServiceType,
 		manifest.BackendServiceType,
+		manifest.WorkerServiceType,
 	)
 	msg := fmt.Sprintf(fmtSvcInitSvcTypePrompt, color.Emphasize("service type"))
 	t, err := o.prompt.SelectOne(msg, help, manifest.ServiceTypes, prompt.WithFinalMessage("Service type:"))
@@ -392,6 +427,10 @@ func (o *initSvcOpts) askSvcPort() error {
 	if o.port != 0 {
 		return nil
 	}
+	// Worker services don't receive traffic.
+	if o.serviceType == manifest.WorkerServiceType {
+		return nil
+	}
 
 	var defaultPort string
 
@@ -530,7 +569,7 @@ This command is also run as part of "copilot init".`,
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":                          fmt.Sprintf(`Required,%s`, strings.Join(manifest.ServiceTypes, ",")),
+		"sections":                          fmt.Sprintf(`Required,%s`, strings.Join([]string{manifest.LoadBalancedWebServiceType, manifest.BackendServiceType}, ",")),
 		"Required":                          requiredFlags.FlagUsages(),
 		"Build":                             buildFlags.FlagUsages(),
 		manifest.LoadBalancedWebServiceType: lbWebSvcFlags.FlagUsages(),
@@ -34,7 +34,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		"invalid service type": {
 			inAppName: "phonetool",
 			inSvcType: "TestSvcType",
-			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Load Balanced Web Service", "Backend Service"`),
+			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Load Balanced Web Service", "Backend Service", "Worker Service"`),
 		},
 		"invalid service name": {
 			inAppName: "phonetool",
@@ -561,6 +561,45 @@ func TestAppInitOpts_Execute(t *testing.T) {
 						nil)
 			},
 		},
+		"writes Worker Service manifest without a port": {
+			inSvcType:        manifest.WorkerServiceType,
+			inAppName:        "app",
+			inSvcName:        "processor",
+			inDockerfilePath: "processor/Dockerfile",
+
+			mockWriter: func(m *mocks.MocksvcDirManifestWriter) {
+				m.EXPECT().CopilotDirPath().Return("/processor", nil)
+				m.EXPECT().WriteServiceManifest(gomock.Any(), "processor").
+					Do(func(m *manifest.WorkerService, _ string) {
+						require.Equal(t, *m.Workload.Type, manifest.WorkerServiceType)
+						require.Nil(t, m.Image.HealthCheck)
+					}).Return("/processor/manifest.yml", nil)
+			},
+			mockstore: func(m *mocks.Mockstore) {
+				m.EXPECT().CreateService(&config.Workload{
+					Name: "processor",
+					App:  "app",
+					Type: manifest.WorkerServiceType,
+				}).Return(nil)
+				m.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+			},
+			mockappDeployer: func(m *mocks.MockappDeployer) {
+				m.EXPECT().AddServiceToApp(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, "processor")
+			},
+			mockProg: func(m *mocks.Mockprogress) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddSvcToAppStart, "processor"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddSvcToAppComplete, "processor"))
+			},
+			mockDf: func(m *mocks.MockdockerfileParser) {
+				m.EXPECT().GetHealthCheck().Return(nil, nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -97,7 +97,7 @@ func (o *listSvcOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("get local services names: %w", err)
 		}
-		svcs = filterSvcsByName(svcs, localNames)
+		svcs = filterWorkloadsByName(svcs, localNames)
 	}
 
 	var out string
@@ -142,17 +142,17 @@ func (o *listSvcOpts) jsonOutput(svcs []*config.Workload) (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
-func filterSvcsByName(svcs []*config.Workload, wantedNames []string) []*config.Workload {
+func filterWorkloadsByName(wklds []*config.Workload, wantedNames []string) []*config.Workload {
 	isWanted := make(map[string]bool)
 	for _, name := range wantedNames {
 		isWanted[name] = true
 	}
 	var filtered []*config.Workload
-	for _, svc := range svcs {
-		if _, ok := isWanted[svc.Name]; !ok {
+	for _, wkld := range wklds {
+		if _, ok := isWanted[wkld.Name]; !ok {
 			continue
 		}
-		filtered = append(filtered, svc)
+		filtered = append(filtered, wkld)
 	}
 	return filtered
 }
@@ -106,6 +106,11 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 				}
+			} else if env.HasImportedCerts() {
+				serializer, err = stack.NewHTTPSLoadBalancedWebServiceWithImportedCerts(v, env.Name, app.Name, rc)
+				if err != nil {
+					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
+				}
 			} else {
 				serializer, err = stack.NewLoadBalancedWebService(v, env.Name, app.Name, rc)
 				if err != nil {
@@ -117,6 +122,16 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 			if err != nil {
 				return nil, fmt.Errorf("init backend service stack serializer: %w", err)
 			}
+		case *manifest.WorkerService:
+			serializer, err = stack.NewWorkerService(v, env.Name, app.Name, rc)
+			if err != nil {
+				return nil, fmt.Errorf("init worker service stack serializer: %w", err)
+			}
+		case *manifest.ScheduledJob:
+			serializer, err = stack.NewScheduledJob(v, env.Name, app.Name, rc)
+			if err != nil {
+				return nil, fmt.Errorf("init scheduled job stack serializer: %w", err)
+			}
 		default:
 			return nil, fmt.Errorf("create stack serializer for manifest of type %T", v)
 		}
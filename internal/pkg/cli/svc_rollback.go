@@ -0,0 +1,294 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackAppNamePrompt      = "Which application is the service in?"
+	svcRollbackAppNameHelpPrompt  = "An application groups all of your services together."
+	svcRollbackNamePrompt         = "Which service's image would you like to roll back?"
+	svcRollbackNameHelpPrompt     = "The service is redeployed with the container image of a previous deployment."
+	svcRollbackRevisionPrompt     = "Which deployment's image would you like to roll back to?"
+	svcRollbackRevisionHelpPrompt = "Previous deployments of the service are listed from newest to oldest."
+
+	fmtSvcRollbackDeploymentOption = "%s (revision %d, %s, deployed %s)"
+
+	// svcRollbackMaxDeployments is the number of recent deployments whose image the service can be rolled back to.
+	svcRollbackMaxDeployments = 20
+)
+
+var (
+	errNoPreviousDeployments = errors.New("no previous deployments to roll back to")
+)
+
+// humanizeTime is overridden in tests so that its output is constant as time passes.
+var humanizeTime = humanize.Time
+
+type rollbackSvcVars struct {
+	appName string
+	name    string
+	envName string
+	to      string
+}
+
+type rollbackSvcOpts struct {
+	rollbackSvcVars
+
+	store   store
+	sel     deploySelector
+	prompt  prompter
+	spinner progress
+
+	describer   svcDeploymentsDescriber
+	svcCFN      svcRollbacker
+	initClients func(*rollbackSvcOpts) error
+
+	// cached variables
+	targetEnvironment *config.Environment
+}
+
+func newSvcRollbackOpts(vars rollbackSvcVars) (*rollbackSvcOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &rollbackSvcOpts{
+		rollbackSvcVars: vars,
+
+		store:   configStore,
+		sel:     selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:  prompter,
+		spinner: termprogress.NewSpinner(),
+		initClients: func(o *rollbackSvcOpts) error {
+			env, err := o.store.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			o.targetEnvironment = env
+			d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.name,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create describer for service %s: %w", o.name, err)
+			}
+			o.describer = d
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume environment manager role: %w", err)
+			}
+			o.svcCFN = cloudformation.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *rollbackSvcOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *rollbackSvcOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askSvcEnvName()
+}
+
+// Execute redeploys the service with the container image of a previous deployment.
+// The rest of the service's configuration is the one that's currently deployed.
+func (o *rollbackSvcOpts) Execute() error {
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	deployments, err := o.describer.Deployments(svcRollbackMaxDeployments)
+	if err != nil {
+		return fmt.Errorf("list deployments of service %s: %w", o.name, err)
+	}
+	if len(deployments) < 2 {
+		return fmt.Errorf("service %s in environment %s: %w", o.name, o.envName, errNoPreviousDeployments)
+	}
+	// The first deployment is the one that's currently running.
+	target, err := o.targetDeployment(deployments[0], deployments[1:])
+	if err != nil {
+		return err
+	}
+	params, err := o.describer.Params()
+	if err != nil {
+		return fmt.Errorf("get parameters of service %s: %w", o.name, err)
+	}
+	params[stack.WorkloadContainerImageParamKey] = target.Image
+
+	o.spinner.Start(fmt.Sprintf("Rolling back the image of %s in %s to %s.",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName), color.HighlightResource(target.Image)))
+	stackEvents, responses := o.svcCFN.StreamServiceRollback(stack.NameForService(o.appName, o.envName, o.name), params,
+		awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN))
+	for stackEvent := range stackEvents {
+		o.spinner.Events(humanizeWorkloadEvents(stackEvent))
+	}
+	if err := <-responses; err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to roll back the image of the service.\n"))
+		return fmt.Errorf("roll back image of service %s: %w", o.name, err)
+	}
+	o.spinner.Stop("\n")
+	log.Successf("Rolled back the image of %s in %s to %s from revision %d.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName), color.HighlightResource(target.Image), target.Revision)
+	return nil
+}
+
+// RecommendedActions returns follow-up actions the user can take after successfully executing the command.
+func (o *rollbackSvcOpts) RecommendedActions() []string {
+	return []string{
+		fmt.Sprintf("Run %s to check the health of the service.",
+			color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.name, o.envName))),
+	}
+}
+
+func (o *rollbackSvcOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcRollbackAppNamePrompt, svcRollbackAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *rollbackSvcOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+// targetDeployment returns the previous deployment matching the --to flag, or prompts the user to select one.
+func (o *rollbackSvcOpts) targetDeployment(current describe.ServiceDeployment, previous []describe.ServiceDeployment) (*describe.ServiceDeployment, error) {
+	if o.to != "" {
+		if matchesDeployment(current, o.to) {
+			return nil, fmt.Errorf("service %s is already running %s", o.name, o.to)
+		}
+		for i := range previous {
+			if matchesDeployment(previous[i], o.to) {
+				return &previous[i], nil
+			}
+		}
+		return nil, fmt.Errorf("deployment %s not found in the %d most recent deployments of service %s", o.to, svcRollbackMaxDeployments, o.name)
+	}
+
+	var options []string
+	deployments := make(map[string]*describe.ServiceDeployment)
+	for i, d := range previous {
+		option := fmt.Sprintf(fmtSvcRollbackDeploymentOption, deploymentTag(d), d.Revision, d.Image, humanizeTime(d.DeployedAt))
+		options = append(options, option)
+		deployments[option] = &previous[i]
+	}
+	selected, err := o.prompt.SelectOne(svcRollbackRevisionPrompt, svcRollbackRevisionHelpPrompt, options)
+	if err != nil {
+		return nil, fmt.Errorf("select deployment to roll back to: %w", err)
+	}
+	return deployments[selected], nil
+}
+
+// matchesDeployment returns true if the value is the deployment's image tag, image, or task definition revision.
+func matchesDeployment(d describe.ServiceDeployment, value string) bool {
+	return value == d.ImageTag || value == d.Image || value == strconv.FormatInt(d.Revision, 10)
+}
+
+func deploymentTag(d describe.ServiceDeployment) string {
+	if d.ImageTag == "" {
+		return "-"
+	}
+	return d.ImageTag
+}
+
+// buildSvcRollbackCmd builds the command for rolling back the image of a service to a previous deployment.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := rollbackSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rolls back the container image of a service to the one of a previous deployment.",
+		Long: `Rolls back the container image of a service to the one of a previous deployment.
+The service is redeployed with the image of the previous deployment without rebuilding it.
+The rest of its configuration, such as variables and resources, is not rolled back.`,
+
+		Example: `
+  Select a previous deployment of the service "api" in the "prod" environment to roll back its image to.
+  /code $ copilot svc rollback -n api -e prod
+  Roll back the image of the service "api" to the one tagged "v1.2.0".
+  /code $ copilot svc rollback -n api -e prod --to v1.2.0`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			log.Infoln("Recommended follow-up actions:")
+			for _, followup := range opts.RecommendedActions() {
+				log.Infof("- %s\n", followup)
+			}
+			return nil
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.to, rollbackToFlag, "", rollbackToFlagDescription)
+	return cmd
+}
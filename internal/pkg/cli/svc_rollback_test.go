@@ -0,0 +1,252 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRollbackOpts_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp         string
+		inputSvc         string
+		inputEnvironment string
+		mockSelector     func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"errors if failed to select application": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcRollbackAppNamePrompt, svcRollbackAppNameHelpPrompt).Return("", mockError)
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inputApp: "phonetool",
+
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("select deployed services for application phonetool: some error"),
+		},
+		"success": {
+			mockSelector: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(svcRollbackAppNamePrompt, svcRollbackAppNameHelpPrompt).Return("phonetool", nil)
+				m.EXPECT().DeployedService(svcRollbackNamePrompt, svcRollbackNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "prod",
+						Svc: "api",
+					}, nil)
+			},
+
+			wantedApp: "phonetool",
+			wantedSvc: "api",
+			wantedEnv: "prod",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &rollbackSvcOpts{
+				rollbackSvcVars: rollbackSvcVars{
+					appName: tc.inputApp,
+					name:    tc.inputSvc,
+					envName: tc.inputEnvironment,
+				},
+				sel: mockSelector,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcRollbackOpts_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	mockDeployments := []describe.ServiceDeployment{
+		{
+			Revision: 3,
+			Image:    "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v3",
+			ImageTag: "v3",
+		},
+		{
+			Revision: 2,
+			Image:    "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2",
+			ImageTag: "v2",
+		},
+		{
+			Revision: 1,
+			Image:    "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+			ImageTag: "v1",
+		},
+	}
+	mockParams := func() map[string]string {
+		return map[string]string{
+			"ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v3",
+			"TaskCount":      "2",
+		}
+	}
+	mockRolledBackParams := func(image string) map[string]string {
+		return map[string]string{
+			"ContainerImage": image,
+			"TaskCount":      "2",
+		}
+	}
+	testCases := map[string]struct {
+		inTo       string
+		setupMocks func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter)
+
+		wantedError error
+	}{
+		"errors if failed to list deployments": {
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(svcRollbackMaxDeployments).Return(nil, mockError)
+			},
+
+			wantedError: errors.New("list deployments of service api: some error"),
+		},
+		"errors if there are no previous deployments": {
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(gomock.Any()).Return(mockDeployments[:1], nil)
+			},
+
+			wantedError: fmt.Errorf("service api in environment prod: %w", errNoPreviousDeployments),
+		},
+		"errors if the service already runs the deployment": {
+			inTo: "v3",
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(gomock.Any()).Return(mockDeployments, nil)
+			},
+
+			wantedError: errors.New("service api is already running v3"),
+		},
+		"errors if the deployment can't be found": {
+			inTo: "v0",
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(gomock.Any()).Return(mockDeployments, nil)
+			},
+
+			wantedError: fmt.Errorf("deployment v0 not found in the %d most recent deployments of service api", svcRollbackMaxDeployments),
+		},
+		"rolls back to the deployment with the revision from the flag": {
+			inTo: "1",
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(gomock.Any()).Return(mockDeployments, nil)
+				describer.EXPECT().Params().Return(mockParams(), nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				cfn.EXPECT().StreamServiceRollback("phonetool-prod-api", mockRolledBackParams(mockDeployments[2].Image), gomock.Any()).
+					Return(mockDeploymentResult(nil))
+			},
+		},
+		"rolls back to the selected deployment": {
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(gomock.Any()).Return(mockDeployments, nil)
+				prompt.EXPECT().SelectOne(svcRollbackRevisionPrompt, svcRollbackRevisionHelpPrompt, []string{
+					"v2 (revision 2, 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2, deployed 2 hours ago)",
+					"v1 (revision 1, 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1, deployed 2 hours ago)",
+				}).Return("v2 (revision 2, 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2, deployed 2 hours ago)", nil)
+				describer.EXPECT().Params().Return(mockParams(), nil)
+				cfn.EXPECT().StreamServiceRollback("phonetool-prod-api", mockRolledBackParams(mockDeployments[1].Image), gomock.Any()).
+					Return(mockDeploymentResult(nil))
+			},
+		},
+		"wraps error if the rollback fails": {
+			inTo: "v2",
+			setupMocks: func(describer *mocks.MocksvcDeploymentsDescriber, cfn *mocks.MocksvcRollbacker, prompt *mocks.Mockprompter) {
+				describer.EXPECT().Deployments(gomock.Any()).Return(mockDeployments, nil)
+				describer.EXPECT().Params().Return(mockParams(), nil)
+				cfn.EXPECT().StreamServiceRollback(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockDeploymentResult(mockError))
+			},
+
+			wantedError: errors.New("roll back image of service api: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDescriber := mocks.NewMocksvcDeploymentsDescriber(ctrl)
+			mockCFN := mocks.NewMocksvcRollbacker(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockSpinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			mockSpinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+			tc.setupMocks(mockDescriber, mockCFN, mockPrompt)
+
+			humanizeTime = func(then time.Time) string {
+				return "2 hours ago"
+			}
+			defer func() { humanizeTime = humanize.Time }()
+
+			opts := &rollbackSvcOpts{
+				rollbackSvcVars: rollbackSvcVars{
+					appName: "phonetool",
+					name:    "api",
+					envName: "prod",
+					to:      tc.inTo,
+				},
+				prompt:  mockPrompt,
+				spinner: mockSpinner,
+				initClients: func(o *rollbackSvcOpts) error {
+					o.targetEnvironment = &config.Environment{
+						Name:             "prod",
+						ExecutionRoleARN: "arn:aws:iam::1234:role/phonetool-prod-CFNExecutionRole",
+					}
+					o.describer = mockDescriber
+					o.svcCFN = mockCFN
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,383 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"syscall"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRunLocalNamePrompt    = "Which service would you like to run locally?"
+	svcRunLocalEnvNamePrompt = "Which environment's configuration would you like to use?"
+	svcRunLocalEnvNameHelp   = "The service runs with the manifest overrides, variables and secrets of the environment."
+
+	localImageTag = "local"
+
+	envOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
+)
+
+type runLocalSvcVars struct {
+	appName string
+	name    string
+	envName string
+}
+
+type runLocalSvcOpts struct {
+	runLocalSvcVars
+
+	store     store
+	ws        wsSvcDirReader
+	sel       wsSelector
+	docker    localContainerRunner
+	unmarshal func([]byte) (interface{}, error)
+
+	envDescriber envOutputsDescriber
+	secrets      secretGetter
+	initClients  func(*runLocalSvcOpts) error
+}
+
+// localSvcConfig holds the configuration from the service's manifest that is needed to run it locally.
+type localSvcConfig struct {
+	build     *manifest.DockerBuildArgs
+	port      *uint16
+	variables map[string]string
+	secrets   map[string]string
+	sidecars  map[string]*manifest.SidecarConfig
+}
+
+func newSvcRunLocalOpts(vars runLocalSvcVars) (*runLocalSvcOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to environment datastore: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	return &runLocalSvcOpts{
+		runLocalSvcVars: vars,
+
+		store:     configStore,
+		ws:        ws,
+		sel:       selector.NewWorkspaceSelect(prompt.New(), configStore, ws),
+		docker:    docker.New(),
+		unmarshal: manifest.UnmarshalWorkload,
+		initClients: func(o *runLocalSvcOpts) error {
+			env, err := o.store.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+			}
+			d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.name,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create describer for service %s: %w", o.name, err)
+			}
+			o.envDescriber = d
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("assume environment manager role: %w", err)
+			}
+			o.secrets = ssm.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *runLocalSvcOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *runLocalSvcOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcRunLocalNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(svcRunLocalEnvNamePrompt, svcRunLocalEnvNameHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	return nil
+}
+
+// Execute builds the image of the service and runs its containers locally with the configuration of the environment.
+// The command blocks until the main container exits, and then stops the sidecars.
+func (o *runLocalSvcOpts) Execute() error {
+	conf, err := o.localConfig()
+	if err != nil {
+		return err
+	}
+	if err := o.initClients(o); err != nil {
+		return err
+	}
+	envVars, err := o.envVars(conf)
+	if err != nil {
+		return err
+	}
+	secrets, err := o.secretValues(conf)
+	if err != nil {
+		return err
+	}
+
+	imageURI := fmt.Sprintf("%s/%s", o.appName, o.name)
+	if err := o.docker.Build(&docker.BuildArguments{
+		URI:        imageURI,
+		ImageTag:   localImageTag,
+		Dockerfile: aws.StringValue(conf.build.Dockerfile),
+		Context:    aws.StringValue(conf.build.Context),
+		Args:       conf.build.Args,
+		Builder:    aws.StringValue(conf.build.Builder),
+		Env:        conf.build.Env,
+	}); err != nil {
+		return fmt.Errorf("build image for service %s: %w", o.name, err)
+	}
+
+	network := fmt.Sprintf("%s-%s-%s", o.appName, o.envName, o.name)
+	if err := o.docker.CreateNetwork(network); err != nil {
+		return err
+	}
+	defer o.cleanUp(o.docker.RemoveNetwork, network)
+
+	for _, name := range sortedSidecarNames(conf.sidecars) {
+		container := fmt.Sprintf("%s-%s", network, name)
+		if err := o.docker.RunContainer(&docker.RunOptions{
+			ImageURI:      aws.StringValue(conf.sidecars[name].Image),
+			ContainerName: container,
+			Network:       network,
+			NetworkAlias:  name,
+			Detach:        true,
+		}); err != nil {
+			return err
+		}
+		defer o.cleanUp(o.docker.StopContainer, container)
+	}
+
+	ports := make(map[string]string)
+	if conf.port != nil {
+		port := strconv.Itoa(int(aws.Uint16Value(conf.port)))
+		ports[port] = port
+	}
+
+	// Handle interrupts instead of exiting right away, so that the sidecars and network are cleaned up.
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupted)
+
+	log.Infof("Running service %s locally with the configuration of environment %s.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	exited := make(chan error, 1)
+	go func() {
+		exited <- o.docker.RunContainer(&docker.RunOptions{
+			ImageURI:      fmt.Sprintf("%s:%s", imageURI, localImageTag),
+			ContainerName: network,
+			Network:       network,
+			NetworkAlias:  o.name,
+			Ports:         ports,
+			EnvVars:       envVars,
+			Secrets:       secrets,
+		})
+	}()
+	select {
+	case err := <-exited:
+		select {
+		case <-interrupted:
+			// The container exited because it received the interrupt as well.
+			return nil
+		default:
+			return err
+		}
+	case <-interrupted:
+		log.Infof("Stopping service %s.\n", color.HighlightUserInput(o.name))
+		o.cleanUp(o.docker.StopContainer, network)
+		<-exited
+		return nil
+	}
+}
+
+// localConfig returns the configuration of the service in the manifest with the environment overrides applied.
+func (o *runLocalSvcOpts) localConfig() (*localSvcConfig, error) {
+	raw, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read service %s manifest from workspace: %w", o.name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return nil, fmt.Errorf("get copilot directory: %w", err)
+	}
+	wsRoot := filepath.Dir(copilotDir)
+
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		svc, err := t.ApplyEnv(o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+		}
+		return &localSvcConfig{
+			build:     svc.BuildArgs(wsRoot),
+			port:      svc.Image.Port,
+			variables: svc.Variables,
+			secrets:   svc.Secrets,
+			sidecars:  svc.Sidecars,
+		}, nil
+	case *manifest.BackendService:
+		svc, err := t.ApplyEnv(o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+		}
+		return &localSvcConfig{
+			build:     svc.BuildArgs(wsRoot),
+			port:      svc.Image.Port,
+			variables: svc.Variables,
+			secrets:   svc.Secrets,
+			sidecars:  svc.Sidecars,
+		}, nil
+	case *manifest.WorkerService:
+		svc, err := t.ApplyEnv(o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+		}
+		return &localSvcConfig{
+			build:     svc.BuildArgs(wsRoot),
+			variables: svc.Variables,
+			secrets:   svc.Secrets,
+			sidecars:  svc.Sidecars,
+		}, nil
+	default:
+		return nil, fmt.Errorf("service %s of type %T cannot run locally", o.name, mft)
+	}
+}
+
+// envVars returns the environment variables that the service's task definition sets.
+func (o *runLocalSvcOpts) envVars(conf *localSvcConfig) (map[string]string, error) {
+	vars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           o.appName,
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": fmt.Sprintf("%s.local", o.appName),
+		"COPILOT_ENVIRONMENT_NAME":           o.envName,
+		"COPILOT_SERVICE_NAME":               o.name,
+	}
+	outputs, err := o.envDescriber.EnvOutputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of environment %s: %w", o.envName, err)
+	}
+	if dns, ok := outputs[envOutputPublicLoadBalancerDNSName]; ok {
+		vars["COPILOT_LB_DNS"] = dns
+	}
+	for name, value := range conf.variables {
+		vars[name] = value
+	}
+	return vars, nil
+}
+
+// secretValues returns the values of the secrets that the service's task definition sets.
+func (o *runLocalSvcOpts) secretValues(conf *localSvcConfig) (map[string]string, error) {
+	secrets := make(map[string]string)
+	for name, param := range conf.secrets {
+		value, err := o.secrets.GetSecret(param)
+		if err != nil {
+			return nil, fmt.Errorf("get secret %s: %w", name, err)
+		}
+		secrets[name] = value
+	}
+	return secrets, nil
+}
+
+func (o *runLocalSvcOpts) cleanUp(fn func(string) error, name string) {
+	if err := fn(name); err != nil {
+		log.Warningf("Failed to clean up %s: %v\n", name, err)
+	}
+}
+
+func sortedSidecarNames(sidecars map[string]*manifest.SidecarConfig) []string {
+	var names []string
+	for name := range sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// buildSvcRunLocalCmd builds the command for running a service locally.
+func buildSvcRunLocalCmd() *cobra.Command {
+	vars := runLocalSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "run-local",
+		Short: "Run a service locally with the configuration of an environment.",
+		Long: `Run a service locally with the configuration of an environment.
+The service's image is built and run with its sidecars on a local Docker network,
+with the variables and secrets of the environment.`,
+
+		Example: `
+  Run the service "api" locally with the configuration of the "test" environment.
+  /code $ copilot svc run-local -n api -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
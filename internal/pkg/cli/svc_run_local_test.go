@@ -0,0 +1,242 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRunLocalOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inSvcName  string
+		setupMocks func(m *mocks.MockwsSvcDirReader)
+
+		wantedErr error
+	}{
+		"errors if not in a workspace": {
+			setupMocks: func(m *mocks.MockwsSvcDirReader) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"errors if the service is not in the workspace": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			setupMocks: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New("service api not found in the workspace"),
+		},
+		"success": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			setupMocks: func(m *mocks.MockwsSvcDirReader) {
+				m.EXPECT().ServiceNames().Return([]string{"frontend", "api"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			tc.setupMocks(mockWs)
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: runLocalSvcVars{
+					appName: tc.inAppName,
+					name:    tc.inSvcName,
+				},
+				ws: mockWs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type runLocalSvcMocks struct {
+	ws           *mocks.MockwsSvcDirReader
+	docker       *mocks.MocklocalContainerRunner
+	envDescriber *mocks.MockenvOutputsDescriber
+	secrets      *mocks.MocksecretGetter
+}
+
+func TestSvcRunLocalOpts_Execute(t *testing.T) {
+	const mockManifest = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+variables:
+  LOG_LEVEL: info
+secrets:
+  GITHUB_TOKEN: GH_TOKEN_SECRET
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx
+environments:
+  test:
+    variables:
+      LOG_LEVEL: debug
+`
+	mockError := errors.New("some error")
+	mockEnvVars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           "phonetool",
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "phonetool.local",
+		"COPILOT_ENVIRONMENT_NAME":           "test",
+		"COPILOT_SERVICE_NAME":               "api",
+		"COPILOT_LB_DNS":                     "phonetool-test.us-west-2.elb.amazonaws.com",
+		"LOG_LEVEL":                          "debug",
+	}
+	mockReadConfig := func(m runLocalSvcMocks) {
+		m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(mockManifest), nil)
+		m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+	}
+	mockResolveEnvVars := func(m runLocalSvcMocks) {
+		m.envDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+			"PublicLoadBalancerDNSName": "phonetool-test.us-west-2.elb.amazonaws.com",
+		}, nil)
+		m.secrets.EXPECT().GetSecret("GH_TOKEN_SECRET").Return("token", nil)
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m runLocalSvcMocks)
+
+		wantedErr error
+	}{
+		"errors if the service type cannot run locally": {
+			setupMocks: func(m runLocalSvcMocks) {
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(`name: api
+type: Scheduled Job
+`), nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+			},
+			wantedErr: errors.New("service api of type *manifest.ScheduledJob cannot run locally"),
+		},
+		"errors if fail to resolve a secret": {
+			setupMocks: func(m runLocalSvcMocks) {
+				mockReadConfig(m)
+				m.envDescriber.EXPECT().EnvOutputs().Return(nil, nil)
+				m.secrets.EXPECT().GetSecret("GH_TOKEN_SECRET").Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("get secret GITHUB_TOKEN: some error"),
+		},
+		"errors if fail to build the image": {
+			setupMocks: func(m runLocalSvcMocks) {
+				mockReadConfig(m)
+				mockResolveEnvVars(m)
+				m.docker.EXPECT().Build(gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("build image for service api: some error"),
+		},
+		"cleans up the sidecars and network if the main container fails": {
+			setupMocks: func(m runLocalSvcMocks) {
+				mockReadConfig(m)
+				mockResolveEnvVars(m)
+				gomock.InOrder(
+					m.docker.EXPECT().Build(&docker.BuildArguments{
+						URI:        "phonetool/api",
+						ImageTag:   "local",
+						Dockerfile: "/ws/api/Dockerfile",
+						Context:    "/ws/api",
+					}).Return(nil),
+					m.docker.EXPECT().CreateNetwork("phonetool-test-api").Return(nil),
+					m.docker.EXPECT().RunContainer(&docker.RunOptions{
+						ImageURI:      "public.ecr.aws/nginx/nginx",
+						ContainerName: "phonetool-test-api-nginx",
+						Network:       "phonetool-test-api",
+						NetworkAlias:  "nginx",
+						Detach:        true,
+					}).Return(nil),
+					m.docker.EXPECT().RunContainer(&docker.RunOptions{
+						ImageURI:      "phonetool/api:local",
+						ContainerName: "phonetool-test-api",
+						Network:       "phonetool-test-api",
+						NetworkAlias:  "api",
+						Ports: map[string]string{
+							"8080": "8080",
+						},
+						EnvVars: mockEnvVars,
+						Secrets: map[string]string{
+							"GITHUB_TOKEN": "token",
+						},
+					}).Return(mockError),
+					m.docker.EXPECT().StopContainer("phonetool-test-api-nginx").Return(nil),
+					m.docker.EXPECT().RemoveNetwork("phonetool-test-api").Return(nil),
+				)
+			},
+			wantedErr: mockError,
+		},
+		"runs the service locally": {
+			setupMocks: func(m runLocalSvcMocks) {
+				mockReadConfig(m)
+				mockResolveEnvVars(m)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				m.docker.EXPECT().CreateNetwork(gomock.Any()).Return(nil)
+				m.docker.EXPECT().RunContainer(gomock.Any()).Return(nil).Times(2)
+				m.docker.EXPECT().StopContainer(gomock.Any()).Return(nil)
+				m.docker.EXPECT().RemoveNetwork(gomock.Any()).Return(errors.New("network has active endpoints"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := runLocalSvcMocks{
+				ws:           mocks.NewMockwsSvcDirReader(ctrl),
+				docker:       mocks.NewMocklocalContainerRunner(ctrl),
+				envDescriber: mocks.NewMockenvOutputsDescriber(ctrl),
+				secrets:      mocks.NewMocksecretGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &runLocalSvcOpts{
+				runLocalSvcVars: runLocalSvcVars{
+					appName: "phonetool",
+					name:    "api",
+					envName: "test",
+				},
+				ws:        m.ws,
+				docker:    m.docker,
+				unmarshal: manifest.UnmarshalWorkload,
+				initClients: func(o *runLocalSvcOpts) error {
+					o.envDescriber = m.envDescriber
+					o.secrets = m.secrets
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
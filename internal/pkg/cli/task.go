@@ -4,11 +4,169 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/spf13/cobra"
 )
 
+const (
+	appEnvOptionDefaultCluster = "None (default cluster)"
+
+	taskGroupNamePrompt     = "Which task group would you like to %s?"
+	taskGroupNameHelpPrompt = "A task group is a set of tasks that share the same resources, created by `copilot task run`."
+)
+
+var (
+	taskGroupAppPromptHelp = fmt.Sprintf(`Task groups that run in an environment of the application.
+Select %s for task groups that run in your default cluster instead.`, color.Emphasize(appEnvOptionDefaultCluster))
+	taskGroupEnvPromptHelp = fmt.Sprintf(`Task groups that run in the environment.
+Select %s for task groups that run in your default cluster instead.`, color.Emphasize(appEnvOptionDefaultCluster))
+)
+
+// taskGroupVars holds the flags that select where task groups run: in an environment, or in the default cluster.
+type taskGroupVars struct {
+	appName    string
+	env        string
+	useDefault bool
+}
+
+func (v taskGroupVars) validate(store store) error {
+	if v.useDefault {
+		if v.appName != "" {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", taskDefaultFlag, appFlag)
+		}
+		if v.env != "" {
+			return fmt.Errorf("cannot specify both `--%s` and `--%s`", taskDefaultFlag, envFlag)
+		}
+		return nil
+	}
+	if v.appName != "" {
+		if _, err := store.GetApplication(v.appName); err != nil {
+			return fmt.Errorf("get application: %w", err)
+		}
+	}
+	if v.env != "" {
+		if v.appName == "" {
+			return errNoAppInWorkspace
+		}
+		if _, err := store.GetEnvironment(v.appName, v.env); err != nil {
+			return fmt.Errorf("get environment %s config: %w", v.env, err)
+		}
+	}
+	return nil
+}
+
+// ask prompts for the application and the environment of the task groups, unless they run in the default cluster.
+func (v *taskGroupVars) ask(sel appEnvSelector, action string) error {
+	if v.useDefault {
+		return nil
+	}
+	if v.appName == "" {
+		app, err := sel.Application(fmt.Sprintf("Which application's task groups would you like to %s?", action),
+			taskGroupAppPromptHelp, appEnvOptionDefaultCluster)
+		if err != nil {
+			return fmt.Errorf("ask for application: %w", err)
+		}
+		if app == appEnvOptionDefaultCluster {
+			v.useDefault = true
+			return nil
+		}
+		v.appName = app
+	}
+	if v.env == "" {
+		env, err := sel.Environment(fmt.Sprintf("Which environment's task groups would you like to %s?", action),
+			taskGroupEnvPromptHelp, v.appName, appEnvOptionDefaultCluster)
+		if err != nil {
+			return fmt.Errorf("ask for environment: %w", err)
+		}
+		if env == appEnvOptionDefaultCluster {
+			v.appName = ""
+			v.useDefault = true
+			return nil
+		}
+		v.env = env
+	}
+	return nil
+}
+
+// session returns a session with the environment manager role if the task groups run in an environment,
+// and the default session otherwise.
+func (v taskGroupVars) session(store store) (*session.Session, error) {
+	provider := sessions.NewProvider()
+	if v.env == "" {
+		sess, err := provider.Default()
+		if err != nil {
+			return nil, fmt.Errorf("get default session: %w", err)
+		}
+		return sess, nil
+	}
+	env, err := store.GetEnvironment(v.appName, v.env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s config: %w", v.env, err)
+	}
+	sess, err := provider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return sess, nil
+}
+
+// listTaskStacks returns the task groups that run in the environment, or in the default cluster.
+func (v taskGroupVars) listTaskStacks(lister taskStackLister) ([]deploy.TaskStackInfo, error) {
+	if v.env == "" {
+		return lister.ListDefaultTaskStacks()
+	}
+	return lister.ListTaskStacks(v.appName, v.env)
+}
+
+func (v taskGroupVars) newTaskGroup(sess *session.Session, name string) taskGroupManager {
+	ecsClient := ecs.New(sess)
+	return &task.Group{
+		Name:                 name,
+		App:                  v.appName,
+		Env:                  v.env,
+		ClusterGetter:        resourcegroups.New(sess),
+		DefaultClusterGetter: ecsClient,
+		Manager:              ecsClient,
+	}
+}
+
+// askTaskGroupName prompts for one of the task groups.
+func askTaskGroupName(prompt prompter, lister taskStackLister, vars taskGroupVars, action string) (string, error) {
+	stacks, err := vars.listTaskStacks(lister)
+	if err != nil {
+		return "", fmt.Errorf("list task groups: %w", err)
+	}
+	if len(stacks) == 0 {
+		if vars.env == "" {
+			return "", errors.New("no task groups found in the default cluster")
+		}
+		return "", fmt.Errorf("no task groups found in environment %s", vars.env)
+	}
+	var names []string
+	for _, s := range stacks {
+		names = append(names, s.Name)
+	}
+	if len(names) == 1 {
+		return names[0], nil
+	}
+	name, err := prompt.SelectOne(fmt.Sprintf(taskGroupNamePrompt, action), taskGroupNameHelpPrompt, names)
+	if err != nil {
+		return "", fmt.Errorf("select task group: %w", err)
+	}
+	return name, nil
+}
+
 // BuildTaskCmd is the top level command for task.
 func BuildTaskCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,6 +176,9 @@ One-off Amazon ECS tasks that terminate once their work is done.`,
 	}
 
 	cmd.AddCommand(BuildTaskRunCmd())
+	cmd.AddCommand(buildTaskListCmd())
+	cmd.AddCommand(buildTaskStopCmd())
+	cmd.AddCommand(buildTaskDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtTaskLogGroupName = "/copilot/%s"
+
+	fmtTaskDeleteConfirmPrompt = "Are you sure you want to delete task group %s?"
+	taskDeleteConfirmHelp      = "This will stop its running tasks, and delete its stack, images and logs."
+)
+
+var (
+	errTaskDeleteCancelled = errors.New("task delete cancelled - no changes made")
+)
+
+type deleteTaskVars struct {
+	taskGroupVars
+	name             string
+	skipConfirmation bool
+}
+
+type deleteTaskOpts struct {
+	deleteTaskVars
+
+	// Interfaces to dependencies.
+	store   store
+	sel     appEnvSelector
+	prompt  prompter
+	spinner progress
+
+	// Fields below are configured at runtime.
+	lister          taskStackLister
+	deleter         taskStackDeleter
+	imageRemover    imageRemover
+	logGroupDeleter logGroupDeleter
+	taskGroup       func(name string) taskGroupManager
+
+	configureClients func() error
+}
+
+func newDeleteTaskOpts(vars deleteTaskVars) (*deleteTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &deleteTaskOpts{
+		deleteTaskVars: vars,
+
+		store:   store,
+		sel:     selector.NewSelect(prompter, store),
+		prompt:  prompter,
+		spinner: termprogress.NewSpinner(),
+	}
+	opts.configureClients = func() error {
+		if opts.env != "" {
+			// The statement that allows the environment manager role to delete the resources of task groups
+			// was added to the environment template after task groups could run in environments.
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         opts.appName,
+				Env:         opts.env,
+				ConfigStore: opts.store,
+			})
+			if err != nil {
+				return fmt.Errorf("new env describer for environment %s in app %s: %w", opts.env, opts.appName, err)
+			}
+			if err := validateEnvVersion(d, opts.appName, opts.env); err != nil {
+				return err
+			}
+		}
+		sess, err := opts.session(opts.store)
+		if err != nil {
+			return err
+		}
+		cfn := cloudformation.New(sess)
+		opts.lister = cfn
+		opts.deleter = cfn
+		opts.imageRemover = ecr.New(sess)
+		opts.logGroupDeleter = cloudwatchlogs.New(sess)
+		opts.taskGroup = func(name string) taskGroupManager {
+			return opts.newTaskGroup(sess, name)
+		}
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *deleteTaskOpts) Validate() error {
+	return o.validate(o.store)
+}
+
+// Ask prompts for the environment and the name of the task group if they're not provided,
+// and confirms the deletion.
+func (o *deleteTaskOpts) Ask() error {
+	if err := o.ask(o.sel, "delete"); err != nil {
+		return err
+	}
+	if err := o.configureClients(); err != nil {
+		return err
+	}
+	if o.name == "" {
+		name, err := askTaskGroupName(o.prompt, o.lister, o.taskGroupVars, "delete")
+		if err != nil {
+			return err
+		}
+		o.name = name
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtTaskDeleteConfirmPrompt, o.name), taskDeleteConfirmHelp)
+	if err != nil {
+		return fmt.Errorf("task delete confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errTaskDeleteCancelled
+	}
+	return nil
+}
+
+// Execute stops the running tasks of the task group, and deletes its stack, ECR repository and log group.
+func (o *deleteTaskOpts) Execute() error {
+	o.spinner.Start(fmt.Sprintf("Stopping the tasks of %s.", o.name))
+	if _, err := o.taskGroup(o.name).Stop(taskStopReason); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to stop the tasks of %s.\n", o.name))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf("Stopped the tasks of %s.\n", o.name))
+
+	// The ECR repository must be empty before the stack can delete it.
+	repoName := fmt.Sprintf(fmtRepoName, o.name)
+	if err := o.imageRemover.ClearRepository(repoName); err != nil {
+		return fmt.Errorf("empty ECR repository %s: %w", repoName, err)
+	}
+
+	o.spinner.Start(fmt.Sprintf("Deleting the resources of %s.", o.name))
+	if err := o.deleter.DeleteTask(o.name); err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to delete the resources of %s.\n", o.name))
+		return fmt.Errorf("delete stack of task group %s: %w", o.name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf("Deleted the resources of %s.\n", o.name))
+
+	// The stack removes the log group, unless the tasks wrote to it while it was being deleted.
+	if err := o.logGroupDeleter.DeleteLogGroup(fmt.Sprintf(fmtTaskLogGroupName, o.name)); err != nil {
+		return err
+	}
+	log.Successf("Deleted task group %s.\n", o.name)
+	return nil
+}
+
+// buildTaskDeleteCmd builds the command for deleting a task group.
+func buildTaskDeleteCmd() *cobra.Command {
+	vars := deleteTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a task group.",
+		Long: `Deletes a task group.
+Stops its running tasks, and deletes its CloudFormation stack, ECR repository and log group.`,
+		Example: `
+  Delete the "db-migrate" task group from the "test" environment.
+  /code $ copilot task delete -n db-migrate --app my-app --env test
+  Delete the "db-migrate" task group from your default cluster without confirmation prompt.
+  /code $ copilot task delete -n db-migrate --default --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeleteTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, taskGroupNameFlag, nameFlagShort, "", taskGroupNameFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskGroupDefaultFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
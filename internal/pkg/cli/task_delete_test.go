@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(prompt *mocks.Mockprompter)
+
+		wantedError error
+	}{
+		"skips the confirmation": {
+			skipConfirmation: true,
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if the deletion is cancelled": {
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().Confirm("Are you sure you want to delete task group db-migrate?", taskDeleteConfirmHelp).Return(false, nil)
+			},
+			wantedError: errTaskDeleteCancelled,
+		},
+		"errors if failed to confirm": {
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("task delete confirmation prompt: some error"),
+		},
+		"confirms the deletion": {
+			setupMocks: func(prompt *mocks.Mockprompter) {
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockPrompt)
+
+			opts := &deleteTaskOpts{
+				deleteTaskVars: deleteTaskVars{
+					taskGroupVars: taskGroupVars{
+						useDefault: true,
+					},
+					name:             "db-migrate",
+					skipConfirmation: tc.skipConfirmation,
+				},
+				prompt:           mockPrompt,
+				configureClients: func() error { return nil },
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type deleteTaskMocks struct {
+	group           *mocks.MocktaskGroupManager
+	deleter         *mocks.MocktaskStackDeleter
+	imageRemover    *mocks.MockimageRemover
+	logGroupDeleter *mocks.MocklogGroupDeleter
+	spinner         *mocks.Mockprogress
+}
+
+func TestDeleteTaskOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m deleteTaskMocks)
+
+		wantedError error
+	}{
+		"errors if failed to stop the tasks": {
+			setupMocks: func(m deleteTaskMocks) {
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.group.EXPECT().Stop(taskStopReason).Return(nil, errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("some error"),
+		},
+		"errors if failed to empty the repository": {
+			setupMocks: func(m deleteTaskMocks) {
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.group.EXPECT().Stop(taskStopReason).Return(nil, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.imageRemover.EXPECT().ClearRepository("copilot-db-migrate").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("empty ECR repository copilot-db-migrate: some error"),
+		},
+		"errors if failed to delete the stack": {
+			setupMocks: func(m deleteTaskMocks) {
+				m.spinner.EXPECT().Start(gomock.Any()).Times(2)
+				m.group.EXPECT().Stop(taskStopReason).Return(nil, nil)
+				m.imageRemover.EXPECT().ClearRepository("copilot-db-migrate").Return(nil)
+				m.deleter.EXPECT().DeleteTask("db-migrate").Return(errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+			wantedError: errors.New("delete stack of task group db-migrate: some error"),
+		},
+		"deletes the stack, the images and the log group": {
+			setupMocks: func(m deleteTaskMocks) {
+				gomock.InOrder(
+					m.group.EXPECT().Stop(taskStopReason).Return(nil, nil),
+					m.imageRemover.EXPECT().ClearRepository("copilot-db-migrate").Return(nil),
+					m.deleter.EXPECT().DeleteTask("db-migrate").Return(nil),
+					m.logGroupDeleter.EXPECT().DeleteLogGroup("/copilot/db-migrate").Return(nil),
+				)
+				m.spinner.EXPECT().Start(gomock.Any()).Times(2)
+				m.spinner.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := deleteTaskMocks{
+				group:           mocks.NewMocktaskGroupManager(ctrl),
+				deleter:         mocks.NewMocktaskStackDeleter(ctrl),
+				imageRemover:    mocks.NewMockimageRemover(ctrl),
+				logGroupDeleter: mocks.NewMocklogGroupDeleter(ctrl),
+				spinner:         mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+
+			opts := &deleteTaskOpts{
+				deleteTaskVars: deleteTaskVars{
+					name: "db-migrate",
+				},
+				spinner:         m.spinner,
+				deleter:         m.deleter,
+				imageRemover:    m.imageRemover,
+				logGroupDeleter: m.logGroupDeleter,
+				taskGroup: func(name string) taskGroupManager {
+					return m.group
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+type listTaskVars struct {
+	taskGroupVars
+	shouldOutputJSON bool
+}
+
+type listTaskOpts struct {
+	listTaskVars
+
+	// Interfaces to dependencies.
+	store store
+	sel   appEnvSelector
+	w     io.Writer
+
+	// Fields below are configured at runtime.
+	lister    taskStackLister
+	taskGroup func(name string) taskGroupManager
+
+	configureClients func() error
+}
+
+func newListTaskOpts(vars listTaskVars) (*listTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	opts := &listTaskOpts{
+		listTaskVars: vars,
+
+		store: store,
+		sel:   selector.NewSelect(prompt.New(), store),
+		w:     os.Stdout,
+	}
+	opts.configureClients = func() error {
+		sess, err := opts.session(opts.store)
+		if err != nil {
+			return err
+		}
+		opts.lister = cloudformation.New(sess)
+		opts.taskGroup = func(name string) taskGroupManager {
+			return opts.newTaskGroup(sess, name)
+		}
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *listTaskOpts) Validate() error {
+	return o.validate(o.store)
+}
+
+// Ask prompts for the application and the environment of the task groups if they're not provided.
+func (o *listTaskOpts) Ask() error {
+	return o.ask(o.sel, "list")
+}
+
+// Execute lists the task groups with their running and stopped tasks.
+func (o *listTaskOpts) Execute() error {
+	if err := o.configureClients(); err != nil {
+		return err
+	}
+	stacks, err := o.listTaskStacks(o.lister)
+	if err != nil {
+		return fmt.Errorf("list task groups: %w", err)
+	}
+	groups := make([]*taskGroupStatus, 0, len(stacks))
+	for _, s := range stacks {
+		g := o.taskGroup(s.Name)
+		running, err := g.RunningTasks()
+		if err != nil {
+			return err
+		}
+		stopped, err := g.StoppedTasks()
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &taskGroupStatus{
+			Name:    s.Name,
+			Running: taskARNs(running),
+			Stopped: taskARNs(stopped),
+		})
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(groups)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(groups)
+	return nil
+}
+
+// taskGroupStatus holds the ARNs of the running and recently stopped tasks of a task group.
+type taskGroupStatus struct {
+	Name    string   `json:"name"`
+	Running []string `json:"running"`
+	Stopped []string `json:"stopped"`
+}
+
+func (o *listTaskOpts) humanOutput(groups []*taskGroupStatus) {
+	writer := tabwriter.NewWriter(o.w, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "Name", "Running", "Stopped")
+	fmt.Fprintf(writer, "%s\t%s\t%s\n", "----", "-------", "-------")
+	for _, g := range groups {
+		fmt.Fprintf(writer, "%s\t%d\t%d\n", g.Name, len(g.Running), len(g.Stopped))
+	}
+	writer.Flush()
+}
+
+func (o *listTaskOpts) jsonOutput(groups []*taskGroupStatus) (string, error) {
+	type out struct {
+		TaskGroups []*taskGroupStatus `json:"taskGroups"`
+	}
+	b, err := json.Marshal(out{TaskGroups: groups})
+	if err != nil {
+		return "", fmt.Errorf("marshal task groups: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+func taskARNs(tasks []*task.Task) []string {
+	arns := make([]string, len(tasks))
+	for i, t := range tasks {
+		arns[i] = t.TaskARN
+	}
+	return arns
+}
+
+// buildTaskListCmd builds the command for listing task groups.
+func buildTaskListCmd() *cobra.Command {
+	vars := listTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the task groups with their running and stopped tasks.",
+		Long: `Lists the task groups with their running and stopped tasks.
+Amazon ECS only keeps stopped tasks for a short time after they stop.`,
+		Example: `
+  List the task groups in the "test" environment.
+  /code $ copilot task ls --app my-app --env test
+  List the task groups in your default cluster.
+  /code $ copilot task ls --default`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskGroupDefaultFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
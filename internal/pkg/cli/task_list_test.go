@@ -0,0 +1,248 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTaskOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp     string
+		inEnv     string
+		inDefault bool
+		mockStore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"errors if both default and app are specified": {
+			inApp:       "my-app",
+			inDefault:   true,
+			mockStore:   func(m *mocks.Mockstore) {},
+			wantedError: errors.New("cannot specify both `--default` and `--app`"),
+		},
+		"errors if both default and env are specified": {
+			inEnv:       "test",
+			inDefault:   true,
+			mockStore:   func(m *mocks.Mockstore) {},
+			wantedError: errors.New("cannot specify both `--default` and `--env`"),
+		},
+		"errors if env is specified without app": {
+			inEnv:       "test",
+			mockStore:   func(m *mocks.Mockstore) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"errors if the environment does not exist": {
+			inApp: "my-app",
+			inEnv: "test",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test config: some error"),
+		},
+		"valid app and env": {
+			inApp: "my-app",
+			inEnv: "test",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					taskGroupVars: taskGroupVars{
+						appName:    tc.inApp,
+						env:        tc.inEnv,
+						useDefault: tc.inDefault,
+					},
+				},
+				store: mockStore,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestListTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp        string
+		inEnv        string
+		mockSelector func(m *mocks.MockappEnvSelector)
+
+		wantedApp     string
+		wantedEnv     string
+		wantedDefault bool
+		wantedError   error
+	}{
+		"prompts for the application and the environment": {
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any(), appEnvOptionDefaultCluster).Return("my-app", nil)
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "my-app", appEnvOptionDefaultCluster).Return("test", nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "test",
+		},
+		"does not prompt for the environment if the default cluster is selected": {
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any(), appEnvOptionDefaultCluster).Return(appEnvOptionDefaultCluster, nil)
+			},
+			wantedDefault: true,
+		},
+		"resets the application if the default cluster is selected for the environment": {
+			inApp: "my-app",
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "my-app", appEnvOptionDefaultCluster).Return(appEnvOptionDefaultCluster, nil)
+			},
+			wantedDefault: true,
+		},
+		"errors if failed to select the environment": {
+			inApp: "my-app",
+			mockSelector: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "my-app", appEnvOptionDefaultCluster).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("ask for environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSelector := mocks.NewMockappEnvSelector(ctrl)
+			tc.mockSelector(mockSelector)
+
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					taskGroupVars: taskGroupVars{
+						appName: tc.inApp,
+						env:     tc.inEnv,
+					},
+				},
+				sel: mockSelector,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedEnv, opts.env)
+				require.Equal(t, tc.wantedDefault, opts.useDefault)
+			}
+		})
+	}
+}
+
+func TestListTaskOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv      string
+		inJSON     bool
+		setupMocks func(lister *mocks.MocktaskStackLister, group *mocks.MocktaskGroupManager)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"errors if failed to list the task stacks": {
+			inEnv: "test",
+			setupMocks: func(lister *mocks.MocktaskStackLister, group *mocks.MocktaskGroupManager) {
+				lister.EXPECT().ListTaskStacks("my-app", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list task groups: some error"),
+		},
+		"errors if failed to list the running tasks": {
+			setupMocks: func(lister *mocks.MocktaskStackLister, group *mocks.MocktaskGroupManager) {
+				lister.EXPECT().ListDefaultTaskStacks().Return([]deploy.TaskStackInfo{{Name: "db-migrate"}}, nil)
+				group.EXPECT().RunningTasks().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"writes a table of the task groups": {
+			inEnv: "test",
+			setupMocks: func(lister *mocks.MocktaskStackLister, group *mocks.MocktaskGroupManager) {
+				lister.EXPECT().ListTaskStacks("my-app", "test").Return([]deploy.TaskStackInfo{{Name: "db-migrate"}}, nil)
+				group.EXPECT().RunningTasks().Return([]*task.Task{{TaskARN: "task-1"}}, nil)
+				group.EXPECT().StoppedTasks().Return([]*task.Task{{TaskARN: "task-2"}, {TaskARN: "task-3"}}, nil)
+			},
+			wantedContent: "Name                Running             Stopped\n----                -------             -------\ndb-migrate          1                   2\n",
+		},
+		"writes the task groups in JSON": {
+			inEnv:  "test",
+			inJSON: true,
+			setupMocks: func(lister *mocks.MocktaskStackLister, group *mocks.MocktaskGroupManager) {
+				lister.EXPECT().ListTaskStacks("my-app", "test").Return([]deploy.TaskStackInfo{{Name: "db-migrate"}}, nil)
+				group.EXPECT().RunningTasks().Return(nil, nil)
+				group.EXPECT().StoppedTasks().Return([]*task.Task{{TaskARN: "task-2"}}, nil)
+			},
+			wantedContent: `{"taskGroups":[{"name":"db-migrate","running":[],"stopped":["task-2"]}]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLister := mocks.NewMocktaskStackLister(ctrl)
+			mockGroup := mocks.NewMocktaskGroupManager(ctrl)
+			tc.setupMocks(mockLister, mockGroup)
+			b := &bytes.Buffer{}
+
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					taskGroupVars: taskGroupVars{
+						appName: "my-app",
+						env:     tc.inEnv,
+					},
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:      b,
+				lister: mockLister,
+				taskGroup: func(name string) taskGroupManager {
+					require.Equal(t, "db-migrate", name)
+					return mockGroup
+				},
+				configureClients: func() error { return nil },
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -4,10 +4,15 @@
 package cli
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/ecslogging"
@@ -21,6 +26,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
 	"github.com/aws/copilot-cli/internal/pkg/task"
@@ -29,6 +35,7 @@ import (
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 
 	"github.com/dustin/go-humanize/english"
@@ -82,12 +89,20 @@ type runTaskVars struct {
 	env               string
 	appName           string
 	useDefaultSubnets bool
+	spot              bool // True if the tasks run on Fargate Spot.
 
 	envVars      map[string]string
+	envFile      string            // Path to a file with an environment variable on each line.
+	secrets      map[string]string // SSM parameters or Secrets Manager secrets by environment variable.
 	command      string
+	entrypoint   string
 	resourceTags map[string]string
 
-	follow bool
+	fromSvc string // Name of a deployed service whose image, roles, variables, secrets and network are reused.
+
+	follow  bool
+	wait    bool
+	timeout time.Duration // Zero to wait until the tasks stop.
 }
 
 type runTaskOpts struct {
@@ -105,14 +120,17 @@ type runTaskOpts struct {
 	repository           repositoryService
 	runner               taskRunner
 	eventsWriter         eventsWriter
+	watcher              taskWatcher
+	svcDescriber         serviceTaskConfigDescriber
 	defaultClusterGetter defaultClusterGetter
 
 	sess              *session.Session
 	targetEnvironment *config.Environment
 
 	// Configurer methods.
-	configureRuntimeOpts func() error
-	configureRepository  func() error
+	configureRuntimeOpts  func() error
+	configureRepository   func() error
+	configureSvcDescriber func() error
 	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow is specified)
 	configureEventsWriter func(tasks []*task.Task)
 }
@@ -136,6 +154,9 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		opts.runner = opts.configureRunner()
 		opts.deployer = cloudformation.New(opts.sess)
 		opts.defaultClusterGetter = ecs.New(opts.sess)
+		opts.watcher = &task.Watcher{
+			Describer: ecs.New(opts.sess),
+		}
 		return nil
 	}
 
@@ -150,6 +171,20 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		return nil
 	}
 
+	opts.configureSvcDescriber = func() error {
+		d, err := describe.NewServiceDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Env:         opts.env,
+			Svc:         opts.fromSvc,
+			ConfigStore: opts.store,
+		})
+		if err != nil {
+			return fmt.Errorf("create describer for service %s: %w", opts.fromSvc, err)
+		}
+		opts.svcDescriber = d
+		return nil
+	}
+
 	opts.configureEventsWriter = func(tasks []*task.Task) {
 		opts.eventsWriter = ecslogging.NewTaskClient(opts.sess, opts.groupName, tasks)
 	}
@@ -168,6 +203,10 @@ func (o *runTaskOpts) configureRunner() taskRunner {
 			App: o.appName,
 			Env: o.env,
 
+			Subnets:        o.subnets,
+			SecurityGroups: o.securityGroups,
+			Spot:           o.spot,
+
 			VPCGetter:     vpcGetter,
 			ClusterGetter: resourcegroups.New(o.sess),
 			Starter:       ecsService,
@@ -180,6 +219,7 @@ func (o *runTaskOpts) configureRunner() taskRunner {
 
 		Subnets:        o.subnets,
 		SecurityGroups: o.securityGroups,
+		Spot:           o.spot,
 
 		VPCGetter:     vpcGetter,
 		ClusterGetter: ecsService,
@@ -247,6 +287,28 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.envFile != "" {
+		if _, err := o.fs.Stat(o.envFile); err != nil {
+			return err
+		}
+	}
+
+	if _, err := parseCommandArgs(o.command); err != nil {
+		return fmt.Errorf("parse --%s: %w", commandFlag, err)
+	}
+
+	if _, err := parseCommandArgs(o.entrypoint); err != nil {
+		return fmt.Errorf("parse --%s: %w", entrypointFlag, err)
+	}
+
+	if err := o.validateWaitFlags(); err != nil {
+		return err
+	}
+
+	if err := o.validateFlagsWithFromSvc(); err != nil {
+		return err
+	}
+
 	if err := o.validateFlagsWithDefaultCluster(); err != nil {
 		return err
 	}
@@ -271,6 +333,53 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.fromSvc != "" && o.appName != "" {
+		if err := o.validateSvcName(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *runTaskOpts) validateWaitFlags() error {
+	if o.follow && o.wait {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", followFlag, waitFlag)
+	}
+	if o.timeout < 0 {
+		return fmt.Errorf("`--%s` must be positive", timeoutFlag)
+	}
+	if o.timeout != 0 && !o.follow && !o.wait {
+		return fmt.Errorf("`--%s` must be specified with `--%s` or `--%s`", timeoutFlag, followFlag, waitFlag)
+	}
+	return nil
+}
+
+func (o *runTaskOpts) validateFlagsWithFromSvc() error {
+	if o.fromSvc == "" {
+		return nil
+	}
+	if o.image != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, imageFlag)
+	}
+	if o.isDockerfileSet {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, dockerFileFlag)
+	}
+	if o.taskRole != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, taskRoleFlag)
+	}
+	if o.executionRole != "" {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, executionRoleFlag)
+	}
+	if o.subnets != nil {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, subnetsFlag)
+	}
+	if o.securityGroups != nil {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, securityGroupsFlag)
+	}
+	if o.useDefaultSubnets {
+		return fmt.Errorf("cannot specify both `--%s` and `--%s`", fromSvcFlag, taskDefaultFlag)
+	}
 	return nil
 }
 
@@ -364,11 +473,22 @@ func (o *runTaskOpts) Execute() error {
 		o.groupName = filepath.Base(dir)
 	}
 
+	if err := o.readEnvFile(); err != nil {
+		return err
+	}
+
 	// NOTE: all runtime options must be configured only after session is configured
 	if err := o.configureSessAndEnv(); err != nil {
 		return err
 	}
 
+	// NOTE: the configuration of the service must be applied before the runner is configured with its network.
+	if o.fromSvc != "" {
+		if err := o.applySvcTaskConfig(); err != nil {
+			return err
+		}
+	}
+
 	if err := o.configureRuntimeOpts(); err != nil {
 		return err
 	}
@@ -413,11 +533,96 @@ func (o *runTaskOpts) Execute() error {
 		return err
 	}
 
+	if !o.follow && !o.wait {
+		return nil
+	}
+	if err := o.waitUntilStopped(tasks); err != nil {
+		return err
+	}
+	return o.checkContainerExits(tasks)
+}
+
+// waitUntilStopped tails the logs of the tasks with --follow, or waits silently with --wait, until they all stop.
+func (o *runTaskOpts) waitUntilStopped(tasks []*task.Task) error {
 	if o.follow {
 		o.configureEventsWriter(tasks)
-		if err := o.displayLogStream(); err != nil {
-			return err
+		return o.withTimeout(o.displayLogStream)
+	}
+	o.spinner.Start(fmt.Sprintf("Waiting for %s to stop.", english.Plural(o.count, "task", "")))
+	err := o.withTimeout(func() error {
+		return o.watcher.WaitUntilStopped(tasks)
+	})
+	if err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to wait for %s to stop.\n", english.Plural(o.count, "task", "")))
+		return fmt.Errorf("wait for tasks to stop: %w", err)
+	}
+	o.spinner.Stop(log.Ssuccessf("%s %s stopped.\n",
+		english.PluralWord(o.count, "Task", ""),
+		english.PluralWord(o.count, "has", "have")))
+	return nil
+}
+
+// withTimeout returns the result of fn, or an error if fn doesn't return before --timeout.
+func (o *runTaskOpts) withTimeout(fn func() error) error {
+	if o.timeout == 0 {
+		return fn()
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(o.timeout):
+		return fmt.Errorf("%s did not stop within %s", english.PluralWord(o.count, "task", "tasks"), o.timeout)
+	}
+}
+
+// checkContainerExits prints how the containers of the stopped tasks exited, and returns an error
+// if an essential container did not run or exited with a non-zero code.
+func (o *runTaskOpts) checkContainerExits(tasks []*task.Task) error {
+	exits, err := o.watcher.ContainerExits(tasks)
+	if err != nil {
+		return fmt.Errorf("get exit codes of tasks: %w", err)
+	}
+	var failed *errContainerExit
+	prevTaskARN := ""
+	for _, exit := range exits {
+		taskID, err := ecs.TaskID(exit.TaskARN)
+		if err != nil {
+			taskID = exit.TaskARN
+		}
+		if exit.TaskARN != prevTaskARN && exit.TaskStoppedReason != "" {
+			log.Infof("Task %s stopped: %s\n", taskID, exit.TaskStoppedReason)
+		}
+		prevTaskARN = exit.TaskARN
+
+		logFailure := log.Errorf
+		if !exit.Essential {
+			logFailure = log.Warningf
 		}
+		switch {
+		case exit.ExitCode == nil:
+			logFailure("Container %s did not run.\n", exit.Name)
+		case exit.Failed():
+			logFailure("Container %s exited with code %d.\n", exit.Name, aws.Int64Value(exit.ExitCode))
+		default:
+			log.Successf("Container %s exited with code 0.\n", exit.Name)
+		}
+		if exit.Reason != "" {
+			log.Infof("  Reason: %s\n", exit.Reason)
+		}
+		if exit.Essential && exit.Failed() && failed == nil {
+			failed = &errContainerExit{
+				container: exit.Name,
+				taskID:    taskID,
+				exitCode:  exit.ExitCode,
+			}
+		}
+	}
+	if failed != nil {
+		return failed
 	}
 	return nil
 }
@@ -433,6 +638,27 @@ func (o *runTaskOpts) displayLogStream() error {
 	return nil
 }
 
+type errContainerExit struct {
+	container string
+	taskID    string
+	exitCode  *int64 // Nil if the container did not run.
+}
+
+func (e *errContainerExit) Error() string {
+	if e.exitCode == nil {
+		return fmt.Sprintf("essential container %s of task %s did not run", e.container, e.taskID)
+	}
+	return fmt.Sprintf("essential container %s of task %s exited with code %d", e.container, e.taskID, *e.exitCode)
+}
+
+// ExitCode returns the exit code of the container so that the command exits with it, or 1 if it's not a valid exit status.
+func (e *errContainerExit) ExitCode() int {
+	if e.exitCode == nil || *e.exitCode < 1 || *e.exitCode > 255 {
+		return 1
+	}
+	return int(*e.exitCode)
+}
+
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
 	tasks, err := o.runner.Run()
@@ -486,6 +712,14 @@ func (o *runTaskOpts) deploy() error {
 	if o.env != "" {
 		deployOpts = []awscloudformation.StackOption{awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)}
 	}
+	command, err := parseCommandArgs(o.command)
+	if err != nil {
+		return fmt.Errorf("parse --%s: %w", commandFlag, err)
+	}
+	entrypoint, err := parseCommandArgs(o.entrypoint)
+	if err != nil {
+		return fmt.Errorf("parse --%s: %w", entrypointFlag, err)
+	}
 	input := &deploy.CreateTaskResourcesInput{
 		Name:           o.groupName,
 		CPU:            o.cpu,
@@ -493,8 +727,10 @@ func (o *runTaskOpts) deploy() error {
 		Image:          o.image,
 		TaskRole:       o.taskRole,
 		ExecutionRole:  o.executionRole,
-		Command:        o.command,
+		Command:        command,
+		EntryPoint:     entrypoint,
 		EnvVars:        o.envVars,
+		Secrets:        o.secrets,
 		App:            o.appName,
 		Env:            o.env,
 		AdditionalTags: o.resourceTags,
@@ -502,6 +738,107 @@ func (o *runTaskOpts) deploy() error {
 	return o.deployer.DeployTask(input, deployOpts...)
 }
 
+// applySvcTaskConfig reuses the image, roles, environment variables, secrets, entrypoint, subnets and security groups
+// of the tasks of the service. Variables, secrets and the entrypoint passed with flags take precedence.
+func (o *runTaskOpts) applySvcTaskConfig() error {
+	if err := o.configureSvcDescriber(); err != nil {
+		return err
+	}
+	cfg, err := o.svcDescriber.TaskConfig()
+	if err != nil {
+		return fmt.Errorf("get task configuration of service %s: %w", o.fromSvc, err)
+	}
+	o.image = cfg.Image
+	o.taskRole = cfg.TaskRole
+	o.executionRole = cfg.ExecutionRole
+	o.subnets = cfg.Subnets
+	o.securityGroups = cfg.SecurityGroups
+	o.envVars = mergeStringMaps(cfg.EnvVars, o.envVars)
+	o.secrets = mergeStringMaps(cfg.Secrets, o.secrets)
+	if o.entrypoint == "" && len(cfg.EntryPoint) != 0 {
+		entrypoint, err := json.Marshal(cfg.EntryPoint)
+		if err != nil {
+			return fmt.Errorf("marshal entrypoint of service %s: %w", o.fromSvc, err)
+		}
+		o.entrypoint = string(entrypoint)
+	}
+	return nil
+}
+
+// mergeStringMaps returns a new map with the entries of base, overridden by the entries of overrides.
+func mergeStringMaps(base, overrides map[string]string) map[string]string {
+	if len(base) == 0 && len(overrides) == 0 {
+		return nil
+	}
+	merged := make(map[string]string)
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
+
+// readEnvFile adds the environment variables of the env file to the ones passed with --env-vars.
+func (o *runTaskOpts) readEnvFile() error {
+	if o.envFile == "" {
+		return nil
+	}
+	content, err := afero.ReadFile(o.fs, o.envFile)
+	if err != nil {
+		return fmt.Errorf("read env file %s: %w", o.envFile, err)
+	}
+	vars, err := parseEnvFile(content)
+	if err != nil {
+		return fmt.Errorf("parse env file %s: %w", o.envFile, err)
+	}
+	for name, value := range o.envVars {
+		vars[name] = value
+	}
+	o.envVars = vars
+	return nil
+}
+
+// parseEnvFile returns the environment variables of a file with a KEY=VALUE pair on each line, like "docker run --env-file".
+// Empty lines and lines starting with "#" are ignored, and values are kept as is, including quotes.
+func parseEnvFile(content []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		kv := strings.SplitN(text, "=", 2)
+		name := strings.TrimSpace(kv[0])
+		if len(kv) != 2 || name == "" {
+			return nil, fmt.Errorf("line %d is not a KEY=VALUE pair", line)
+		}
+		vars[name] = kv[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// parseCommandArgs splits a command into its arguments.
+// The command is either a JSON array of strings, so that arguments can contain spaces, or arguments separated by spaces.
+func parseCommandArgs(command string) ([]string, error) {
+	if strings.TrimSpace(command) == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(strings.TrimSpace(command), "[") {
+		return strings.Fields(command), nil
+	}
+	var args []string
+	if err := json.Unmarshal([]byte(command), &args); err != nil {
+		return nil, fmt.Errorf("unmarshal %s to a JSON array of strings: %w", command, err)
+	}
+	return args, nil
+}
+
 func (o *runTaskOpts) validateAppName() error {
 	if _, err := o.store.GetApplication(o.appName); err != nil {
 		return fmt.Errorf("get application: %w", err)
@@ -521,13 +858,25 @@ func (o *runTaskOpts) validateEnvName() error {
 	return nil
 }
 
+func (o *runTaskOpts) validateSvcName() error {
+	if _, err := o.store.GetService(o.appName, o.fromSvc); err != nil {
+		return fmt.Errorf("get service %s: %w", o.fromSvc, err)
+	}
+	return nil
+}
+
 func (o *runTaskOpts) askAppName() error {
 	if o.appName != "" {
 		return nil
 	}
 
+	// Tasks that reuse the configuration of a service must run in the service's application.
+	var additionalOpts []string
+	if o.fromSvc == "" {
+		additionalOpts = append(additionalOpts, appEnvOptionNone)
+	}
 	// If the application is empty then the user wants to run in the default VPC. Do not prompt for an environment name.
-	app, err := o.sel.Application(taskRunAppPrompt, taskRunAppPromptHelp, appEnvOptionNone)
+	app, err := o.sel.Application(taskRunAppPrompt, taskRunAppPromptHelp, additionalOpts...)
 	if err != nil {
 		return fmt.Errorf("ask for application: %w", err)
 	}
@@ -537,6 +886,9 @@ func (o *runTaskOpts) askAppName() error {
 	}
 
 	o.appName = app
+	if o.fromSvc != "" {
+		return o.validateSvcName()
+	}
 	return nil
 }
 
@@ -550,7 +902,11 @@ func (o *runTaskOpts) askEnvName() error {
 		return nil
 	}
 
-	env, err := o.sel.Environment(taskRunEnvPrompt, taskRunEnvPromptHelp, o.appName, appEnvOptionNone)
+	var additionalOpts []string
+	if o.fromSvc == "" {
+		additionalOpts = append(additionalOpts, appEnvOptionNone)
+	}
+	env, err := o.sel.Environment(taskRunEnvPrompt, taskRunEnvPromptHelp, o.appName, additionalOpts...)
 	if err != nil {
 		return fmt.Errorf("ask for environment: %w", err)
 	}
@@ -587,10 +943,20 @@ Run 4 tasks with 2GB memory, an existing image, and a custom task role.
 /code $ copilot task run --num 4 --memory 2048 --image=rds-migrate --task-role migrate-role
 Run a task with environment variables.
 /code $ copilot task run --env-vars name=myName,user=myUser
+Run a task with the environment variables of a file, and a secret from SSM Parameter Store.
+/code $ copilot task run --env-file ./migrate.env --secrets DB_PASSWORD=/myapp/test/db-password
 Run a task using the current workspace with specific subnets and security groups.
 /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
+Run 10 tasks on Fargate Spot in the "test" environment.
+/code $ copilot task run -n batch --env test --count 10 --spot
 Run a task with a command.
-/code $ copilot task run --command "python migrate-script.py"`,
+/code $ copilot task run --command "python migrate-script.py"
+Run a task with an entrypoint and a command whose arguments contain spaces.
+/code $ copilot task run --entrypoint /bin/sh --command '["-c", "python migrate-script.py --all"]'
+Run a database migration with the image, roles, variables, secrets and network of the deployed "api" service.
+/code $ copilot task run --app my-app --env prod --from-svc api --command "rake db:migrate"
+Run a task in a CI pipeline: wait up to 30 minutes for it to stop, and exit with the exit code of its container.
+/code $ copilot task run -n db-migrate --env test --wait --timeout 30m`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -634,11 +1000,18 @@ Run a task with a command.
 	cmd.Flags().StringSliceVar(&vars.subnets, subnetsFlag, nil, subnetsFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.securityGroups, securityGroupsFlag, nil, securityGroupsFlagDescription)
 	cmd.Flags().BoolVar(&vars.useDefaultSubnets, taskDefaultFlag, false, taskDefaultFlagDescription)
+	cmd.Flags().BoolVar(&vars.spot, spotFlag, false, spotFlagDescription)
 
 	cmd.Flags().StringToStringVar(&vars.envVars, envVarsFlag, nil, envVarsFlagDescription)
+	cmd.Flags().StringVar(&vars.envFile, envFileFlag, "", envFileFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.secrets, secretsFlag, nil, secretsFlagDescription)
 	cmd.Flags().StringVar(&vars.command, commandFlag, "", commandFlagDescription)
+	cmd.Flags().StringVar(&vars.entrypoint, entrypointFlag, "", entrypointFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.fromSvc, fromSvcFlag, "", fromSvcFlagDescription)
 
-	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, taskFollowFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, waitFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, taskTimeoutFlagDescription)
 	return cmd
 }
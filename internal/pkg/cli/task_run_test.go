@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/docker"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -57,11 +60,19 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 		inSubnets        []string
 		inSecurityGroups []string
 
-		inEnvVars map[string]string
-		inCommand string
+		inEnvVars    map[string]string
+		inEnvFile    string
+		inCommand    string
+		inEntryPoint string
 
 		inDefault bool
 
+		inFollow  bool
+		inWait    bool
+		inTimeout time.Duration
+
+		inFromSvc string
+
 		appName         string
 		isDockerfileSet bool
 
@@ -127,6 +138,31 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 			},
 			wantedError: nil,
 		},
+		"valid with a JSON command and an env file": {
+			basicOpts: defaultOpts,
+
+			inEnvFile:    "migrate.env",
+			inCommand:    `["-c", "python migrate.py --all"]`,
+			inEntryPoint: "/bin/sh",
+
+			mockFileSystem: func(mockFS afero.Fs) {
+				afero.WriteFile(mockFS, "migrate.env", []byte("DB_NAME=orders"), 0644)
+			},
+		},
+		"invalid env file path": {
+			basicOpts: defaultOpts,
+
+			inEnvFile: "migrate.env",
+
+			wantedError: errors.New("open migrate.env: file does not exist"),
+		},
+		"invalid JSON command": {
+			basicOpts: defaultOpts,
+
+			inCommand: `["python", "migrate.py"`,
+
+			wantedError: errors.New("parse --command: unmarshal [\"python\", \"migrate.py\" to a JSON array of strings: unexpected end of JSON input"),
+		},
 		"invalid number of tasks": {
 			basicOpts: basicOpts{
 				inCount:  -1,
@@ -274,6 +310,88 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify both `--subnets` and `--default`"),
 		},
+		"valid with wait and timeout": {
+			basicOpts: defaultOpts,
+
+			inWait:    true,
+			inTimeout: 30 * time.Minute,
+		},
+		"both follow and wait specified": {
+			basicOpts: defaultOpts,
+
+			inFollow: true,
+			inWait:   true,
+
+			wantedError: errors.New("cannot specify both `--follow` and `--wait`"),
+		},
+		"negative timeout": {
+			basicOpts: defaultOpts,
+
+			inFollow:  true,
+			inTimeout: -time.Minute,
+
+			wantedError: errors.New("`--timeout` must be positive"),
+		},
+		"both from-svc and image specified": {
+			basicOpts: defaultOpts,
+
+			inFromSvc: "api",
+			inImage:   "image",
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--image`"),
+		},
+		"both from-svc and task role specified": {
+			basicOpts: defaultOpts,
+
+			inFromSvc:  "api",
+			inTaskRole: "role",
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--task-role`"),
+		},
+		"both from-svc and subnets specified": {
+			basicOpts: defaultOpts,
+
+			inFromSvc: "api",
+			inSubnets: []string{"subnet-1"},
+
+			wantedError: errors.New("cannot specify both `--from-svc` and `--subnets`"),
+		},
+		"unknown service to run the task from": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "test",
+			inFromSvc: "api",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service api: some error"),
+		},
+		"valid with from-svc and command": {
+			basicOpts: defaultOpts,
+
+			appName:   "my-app",
+			inEnv:     "test",
+			inFromSvc: "api",
+			inCommand: "rake db:migrate",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.EXPECT().GetService("my-app", "api").Return(&config.Workload{Name: "api"}, nil)
+			},
+		},
+		"timeout without follow or wait": {
+			basicOpts: defaultOpts,
+
+			inTimeout: time.Minute,
+
+			wantedError: errors.New("`--timeout` must be specified with `--follow` or `--wait`"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -297,8 +415,14 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					securityGroups:    tc.inSecurityGroups,
 					dockerfilePath:    tc.inDockerfilePath,
 					envVars:           tc.inEnvVars,
+					envFile:           tc.inEnvFile,
 					command:           tc.inCommand,
+					entrypoint:        tc.inEntryPoint,
 					useDefaultSubnets: tc.inDefault,
+					follow:            tc.inFollow,
+					wait:              tc.inWait,
+					timeout:           tc.inTimeout,
+					fromSvc:           tc.inFromSvc,
 				},
 				isDockerfileSet: tc.isDockerfileSet,
 
@@ -334,9 +458,11 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 		inDefault bool
 		inEnv     string
 		appName   string
+		inFromSvc string
 
 		mockSel    func(m *mocks.MockappEnvSelector)
 		mockPrompt func(m *mocks.Mockprompter)
+		mockStore  func(m *mocks.Mockstore)
 
 		wantedError error
 		wantedApp   string
@@ -503,6 +629,33 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 
 			wantedError: errors.New("ask for environment: error selecting environment"),
 		},
+		"do not offer the default VPC when running from a service": {
+			inFromSvc: "api",
+
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskRunAppPrompt, gomock.Any()).Return("my-app", nil)
+				m.EXPECT().Environment(taskRunEnvPrompt, gomock.Any(), "my-app").Return("test", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("my-app", "api").Return(&config.Workload{Name: "api"}, nil)
+			},
+
+			wantedApp: "my-app",
+			wantedEnv: "test",
+		},
+		"error if the service is not in the selected application": {
+			inFromSvc: "api",
+
+			mockSel: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(taskRunAppPrompt, gomock.Any()).Return("my-app", nil)
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetService("my-app", "api").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("get service api: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -512,6 +665,7 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 
 			mockSel := mocks.NewMockappEnvSelector(ctrl)
 			mockPrompter := mocks.NewMockprompter(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
 
 			if tc.mockSel != nil {
 				tc.mockSel(mockSel)
@@ -521,6 +675,10 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 				tc.mockPrompt(mockPrompter)
 			}
 
+			if tc.mockStore != nil {
+				tc.mockStore(mockStore)
+			}
+
 			opts := runTaskOpts{
 				runTaskVars: runTaskVars{
 					appName:           tc.appName,
@@ -529,8 +687,10 @@ func TestTaskRunOpts_Ask(t *testing.T) {
 					useDefaultSubnets: tc.inDefault,
 					subnets:           tc.inSubnets,
 					securityGroups:    tc.inSecurityGroups,
+					fromSvc:           tc.inFromSvc,
 				},
-				sel: mockSel,
+				sel:   mockSel,
+				store: mockStore,
 			}
 
 			err := opts.Ask()
@@ -555,6 +715,8 @@ type runTaskMocks struct {
 	runner               *mocks.MocktaskRunner
 	store                *mocks.Mockstore
 	eventsWriter         *mocks.MockeventsWriter
+	watcher              *mocks.MocktaskWatcher
+	svcDescriber         *mocks.MockserviceTaskConfigDescriber
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
 }
 
@@ -568,7 +730,16 @@ func mockRepositoryAnytime(m runTaskMocks) {
 }
 
 func TestTaskRunOpts_Execute(t *testing.T) {
-	const inGroupName = "my-task"
+	const (
+		inGroupName = "my-task"
+		mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/my-cluster/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	mockTasks := []*task.Task{
+		{
+			TaskARN:    mockTaskARN,
+			ClusterARN: "my-cluster",
+		},
+	}
 	mockRepoURI := "uri/repo"
 
 	tag := "tag"
@@ -579,15 +750,26 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		inImage  string
-		inTag    string
-		inFollow bool
+		inImage   string
+		inTag     string
+		inFollow  bool
+		inWait    bool
+		inTimeout time.Duration
+		inFromSvc string
 
 		inEnv string
 
+		inEnvVars    map[string]string
+		inEnvFile    string
+		inSecrets    map[string]string
+		inCommand    string
+		inEntryPoint string
+
 		setupMocks func(m runTaskMocks)
 
-		wantedError error
+		wantedError          error
+		wantedSubnets        []string
+		wantedSecurityGroups []string
 	}{
 		"check if default cluster exists if deploying to default cluster": {
 			setupMocks: func(m runTaskMocks) {
@@ -706,6 +888,42 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				mockHasDefaultCluster(m)
 			},
 		},
+		"pass environment variables, secrets, entrypoint and command to the task resources": {
+			inImage: "image",
+			inEnvVars: map[string]string{
+				"LOG_LEVEL": "debug",
+			},
+			inEnvFile: "migrate.env",
+			inSecrets: map[string]string{
+				"DB_PASSWORD": "/myapp/test/db-password",
+			},
+			inCommand:    `["-c", "python migrate.py --all"]`,
+			inEntryPoint: "/bin/sh",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:       inGroupName,
+					Image:      "image",
+					Command:    []string{"-c", "python migrate.py --all"},
+					EntryPoint: []string{"/bin/sh"},
+					EnvVars: map[string]string{
+						"DB_NAME":   "orders",
+						"LOG_LEVEL": "debug",
+					},
+					Secrets: map[string]string{
+						"DB_PASSWORD": "/myapp/test/db-password",
+					},
+				}).Return(nil)
+				m.runner.EXPECT().Run().AnyTimes()
+				mockHasDefaultCluster(m)
+			},
+		},
+		"error parsing the env file": {
+			inEnvFile: "invalid.env",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("parse env file invalid.env: line 2 is not a KEY=VALUE pair"),
+		},
 		"fail to write events": {
 			inFollow: true,
 			inImage:  "image",
@@ -728,6 +946,168 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"reuse the task configuration of the service": {
+			inEnv:     "test",
+			inFromSvc: "api",
+			inEnvVars: map[string]string{
+				"LOG_LEVEL": "debug",
+			},
+			inCommand: "rake db:migrate",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), "test").Return(&config.Environment{
+					ExecutionRoleARN: "env execution role",
+				}, nil)
+				m.svcDescriber.EXPECT().TaskConfig().Return(&describe.ServiceTaskConfig{
+					Image:         "uri/api:v1",
+					TaskRole:      "api-task-role",
+					ExecutionRole: "api-execution-role",
+					EntryPoint:    []string{"/bin/sh", "-c"},
+					EnvVars: map[string]string{
+						"COPILOT_SERVICE_NAME": "api",
+						"LOG_LEVEL":            "info",
+					},
+					Secrets: map[string]string{
+						"DB_PASSWORD": "/my-app/test/db-password",
+					},
+					Subnets:        []string{"subnet-1"},
+					SecurityGroups: []string{"sg-1"},
+				}, nil)
+				m.deployer.EXPECT().DeployTask(&deploy.CreateTaskResourcesInput{
+					Name:          inGroupName,
+					Image:         "uri/api:v1",
+					TaskRole:      "api-task-role",
+					ExecutionRole: "api-execution-role",
+					Command:       []string{"rake", "db:migrate"},
+					EntryPoint:    []string{"/bin/sh", "-c"},
+					EnvVars: map[string]string{
+						"COPILOT_SERVICE_NAME": "api",
+						"LOG_LEVEL":            "debug",
+					},
+					Secrets: map[string]string{
+						"DB_PASSWORD": "/my-app/test/db-password",
+					},
+					Env: "test",
+				}, gomock.Any()).Return(nil)
+				m.repository.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+				m.runner.EXPECT().Run()
+			},
+			wantedSubnets:        []string{"subnet-1"},
+			wantedSecurityGroups: []string{"sg-1"},
+		},
+		"error getting the task configuration of the service": {
+			inEnv:     "test",
+			inFromSvc: "api",
+			setupMocks: func(m runTaskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), "test").Return(&config.Environment{}, nil)
+				m.svcDescriber.EXPECT().TaskConfig().Return(nil, errors.New("some error"))
+				m.deployer.EXPECT().DeployTask(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("get task configuration of service api: some error"),
+		},
+		"succeed if the essential containers exited with code 0": {
+			inFollow: true,
+			inImage:  "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(mockTasks, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.watcher.EXPECT().ContainerExits(mockTasks).Return([]*task.ContainerExit{
+					{
+						TaskARN:   mockTaskARN,
+						Name:      "my-task",
+						Essential: true,
+						ExitCode:  aws.Int64(0),
+					},
+					{
+						TaskARN:  mockTaskARN,
+						Name:     "sidecar",
+						ExitCode: aws.Int64(1),
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+		},
+		"fail if an essential container exited with a non-zero code": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(mockTasks, nil)
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(0)
+				m.watcher.EXPECT().WaitUntilStopped(mockTasks).Return(nil)
+				m.watcher.EXPECT().ContainerExits(mockTasks).Return([]*task.ContainerExit{
+					{
+						TaskARN:           mockTaskARN,
+						Name:              "my-task",
+						Essential:         true,
+						ExitCode:          aws.Int64(3),
+						TaskStoppedReason: "Essential container in task exited",
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("essential container my-task of task 4082490ee6c245e09d2145010aa1ba8d exited with code 3"),
+		},
+		"fail if an essential container did not run": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(mockTasks, nil)
+				m.watcher.EXPECT().WaitUntilStopped(mockTasks).Return(nil)
+				m.watcher.EXPECT().ContainerExits(mockTasks).Return([]*task.ContainerExit{
+					{
+						TaskARN:           mockTaskARN,
+						Name:              "my-task",
+						Essential:         true,
+						Reason:            "OutOfMemoryError: Container killed due to memory usage",
+						TaskStoppedReason: "Essential container in task exited",
+					},
+				}, nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("essential container my-task of task 4082490ee6c245e09d2145010aa1ba8d did not run"),
+		},
+		"fail to wait for tasks to stop": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(mockTasks, nil)
+				m.watcher.EXPECT().WaitUntilStopped(mockTasks).Return(errors.New("some error"))
+				m.watcher.EXPECT().ContainerExits(gomock.Any()).Times(0)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("wait for tasks to stop: some error"),
+		},
+		"fail if tasks do not stop before the timeout": {
+			inWait:    true,
+			inTimeout: time.Millisecond,
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(mockTasks, nil)
+				m.watcher.EXPECT().WaitUntilStopped(mockTasks).DoAndReturn(func(_ []*task.Task) error {
+					time.Sleep(100 * time.Millisecond)
+					return nil
+				})
+				m.watcher.EXPECT().ContainerExits(gomock.Any()).Times(0)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("wait for tasks to stop: tasks did not stop within 1ms"),
+		},
+		"fail to get the exit codes of the containers": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(mockTasks, nil)
+				m.watcher.EXPECT().WaitUntilStopped(mockTasks).Return(nil)
+				m.watcher.EXPECT().ContainerExits(mockTasks).Return(nil, errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("get exit codes of tasks: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -741,26 +1121,42 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				runner:               mocks.NewMocktaskRunner(ctrl),
 				store:                mocks.NewMockstore(ctrl),
 				eventsWriter:         mocks.NewMockeventsWriter(ctrl),
+				watcher:              mocks.NewMocktaskWatcher(ctrl),
+				svcDescriber:         mocks.NewMockserviceTaskConfigDescriber(ctrl),
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
 			}
 			tc.setupMocks(mocks)
 
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			fs.WriteFile("migrate.env", []byte("# Database to migrate.\nDB_NAME=orders\n\nLOG_LEVEL=info\n"), 0644)
+			fs.WriteFile("invalid.env", []byte("DB_NAME=orders\nLOG_LEVEL\n"), 0644)
+
 			opts := &runTaskOpts{
 				runTaskVars: runTaskVars{
 					groupName: inGroupName,
 
-					image:    tc.inImage,
-					imageTag: tc.inTag,
-					env:      tc.inEnv,
-					follow:   tc.inFollow,
+					image:      tc.inImage,
+					imageTag:   tc.inTag,
+					env:        tc.inEnv,
+					follow:     tc.inFollow,
+					wait:       tc.inWait,
+					timeout:    tc.inTimeout,
+					fromSvc:    tc.inFromSvc,
+					envVars:    tc.inEnvVars,
+					envFile:    tc.inEnvFile,
+					secrets:    tc.inSecrets,
+					command:    tc.inCommand,
+					entrypoint: tc.inEntryPoint,
 				},
 				spinner: &mockSpinner{},
 				store:   mocks.store,
+				fs:      fs,
 			}
 			opts.configureRuntimeOpts = func() error {
 				opts.runner = mocks.runner
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
+				opts.watcher = mocks.watcher
 				return nil
 			}
 			opts.configureRepository = func() error {
@@ -770,12 +1166,18 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			opts.configureEventsWriter = func(tasks []*task.Task) {
 				opts.eventsWriter = mocks.eventsWriter
 			}
+			opts.configureSvcDescriber = func() error {
+				opts.svcDescriber = mocks.svcDescriber
+				return nil
+			}
 
 			err := opts.Execute()
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedSubnets, opts.subnets)
+				require.Equal(t, tc.wantedSecurityGroups, opts.securityGroups)
 			}
 		})
 	}
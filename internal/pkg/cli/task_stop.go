@@ -0,0 +1,143 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	taskStopReason = "Task stopped by copilot task stop"
+)
+
+type stopTaskVars struct {
+	taskGroupVars
+	name string
+}
+
+type stopTaskOpts struct {
+	stopTaskVars
+
+	// Interfaces to dependencies.
+	store   store
+	sel     appEnvSelector
+	prompt  prompter
+	spinner progress
+
+	// Fields below are configured at runtime.
+	lister    taskStackLister
+	taskGroup func(name string) taskGroupManager
+
+	configureClients func() error
+}
+
+func newStopTaskOpts(vars stopTaskVars) (*stopTaskOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+
+	prompter := prompt.New()
+	opts := &stopTaskOpts{
+		stopTaskVars: vars,
+
+		store:   store,
+		sel:     selector.NewSelect(prompter, store),
+		prompt:  prompter,
+		spinner: termprogress.NewSpinner(),
+	}
+	opts.configureClients = func() error {
+		sess, err := opts.session(opts.store)
+		if err != nil {
+			return err
+		}
+		opts.lister = cloudformation.New(sess)
+		opts.taskGroup = func(name string) taskGroupManager {
+			return opts.newTaskGroup(sess, name)
+		}
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *stopTaskOpts) Validate() error {
+	return o.validate(o.store)
+}
+
+// Ask prompts for the environment and the name of the task group if they're not provided.
+func (o *stopTaskOpts) Ask() error {
+	if err := o.ask(o.sel, "stop"); err != nil {
+		return err
+	}
+	if err := o.configureClients(); err != nil {
+		return err
+	}
+	if o.name != "" {
+		return nil
+	}
+	name, err := askTaskGroupName(o.prompt, o.lister, o.taskGroupVars, "stop")
+	if err != nil {
+		return err
+	}
+	o.name = name
+	return nil
+}
+
+// Execute stops the running tasks of the task group.
+func (o *stopTaskOpts) Execute() error {
+	o.spinner.Start(fmt.Sprintf("Stopping the tasks of %s.", o.name))
+	tasks, err := o.taskGroup(o.name).Stop(taskStopReason)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf("Failed to stop the tasks of %s.\n", o.name))
+		return err
+	}
+	if len(tasks) == 0 {
+		o.spinner.Stop(log.Ssuccessf("No running tasks in %s.\n", o.name))
+		return nil
+	}
+	o.spinner.Stop(log.Ssuccessf("Stopped %s of %s.\n", english.Plural(len(tasks), "task", ""), o.name))
+	return nil
+}
+
+// buildTaskStopCmd builds the command for stopping the running tasks of a task group.
+func buildTaskStopCmd() *cobra.Command {
+	vars := stopTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stops the running tasks of a task group.",
+		Example: `
+  Stop the tasks of the "db-migrate" task group in the "test" environment.
+  /code $ copilot task stop -n db-migrate --app my-app --env test
+  Stop the tasks of the "db-migrate" task group in your default cluster.
+  /code $ copilot task stop -n db-migrate --default`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStopTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, taskGroupNameFlag, nameFlagShort, "", taskGroupNameFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, "", appFlagDescription)
+	cmd.Flags().StringVarP(&vars.env, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskGroupDefaultFlagDescription)
+	return cmd
+}
@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		inEnv      string
+		setupMocks func(lister *mocks.MocktaskStackLister, prompt *mocks.Mockprompter)
+
+		wantedName  string
+		wantedError error
+	}{
+		"does not prompt if the name is provided": {
+			inName:     "db-migrate",
+			inEnv:      "test",
+			setupMocks: func(lister *mocks.MocktaskStackLister, prompt *mocks.Mockprompter) {},
+			wantedName: "db-migrate",
+		},
+		"errors if there are no task groups in the environment": {
+			inEnv: "test",
+			setupMocks: func(lister *mocks.MocktaskStackLister, prompt *mocks.Mockprompter) {
+				lister.EXPECT().ListTaskStacks("my-app", "test").Return(nil, nil)
+			},
+			wantedError: errors.New("no task groups found in environment test"),
+		},
+		"uses the only task group without prompting": {
+			inEnv: "test",
+			setupMocks: func(lister *mocks.MocktaskStackLister, prompt *mocks.Mockprompter) {
+				lister.EXPECT().ListTaskStacks("my-app", "test").Return([]deploy.TaskStackInfo{{Name: "db-migrate"}}, nil)
+				prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedName: "db-migrate",
+		},
+		"prompts for one of the task groups": {
+			inEnv: "test",
+			setupMocks: func(lister *mocks.MocktaskStackLister, prompt *mocks.Mockprompter) {
+				lister.EXPECT().ListTaskStacks("my-app", "test").Return([]deploy.TaskStackInfo{{Name: "db-migrate"}, {Name: "cleanup"}}, nil)
+				prompt.EXPECT().SelectOne("Which task group would you like to stop?", gomock.Any(), []string{"db-migrate", "cleanup"}).Return("cleanup", nil)
+			},
+			wantedName: "cleanup",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLister := mocks.NewMocktaskStackLister(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockLister, mockPrompt)
+
+			opts := &stopTaskOpts{
+				stopTaskVars: stopTaskVars{
+					taskGroupVars: taskGroupVars{
+						appName: "my-app",
+						env:     tc.inEnv,
+					},
+					name: tc.inName,
+				},
+				prompt:           mockPrompt,
+				lister:           mockLister,
+				configureClients: func() error { return nil },
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestStopTaskOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(group *mocks.MocktaskGroupManager, spinner *mocks.Mockprogress)
+
+		wantedError error
+	}{
+		"errors if failed to stop the tasks": {
+			setupMocks: func(group *mocks.MocktaskGroupManager, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start("Stopping the tasks of db-migrate.")
+				group.EXPECT().Stop(taskStopReason).Return(nil, errors.New("some error"))
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("some error"),
+		},
+		"stops the running tasks": {
+			setupMocks: func(group *mocks.MocktaskGroupManager, spinner *mocks.Mockprogress) {
+				spinner.EXPECT().Start("Stopping the tasks of db-migrate.")
+				group.EXPECT().Stop(taskStopReason).Return([]*task.Task{{TaskARN: "task-1"}}, nil)
+				spinner.EXPECT().Stop(gomock.Any())
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGroup := mocks.NewMocktaskGroupManager(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(mockGroup, mockSpinner)
+
+			opts := &stopTaskOpts{
+				stopTaskVars: stopTaskVars{
+					name: "db-migrate",
+				},
+				spinner: mockSpinner,
+				taskGroup: func(name string) taskGroupManager {
+					return mockGroup
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/robfig/cron/v3"
 
 	"github.com/spf13/afero"
@@ -447,3 +448,14 @@ func validateCIDRSlice(val interface{}) error {
 	}
 	return nil
 }
+
+// validateCertARNs returns an error if any of the ARNs is not an ACM certificate ARN.
+func validateCertARNs(arns []string) error {
+	for _, certARN := range arns {
+		parsed, err := arn.Parse(certARN)
+		if err != nil || parsed.Service != "acm" || !strings.HasPrefix(parsed.Resource, "certificate/") {
+			return fmt.Errorf("%s is not a valid ACM certificate ARN", certARN)
+		}
+	}
+	return nil
+}
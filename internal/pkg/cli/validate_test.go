@@ -430,3 +430,32 @@ func TestIsCorrectFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCertARNs(t *testing.T) {
+	testCases := map[string]struct {
+		in        []string
+		wantedErr error
+	}{
+		"valid certificate ARNs": {
+			in: []string{"arn:aws:acm:us-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"},
+		},
+		"not an ARN": {
+			in:        []string{"mycert"},
+			wantedErr: errors.New("mycert is not a valid ACM certificate ARN"),
+		},
+		"not an ACM certificate": {
+			in:        []string{"arn:aws:iam::123456789012:server-certificate/mycert"},
+			wantedErr: errors.New("arn:aws:iam::123456789012:server-certificate/mycert is not a valid ACM certificate ARN"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateCertARNs(tc.in)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,568 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package compose converts Docker Compose projects into Copilot workload manifests.
+package compose
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultDockerfileName = "Dockerfile"
+	defaultBackendPort    = 80
+
+	cpuUnitsPerVCPU = 1024
+)
+
+var (
+	errUnmarshalPort      = errors.New("unmarshal port to a string, integer or long syntax mapping")
+	errUnmarshalStringMap = errors.New(`unmarshal to a mapping or a list of "KEY=VALUE" strings`)
+	errUnmarshalCommand   = errors.New("unmarshal command to a string or a list of strings")
+	errUnmarshalDependsOn = errors.New("unmarshal depends_on to a list of services or a mapping")
+)
+
+// Keys with this prefix are Compose extension fields, which are ignored without warnings.
+const extensionFieldPrefix = "x-"
+
+// Project represents the subset of a Docker Compose file that can be converted to Copilot manifests.
+// See https://docs.docker.com/compose/compose-file/
+type Project struct {
+	Version  *string             `yaml:"version"`
+	Services map[string]*Service `yaml:"services"`
+
+	Unsupported map[string]yaml.Node `yaml:",inline"`
+}
+
+// Service represents a Compose service.
+type Service struct {
+	Image       *string                    `yaml:"image"`
+	Build       manifest.BuildArgsOrString `yaml:"build"`
+	Ports       []Port                     `yaml:"ports"`
+	Expose      []Port                     `yaml:"expose"`
+	Environment stringMap                  `yaml:"environment"`
+	HealthCheck *HealthCheck               `yaml:"healthcheck"`
+	Deploy      *Deploy                    `yaml:"deploy"`
+	DependsOn   dependsOn                  `yaml:"depends_on"`
+
+	Unsupported map[string]yaml.Node `yaml:",inline"`
+}
+
+// Port is a port mapping of a Compose service.
+type Port struct {
+	Published *string // Port on the host, nil if the port isn't published.
+	Target    string  // Port in the container.
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Port struct
+// to support both the short "[HOST:]CONTAINER[/PROTOCOL]" syntax and the long syntax.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (p *Port) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var long struct {
+		Target    *string `yaml:"target"`
+		Published *string `yaml:"published"`
+	}
+	if err := unmarshal(&long); err == nil {
+		if long.Target == nil {
+			return errUnmarshalPort
+		}
+		p.Target, p.Published = aws.StringValue(long.Target), long.Published
+		return nil
+	}
+	var short string
+	if err := unmarshal(&short); err != nil {
+		return errUnmarshalPort
+	}
+	short = strings.Split(short, "/")[0]
+	parts := strings.Split(short, ":")
+	p.Target = parts[len(parts)-1]
+	if len(parts) > 1 {
+		p.Published = aws.String(parts[len(parts)-2])
+	}
+	return nil
+}
+
+// HealthCheck represents the healthcheck of a Compose service.
+type HealthCheck struct {
+	Test        command `yaml:"test"`
+	Interval    *string `yaml:"interval"`
+	Timeout     *string `yaml:"timeout"`
+	StartPeriod *string `yaml:"start_period"`
+	Retries     *int    `yaml:"retries"`
+	Disable     *bool   `yaml:"disable"`
+}
+
+// Deploy represents the deployment configuration of a Compose service.
+type Deploy struct {
+	Replicas  *int `yaml:"replicas"`
+	Resources struct {
+		Limits       *Resources `yaml:"limits"`
+		Reservations *Resources `yaml:"reservations"`
+	} `yaml:"resources"`
+
+	Unsupported map[string]yaml.Node `yaml:",inline"`
+}
+
+// Resources represents the CPU and memory boundaries of a Compose service.
+type Resources struct {
+	CPUs   *string `yaml:"cpus"`
+	Memory *string `yaml:"memory"`
+}
+
+// stringMap is a mapping that can be written either as a YAML mapping or as a list of "KEY=VALUE" strings.
+// Keys without a value are mapped to nil.
+type stringMap map[string]*string
+
+// UnmarshalYAML implements the yaml.Unmarshaler (v2) interface.
+func (m *stringMap) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var mapping map[string]*string
+	if err := unmarshal(&mapping); err == nil {
+		*m = mapping
+		return nil
+	}
+	var list []string
+	if err := unmarshal(&list); err != nil {
+		return errUnmarshalStringMap
+	}
+	*m = make(map[string]*string)
+	for _, kv := range list {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 1 {
+			(*m)[parts[0]] = nil
+			continue
+		}
+		(*m)[parts[0]] = aws.String(parts[1])
+	}
+	return nil
+}
+
+// command is a command that can be written either as a string or as a list of strings.
+type command []string
+
+// UnmarshalYAML implements the yaml.Unmarshaler (v2) interface.
+// A command written as a string is run with the container's default shell.
+func (c *command) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*c = list
+		return nil
+	}
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return errUnmarshalCommand
+	}
+	*c = []string{"CMD-SHELL", str}
+	return nil
+}
+
+// dependsOn is a list of services that can be written either as a list or as a mapping with conditions.
+type dependsOn []string
+
+// UnmarshalYAML implements the yaml.Unmarshaler (v2) interface.
+func (d *dependsOn) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var list []string
+	if err := unmarshal(&list); err == nil {
+		*d = list
+		return nil
+	}
+	var mapping map[string]yaml.Node
+	if err := unmarshal(&mapping); err != nil {
+		return errUnmarshalDependsOn
+	}
+	for name := range mapping {
+		*d = append(*d, name)
+	}
+	sort.Strings(*d)
+	return nil
+}
+
+// Workload is a Copilot workload converted from a Compose service.
+type Workload struct {
+	Name     string
+	Type     string
+	Manifest encoding.BinaryMarshaler
+}
+
+// Parse deserializes the Docker Compose file content into a Project.
+func Parse(in []byte) (*Project, error) {
+	var p Project
+	if err := yaml.Unmarshal(in, &p); err != nil {
+		return nil, fmt.Errorf("unmarshal compose file: %w", err)
+	}
+	if len(p.Services) == 0 {
+		return nil, errors.New(`compose file does not define any "services"`)
+	}
+	return &p, nil
+}
+
+// Workloads converts the services of the project into Copilot workloads.
+// Services that publish ports become Load Balanced Web Services and the other services become Backend Services.
+// Services without a "build" section that other services depend on become sidecars of their dependents.
+// dir is the path of the directory of the Compose file relative to the workspace root.
+// Returns warnings for the configuration that can't be converted.
+func (p *Project) Workloads(dir string) ([]*Workload, []string, error) {
+	var warnings []string
+	for _, key := range sortedKeys(p.Unsupported) {
+		warnings = append(warnings, fmt.Sprintf(`top-level "%s" is not supported and was ignored`, key))
+	}
+
+	sidecars := make(map[string]bool)
+	for _, name := range sortedKeys(p.Services) {
+		for _, dep := range p.Services[name].DependsOn {
+			if svc, ok := p.Services[dep]; ok && svc.isSidecar() {
+				sidecars[dep] = true
+			}
+		}
+	}
+
+	var workloads []*Workload
+	var hasLBWebService bool
+	for _, name := range sortedKeys(p.Services) {
+		svc := p.Services[name]
+		if sidecars[name] {
+			continue
+		}
+		if !svc.hasBuild() {
+			warnings = append(warnings, fmt.Sprintf(`service %s has no "build" section and was not imported`, name))
+			continue
+		}
+		conv := &converter{
+			name:     name,
+			dir:      dir,
+			services: p.Services,
+		}
+		conv.warnUnsupported(svc)
+		var mft encoding.BinaryMarshaler
+		var err error
+		if svc.publishedPort() != nil {
+			path := "/"
+			if hasLBWebService {
+				path = name
+			}
+			hasLBWebService = true
+			mft, err = conv.lbWebService(svc, path)
+		} else {
+			mft, err = conv.backendService(svc)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("convert service %s: %w", name, err)
+		}
+		workloads = append(workloads, &Workload{
+			Name:     name,
+			Type:     conv.wkldType,
+			Manifest: mft,
+		})
+		warnings = append(warnings, conv.warnings...)
+	}
+	return workloads, warnings, nil
+}
+
+func (s *Service) hasBuild() bool {
+	return s.Build.BuildString != nil || s.Build.BuildArgs.Context != nil || s.Build.BuildArgs.Dockerfile != nil
+}
+
+// isSidecar returns true if the service only runs an image without publishing any ports.
+func (s *Service) isSidecar() bool {
+	return s.Image != nil && !s.hasBuild() && s.publishedPort() == nil
+}
+
+// publishedPort returns the first port published on the host, or nil if there are none.
+func (s *Service) publishedPort() *Port {
+	for i := range s.Ports {
+		if s.Ports[i].Published != nil {
+			return &s.Ports[i]
+		}
+	}
+	return nil
+}
+
+// targetPort returns the first port of the container that is either in "ports" or in "expose", or an empty string.
+func (s *Service) targetPort() string {
+	for _, ports := range [][]Port{s.Ports, s.Expose} {
+		if len(ports) > 0 {
+			return ports[0].Target
+		}
+	}
+	return ""
+}
+
+type converter struct {
+	name     string
+	dir      string
+	services map[string]*Service
+
+	wkldType string
+	warnings []string
+}
+
+func (c *converter) warnf(format string, args ...interface{}) {
+	c.warnings = append(c.warnings, fmt.Sprintf("service %s: %s", c.name, fmt.Sprintf(format, args...)))
+}
+
+func (c *converter) warnUnsupported(svc *Service) {
+	for _, key := range sortedKeys(svc.Unsupported) {
+		c.warnf(`"%s" is not supported and was ignored`, key)
+	}
+	if svc.Image != nil {
+		c.warnf(`"image" is ignored in favor of "build"`)
+	}
+	if svc.Deploy == nil {
+		return
+	}
+	for _, key := range sortedKeys(svc.Deploy.Unsupported) {
+		c.warnf(`"deploy.%s" is not supported and was ignored`, key)
+	}
+	if svc.Deploy.Resources.Reservations != nil {
+		c.warnf(`"deploy.resources.reservations" is not supported, set "deploy.resources.limits" instead`)
+	}
+}
+
+func (c *converter) lbWebService(svc *Service, path string) (*manifest.LoadBalancedWebService, error) {
+	c.wkldType = manifest.LoadBalancedWebServiceType
+	port, err := parsePort(svc.publishedPort().Target)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Ports) > 1 {
+		c.warnf("only container port %d is exposed through the load balancer", port)
+	}
+	if svc.HealthCheck != nil {
+		c.warnf(`"healthcheck" is not supported for a %s, configure "http.healthcheck" instead`, manifest.LoadBalancedWebServiceType)
+	}
+	build := c.buildArgs(svc)
+	mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+		WorkloadProps: &manifest.WorkloadProps{
+			Name:       c.name,
+			Dockerfile: aws.StringValue(build.Dockerfile),
+		},
+		Path: path,
+		Port: port,
+	})
+	mft.Image.Build.BuildArgs = *build
+	if err := c.applyTaskConfig(svc, &mft.TaskConfig); err != nil {
+		return nil, err
+	}
+	mft.Sidecars = c.sidecars(svc)
+	return mft, nil
+}
+
+func (c *converter) backendService(svc *Service) (*manifest.BackendService, error) {
+	c.wkldType = manifest.BackendServiceType
+	port := uint16(defaultBackendPort)
+	if target := svc.targetPort(); target != "" {
+		p, err := parsePort(target)
+		if err != nil {
+			return nil, err
+		}
+		port = p
+	} else {
+		c.warnf("no container port found, defaulting to port %d", defaultBackendPort)
+	}
+	hc, err := c.healthCheck(svc.HealthCheck)
+	if err != nil {
+		return nil, err
+	}
+	build := c.buildArgs(svc)
+	mft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       c.name,
+			Dockerfile: aws.StringValue(build.Dockerfile),
+		},
+		Port:        port,
+		HealthCheck: hc,
+	})
+	mft.Image.Build.BuildArgs = *build
+	if err := c.applyTaskConfig(svc, &mft.TaskConfig); err != nil {
+		return nil, err
+	}
+	mft.Sidecars = c.sidecars(svc)
+	return mft, nil
+}
+
+// buildArgs returns the build arguments of the service with paths relative to the workspace root.
+func (c *converter) buildArgs(svc *Service) *manifest.DockerBuildArgs {
+	// In a Compose file, a "build" string is the path to the build context.
+	ctx := aws.StringValue(svc.Build.BuildString)
+	if svc.Build.BuildString == nil {
+		ctx = aws.StringValue(svc.Build.BuildArgs.Context)
+	}
+	dockerfile := aws.StringValue(svc.Build.BuildArgs.Dockerfile)
+	if dockerfile == "" {
+		dockerfile = defaultDockerfileName
+	}
+	return &manifest.DockerBuildArgs{
+		Context:    aws.String(filepath.ToSlash(filepath.Join(c.dir, ctx))),
+		Dockerfile: aws.String(filepath.ToSlash(filepath.Join(c.dir, ctx, dockerfile))),
+		Args:       svc.Build.BuildArgs.Args,
+	}
+}
+
+func (c *converter) applyTaskConfig(svc *Service, conf *manifest.TaskConfig) error {
+	for _, name := range sortedKeys(svc.Environment) {
+		value := svc.Environment[name]
+		if value == nil {
+			c.warnf("environment variable %s has no value and was ignored", name)
+			continue
+		}
+		if conf.Variables == nil {
+			conf.Variables = make(map[string]string)
+		}
+		conf.Variables[name] = aws.StringValue(value)
+	}
+	if svc.Deploy == nil {
+		return nil
+	}
+	if svc.Deploy.Replicas != nil {
+		conf.Count.Value = svc.Deploy.Replicas
+	}
+	limits := svc.Deploy.Resources.Limits
+	if limits == nil {
+		return nil
+	}
+	if limits.CPUs != nil {
+		cpu, err := parseCPUs(aws.StringValue(limits.CPUs))
+		if err != nil {
+			return err
+		}
+		conf.CPU = aws.Int(cpu)
+	}
+	if limits.Memory != nil {
+		mem, err := parseMemory(aws.StringValue(limits.Memory))
+		if err != nil {
+			return err
+		}
+		conf.Memory = aws.Int(mem)
+	}
+	return nil
+}
+
+func (c *converter) healthCheck(hc *HealthCheck) (*manifest.ContainerHealthCheck, error) {
+	if hc == nil || aws.BoolValue(hc.Disable) {
+		return nil, nil
+	}
+	if len(hc.Test) > 0 && hc.Test[0] == "NONE" {
+		return nil, nil
+	}
+	out := &manifest.ContainerHealthCheck{
+		Retries: hc.Retries,
+	}
+	if len(hc.Test) > 0 {
+		out.Command = hc.Test
+	}
+	for _, d := range []struct {
+		in  *string
+		out **time.Duration
+	}{
+		{in: hc.Interval, out: &out.Interval},
+		{in: hc.Timeout, out: &out.Timeout},
+		{in: hc.StartPeriod, out: &out.StartPeriod},
+	} {
+		if d.in == nil {
+			continue
+		}
+		v, err := time.ParseDuration(aws.StringValue(d.in))
+		if err != nil {
+			return nil, fmt.Errorf("parse healthcheck duration %s: %w", aws.StringValue(d.in), err)
+		}
+		*d.out = &v
+	}
+	return out, nil
+}
+
+// sidecars returns the services that the service depends on that can run as sidecars.
+func (c *converter) sidecars(svc *Service) map[string]*manifest.SidecarConfig {
+	var sidecars map[string]*manifest.SidecarConfig
+	for _, dep := range svc.DependsOn {
+		depSvc, ok := c.services[dep]
+		if !ok || !depSvc.isSidecar() {
+			c.warnf(`"depends_on" service %s is not a sidecar, reach it with service discovery instead`, dep)
+			continue
+		}
+		if sidecars == nil {
+			sidecars = make(map[string]*manifest.SidecarConfig)
+		}
+		conf := &manifest.SidecarConfig{
+			Image: depSvc.Image,
+		}
+		if port := depSvc.targetPort(); port != "" {
+			conf.Port = aws.String(port)
+		}
+		sidecars[dep] = conf
+	}
+	return sidecars
+}
+
+func parsePort(s string) (uint16, error) {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("parse port %s: %w", s, err)
+	}
+	return uint16(port), nil
+}
+
+// parseCPUs converts a number of vCPUs, such as "0.5", to CPU units.
+func parseCPUs(s string) (int, error) {
+	cpus, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse cpus %s: %w", s, err)
+	}
+	return int(math.Round(cpus * cpuUnitsPerVCPU)), nil
+}
+
+// parseMemory converts a byte value, such as "512M" or "1gb", to MiB.
+func parseMemory(s string) (int, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	value = strings.TrimSuffix(value, "b")
+	multipliers := map[string]float64{
+		"k": 1.0 / 1024,
+		"m": 1,
+		"g": 1024,
+	}
+	multiplier := 1.0 / (1024 * 1024)
+	if len(value) > 0 {
+		if m, ok := multipliers[value[len(value)-1:]]; ok {
+			multiplier = m
+			value = value[:len(value)-1]
+		}
+	}
+	bytes, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse memory %s: %w", s, err)
+	}
+	return int(math.Ceil(bytes * multiplier)), nil
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch v := m.(type) {
+	case map[string]yaml.Node:
+		for k := range v {
+			if strings.HasPrefix(k, extensionFieldPrefix) {
+				continue
+			}
+			keys = append(keys, k)
+		}
+	case map[string]*Service:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	case stringMap:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
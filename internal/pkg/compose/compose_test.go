@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package compose
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedPorts     []Port
+		wantedEnv       stringMap
+		wantedDependsOn dependsOn
+		wantedErr       string
+	}{
+		"parses short and long syntax": {
+			inContent: `
+version: "3.8"
+services:
+  web:
+    build: ./web
+    ports:
+      - 80
+      - "127.0.0.1:8080:8000/tcp"
+      - target: 81
+        published: 8081
+    environment:
+      - LOG_LEVEL=info
+      - DEBUG
+    depends_on:
+      redis:
+        condition: service_started
+      db:
+        condition: service_healthy
+`,
+			wantedPorts: []Port{
+				{Target: "80"},
+				{Target: "8000", Published: aws.String("8080")},
+				{Target: "81", Published: aws.String("8081")},
+			},
+			wantedEnv: stringMap{
+				"LOG_LEVEL": aws.String("info"),
+				"DEBUG":     nil,
+			},
+			wantedDependsOn: dependsOn{"db", "redis"},
+		},
+		"errors if there are no services": {
+			inContent: `version: "3.8"`,
+			wantedErr: `compose file does not define any "services"`,
+		},
+		"errors if a port can't be parsed": {
+			inContent: `
+services:
+  web:
+    ports:
+      - published: 8080
+`,
+			wantedErr: "unmarshal compose file: " + errUnmarshalPort.Error(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			p, err := Parse([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPorts, p.Services["web"].Ports)
+			require.Equal(t, tc.wantedEnv, p.Services["web"].Environment)
+			require.Equal(t, tc.wantedDependsOn, p.Services["web"].DependsOn)
+		})
+	}
+}
+
+func TestProject_Workloads(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inDir     string
+
+		wantedWorkloads func() []*Workload
+		wantedWarnings  []string
+		wantedErr       string
+	}{
+		"converts services with published ports to load balanced web services": {
+			inContent: `
+services:
+  web:
+    build:
+      context: ./web
+      dockerfile: Dockerfile.prod
+      args:
+        GIT_COMMIT: abc123
+    ports:
+      - "8080:80"
+    environment:
+      LOG_LEVEL: info
+    deploy:
+      replicas: 2
+      resources:
+        limits:
+          cpus: "0.5"
+          memory: 1G
+    depends_on:
+      - redis
+  redis:
+    image: redis:6
+    expose:
+      - 6379
+`,
+			inDir: "compose",
+			wantedWorkloads: func() []*Workload {
+				mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "web",
+						Dockerfile: "compose/web/Dockerfile.prod",
+					},
+					Path: "/",
+					Port: 80,
+				})
+				mft.Image.Build.BuildArgs.Context = aws.String("compose/web")
+				mft.Image.Build.BuildArgs.Args = map[string]string{"GIT_COMMIT": "abc123"}
+				mft.Variables = map[string]string{"LOG_LEVEL": "info"}
+				mft.Count.Value = aws.Int(2)
+				mft.CPU = aws.Int(512)
+				mft.Memory = aws.Int(1024)
+				mft.Sidecars = map[string]*manifest.SidecarConfig{
+					"redis": {
+						Image: aws.String("redis:6"),
+						Port:  aws.String("6379"),
+					},
+				}
+				return []*Workload{
+					{
+						Name:     "web",
+						Type:     manifest.LoadBalancedWebServiceType,
+						Manifest: mft,
+					},
+				}
+			},
+		},
+		"converts the other services to backend services": {
+			inContent: `
+volumes:
+  data:
+services:
+  api:
+    build: api
+    expose:
+      - "8080"
+    healthcheck:
+      test: curl -f http://localhost:8080/ || exit 1
+      interval: 30s
+      retries: 5
+    volumes:
+      - data:/data
+    environment:
+      - SHELL_VAR
+  queue:
+    image: rabbitmq:3
+`,
+			wantedWorkloads: func() []*Workload {
+				mft := manifest.NewBackendService(manifest.BackendServiceProps{
+					WorkloadProps: manifest.WorkloadProps{
+						Name:       "api",
+						Dockerfile: "api/Dockerfile",
+					},
+					Port: 8080,
+					HealthCheck: &manifest.ContainerHealthCheck{
+						Command:  []string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"},
+						Interval: durationp(30 * time.Second),
+						Retries:  aws.Int(5),
+					},
+				})
+				mft.Image.Build.BuildArgs.Context = aws.String("api")
+				return []*Workload{
+					{
+						Name:     "api",
+						Type:     manifest.BackendServiceType,
+						Manifest: mft,
+					},
+				}
+			},
+			wantedWarnings: []string{
+				`top-level "volumes" is not supported and was ignored`,
+				`service api: "volumes" is not supported and was ignored`,
+				"service api: environment variable SHELL_VAR has no value and was ignored",
+				`service queue has no "build" section and was not imported`,
+			},
+		},
+		"warns about configuration that can't be converted for a load balanced web service": {
+			inContent: `
+services:
+  web:
+    build: .
+    image: phonetool/web
+    ports:
+      - "80:80"
+      - "443:443"
+    healthcheck:
+      test: ["CMD", "true"]
+    deploy:
+      restart_policy:
+        condition: on-failure
+      resources:
+        reservations:
+          memory: 256M
+    depends_on:
+      - api
+  api:
+    build: api
+`,
+			wantedWorkloads: func() []*Workload {
+				api := manifest.NewBackendService(manifest.BackendServiceProps{
+					WorkloadProps: manifest.WorkloadProps{
+						Name:       "api",
+						Dockerfile: "api/Dockerfile",
+					},
+					Port: 80,
+				})
+				api.Image.Build.BuildArgs.Context = aws.String("api")
+				web := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+					WorkloadProps: &manifest.WorkloadProps{
+						Name:       "web",
+						Dockerfile: "Dockerfile",
+					},
+					Path: "/",
+					Port: 80,
+				})
+				web.Image.Build.BuildArgs.Context = aws.String(".")
+				return []*Workload{
+					{
+						Name:     "api",
+						Type:     manifest.BackendServiceType,
+						Manifest: api,
+					},
+					{
+						Name:     "web",
+						Type:     manifest.LoadBalancedWebServiceType,
+						Manifest: web,
+					},
+				}
+			},
+			wantedWarnings: []string{
+				"service api: no container port found, defaulting to port 80",
+				`service web: "image" is ignored in favor of "build"`,
+				`service web: "deploy.restart_policy" is not supported and was ignored`,
+				`service web: "deploy.resources.reservations" is not supported, set "deploy.resources.limits" instead`,
+				"service web: only container port 80 is exposed through the load balancer",
+				`service web: "healthcheck" is not supported for a Load Balanced Web Service, configure "http.healthcheck" instead`,
+				`service web: "depends_on" service api is not a sidecar, reach it with service discovery instead`,
+			},
+		},
+		"errors if the memory can't be parsed": {
+			inContent: `
+services:
+  api:
+    build: api
+    deploy:
+      resources:
+        limits:
+          memory: lots
+`,
+			wantedErr: `convert service api: parse memory lots: strconv.ParseFloat: parsing "lots": invalid syntax`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			p, err := Parse([]byte(tc.inContent))
+			require.NoError(t, err)
+
+			// WHEN
+			workloads, warnings, err := p.Workloads(tc.inDir)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWorkloads(), workloads)
+			require.Equal(t, tc.wantedWarnings, warnings)
+		})
+	}
+}
+
+func TestParseMemory(t *testing.T) {
+	testCases := map[string]int{
+		"512M":       512,
+		"1gb":        1024,
+		"2G":         2048,
+		"524288k":    512,
+		"1073741824": 1024,
+	}
+	for in, wanted := range testCases {
+		t.Run(in, func(t *testing.T) {
+			got, err := parseMemory(in)
+
+			require.NoError(t, err)
+			require.Equal(t, wanted, got)
+		})
+	}
+}
+
+func durationp(d time.Duration) *time.Duration {
+	return &d
+}
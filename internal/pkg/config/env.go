@@ -6,6 +6,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -28,21 +29,44 @@ type Environment struct {
 
 // CustomizeEnv represents the custom environment config.
 type CustomizeEnv struct {
-	ImportVPC *ImportVPC `json:"importVPC,omitempty"`
-	VPCConfig *AdjustVPC `json:"adjustVPC,omitempty"`
+	ImportVPC      *ImportVPC `json:"importVPC,omitempty"`
+	VPCConfig      *AdjustVPC `json:"adjustVPC,omitempty"`
+	VPCEndpoints   bool       `json:"vpcEndpoints,omitempty"`   // Whether or not the tasks reach AWS services through VPC endpoints.
+	ImportCertARNs []string   `json:"importCertARNs,omitempty"` // ARNs of the ACM certificates attached to the HTTPS listener.
+
+	// Features enabled in the environment manifest, kept so that "env upgrade" doesn't turn them off without the manifest.
+	NATGateways             string         `json:"natGateways,omitempty"`             // Either "per-az" or "single".
+	InternalALB             bool           `json:"internalALB,omitempty"`             // Whether or not the environment has an internal load balancer.
+	WebACLARN               string         `json:"webACLARN,omitempty"`               // ARN of the WAFv2 web ACL of the public load balancer.
+	ELBAccessLogs           *ELBAccessLogs `json:"elbAccessLogs,omitempty"`           // Access logs of the public load balancer.
+	EnableContainerInsights bool           `json:"enableContainerInsights,omitempty"` // Whether or not Container Insights is enabled for the cluster.
+}
+
+// ELBAccessLogs holds the configuration of the access logs of the public load balancer.
+type ELBAccessLogs struct {
+	BucketName string `json:"bucketName,omitempty"` // Empty if the logs are stored in a bucket created by Copilot.
+	Prefix     string `json:"prefix,omitempty"`
+	Retention  int    `json:"retention,omitempty"` // Days before the logs in the bucket created by Copilot expire.
 }
 
 // NewCustomizeEnv returns a new CustomizeEnv struct.
-func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC) *CustomizeEnv {
-	if importVPC == nil && adjustVPC == nil {
+func NewCustomizeEnv(importVPC *ImportVPC, adjustVPC *AdjustVPC, vpcEndpoints bool, importCertARNs []string) *CustomizeEnv {
+	if importVPC == nil && adjustVPC == nil && !vpcEndpoints && len(importCertARNs) == 0 {
 		return nil
 	}
 	return &CustomizeEnv{
-		ImportVPC: importVPC,
-		VPCConfig: adjustVPC,
+		ImportVPC:      importVPC,
+		VPCConfig:      adjustVPC,
+		VPCEndpoints:   vpcEndpoints,
+		ImportCertARNs: importCertARNs,
 	}
 }
 
+// HasImportedCerts returns true if the HTTPS listener of the environment uses ACM certificates imported by the user.
+func (e *Environment) HasImportedCerts() bool {
+	return e.CustomConfig != nil && len(e.CustomConfig.ImportCertARNs) != 0
+}
+
 // ImportVPC holds the fields to import VPC resources.
 type ImportVPC struct {
 	ID               string   `json:"id"` // ID for the VPC.
@@ -50,11 +74,77 @@ type ImportVPC struct {
 	PrivateSubnetIDs []string `json:"privateSubnetIDs"`
 }
 
+// Range of the number of availability zones of the VPC created by Copilot.
+const (
+	MinAZCount = 2
+	MaxAZCount = 6
+)
+
 // AdjustVPC holds the fields to adjust default VPC resources.
 type AdjustVPC struct {
 	CIDR               string   `json:"cidr"` // CIDR range for the VPC.
 	PublicSubnetCIDRs  []string `json:"publicSubnetCIDRs"`
 	PrivateSubnetCIDRs []string `json:"privateSubnetCIDRs"`
+
+	// Names of the availability zones of the subnets: the i-th public and private subnets are placed in the i-th zone.
+	// If empty, the subnets are spread over the first zones of the region.
+	AZs []string `json:"availabilityZones,omitempty"`
+}
+
+// Validate returns an error if the subnets don't fit in the VPC CIDR range, overlap,
+// or don't match the availability zones.
+func (v *AdjustVPC) Validate() error {
+	_, vpcCIDR, err := net.ParseCIDR(v.CIDR)
+	if err != nil {
+		return fmt.Errorf("parse VPC CIDR %s: %w", v.CIDR, err)
+	}
+	if len(v.AZs) != 0 {
+		if err := v.validateAZs(); err != nil {
+			return err
+		}
+	}
+	var subnets []*net.IPNet
+	for _, cidr := range append(append([]string{}, v.PublicSubnetCIDRs...), v.PrivateSubnetCIDRs...) {
+		_, subnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("parse subnet CIDR %s: %w", cidr, err)
+		}
+		if !containsCIDR(vpcCIDR, subnet) {
+			return fmt.Errorf("subnet CIDR %s is not within the VPC CIDR %s", cidr, v.CIDR)
+		}
+		for _, other := range subnets {
+			if containsCIDR(other, subnet) || containsCIDR(subnet, other) {
+				return fmt.Errorf("subnet CIDRs %s and %s overlap", other, subnet)
+			}
+		}
+		subnets = append(subnets, subnet)
+	}
+	return nil
+}
+
+func (v *AdjustVPC) validateAZs() error {
+	if len(v.AZs) < MinAZCount || len(v.AZs) > MaxAZCount {
+		return fmt.Errorf("number of availability zones must be between %d and %d, got %d", MinAZCount, MaxAZCount, len(v.AZs))
+	}
+	seen := make(map[string]bool)
+	for _, az := range v.AZs {
+		if seen[az] {
+			return fmt.Errorf("availability zone %s is listed more than once", az)
+		}
+		seen[az] = true
+	}
+	if len(v.PublicSubnetCIDRs) != len(v.AZs) || len(v.PrivateSubnetCIDRs) != len(v.AZs) {
+		return fmt.Errorf("%d availability zones require %d public and %d private subnets, got %d public and %d private subnets",
+			len(v.AZs), len(v.AZs), len(v.AZs), len(v.PublicSubnetCIDRs), len(v.PrivateSubnetCIDRs))
+	}
+	return nil
+}
+
+// containsCIDR returns true if the range b is within the range a.
+func containsCIDR(a, b *net.IPNet) bool {
+	aOnes, aBits := a.Mask.Size()
+	bOnes, bBits := b.Mask.Size()
+	return aBits == bBits && aOnes <= bOnes && a.Contains(b.IP)
 }
 
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
@@ -88,6 +178,26 @@ func (s *Store) CreateEnvironment(environment *Environment) error {
 	return nil
 }
 
+// UpdateEnvironment overwrites the configuration of an existing environment.
+func (s *Store) UpdateEnvironment(environment *Environment) error {
+	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
+	data, err := marshal(environment)
+	if err != nil {
+		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+
+	_, err = s.ssmClient.PutParameter(&ssm.PutParameterInput{
+		Name:      aws.String(environmentPath),
+		Type:      aws.String(ssm.ParameterTypeString),
+		Value:     aws.String(data),
+		Overwrite: aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("update environment %s in application %s: %w", environment.Name, environment.App, err)
+	}
+	return nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
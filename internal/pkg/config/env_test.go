@@ -330,6 +330,65 @@ func TestStore_CreateEnvironment(t *testing.T) {
 	}
 }
 
+func TestStore_UpdateEnvironment(t *testing.T) {
+	testEnvironment := Environment{
+		Name:      "test",
+		App:       "chicken",
+		AccountID: "1234",
+		Region:    "us-west-2",
+		CustomConfig: &CustomizeEnv{
+			ImportCertARNs: []string{"arn:aws:acm:us-west-2:1234:certificate/mockCert"},
+		},
+	}
+	testEnvironmentString, err := marshal(testEnvironment)
+	testEnvironmentPath := fmt.Sprintf(fmtEnvParamPath, testEnvironment.App, testEnvironment.Name)
+	require.NoError(t, err, "Marshal environment should not fail")
+
+	testCases := map[string]struct {
+		mockPutParameter func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+		wantedErr        error
+	}{
+		"overwrites the environment": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				require.Equal(t, testEnvironmentPath, *param.Name)
+				require.Equal(t, testEnvironmentString, *param.Value)
+				require.True(t, aws.BoolValue(param.Overwrite))
+				return &ssm.PutParameterOutput{
+					Version: aws.Int64(2),
+				}, nil
+			},
+		},
+		"with SSM error": {
+			mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+				return nil, fmt.Errorf("broken")
+			},
+			wantedErr: fmt.Errorf("update environment test in application chicken: broken"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			store := &Store{
+				ssmClient: &mockSSM{
+					t:                t,
+					mockPutParameter: tc.mockPutParameter,
+				},
+			}
+
+			// WHEN
+			err := store.UpdateEnvironment(&testEnvironment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStore_DeleteEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		inApplicationName string
@@ -386,3 +445,90 @@ func TestStore_DeleteEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestAdjustVPC_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in        AdjustVPC
+		wantedErr string
+	}{
+		"valid subnets spread over three availability zones": {
+			in: AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.3.0/24", "10.0.4.0/24", "10.0.5.0/24"},
+				AZs:                []string{"us-west-2a", "us-west-2b", "us-west-2c"},
+			},
+		},
+		"valid subnets without availability zones": {
+			in: AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.128.0/17"},
+			},
+		},
+		"error if the VPC CIDR is invalid": {
+			in: AdjustVPC{
+				CIDR: "10.0.0.0",
+			},
+			wantedErr: "parse VPC CIDR 10.0.0.0: invalid CIDR address: 10.0.0.0",
+		},
+		"error if a subnet is larger than the VPC": {
+			in: AdjustVPC{
+				CIDR:              "10.0.0.0/16",
+				PublicSubnetCIDRs: []string{"10.0.0.0/8"},
+			},
+			wantedErr: "subnet CIDR 10.0.0.0/8 is not within the VPC CIDR 10.0.0.0/16",
+		},
+		"error if a subnet is outside of the VPC": {
+			in: AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24"},
+				PrivateSubnetCIDRs: []string{"10.1.0.0/24"},
+			},
+			wantedErr: "subnet CIDR 10.1.0.0/24 is not within the VPC CIDR 10.0.0.0/16",
+		},
+		"error if subnets overlap": {
+			in: AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.0.0.0/23"},
+				PrivateSubnetCIDRs: []string{"10.0.1.0/24"},
+			},
+			wantedErr: "subnet CIDRs 10.0.0.0/23 and 10.0.1.0/24 overlap",
+		},
+		"error if there are too many availability zones": {
+			in: AdjustVPC{
+				CIDR: "10.0.0.0/16",
+				AZs:  []string{"a", "b", "c", "d", "e", "f", "g"},
+			},
+			wantedErr: "number of availability zones must be between 2 and 6, got 7",
+		},
+		"error if an availability zone is duplicated": {
+			in: AdjustVPC{
+				CIDR: "10.0.0.0/16",
+				AZs:  []string{"us-west-2a", "us-west-2a"},
+			},
+			wantedErr: "availability zone us-west-2a is listed more than once",
+		},
+		"error if the subnets don't match the availability zones": {
+			in: AdjustVPC{
+				CIDR:               "10.0.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+				PrivateSubnetCIDRs: []string{"10.0.3.0/24", "10.0.4.0/24"},
+				AZs:                []string{"us-west-2a", "us-west-2b", "us-west-2c"},
+			},
+			wantedErr: "3 availability zones require 3 public and 3 private subnets, got 3 public and 2 private subnets",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -36,7 +36,12 @@ type cfnClient interface {
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
 	Describe(stackName string) (*cloudformation.StackDescription, error)
+	ListStacksWithTags(tags map[string]string) ([]cloudformation.StackDescription, error)
 	Events(stackName string) ([]cloudformation.StackEvent, error)
+	EventsSince(stackName string, since time.Time) ([]cloudformation.StackEvent, error)
+	CreateChangeSet(*cloudformation.Stack) (*cloudformation.ChangeSetDescription, error)
+	ExecuteChangeSet(stackName, changeSetName string) error
+	DeleteChangeSet(stackName, changeSetName string) error
 }
 
 type stackSetClient interface {
@@ -70,13 +75,13 @@ func New(sess *session.Session) CloudFormation {
 	}
 }
 
-// streamResourceEvents sends a list of ResourceEvent every 3 seconds to the events channel.
+// streamResourceEvents sends a list of ResourceEvent that occurred since the given time every 3 seconds to the events channel.
 // The events channel is closed only when the done channel receives a message.
 // If an error occurs while describing stack events, it is ignored so that the stream is not interrupted.
-func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string) {
+func (cf CloudFormation) streamResourceEvents(done <-chan struct{}, events chan []deploy.ResourceEvent, stackName string, since time.Time) {
 	sendStatusUpdates := func() {
 		// Send a list of ResourceEvent to events if there was no error.
-		cfEvents, err := cf.cfnClient.Events(stackName)
+		cfEvents, err := cf.cfnClient.EventsSince(stackName, since)
 		if err != nil {
 			return
 		}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -46,7 +47,7 @@ func (cf CloudFormation) StreamEnvironmentCreation(env *deploy.CreateEnvironment
 	resp := make(chan deploy.CreateEnvironmentResponse, 1)
 
 	stack := stack.NewEnvStackConfig(env)
-	go cf.streamResourceEvents(done, events, stack.StackName())
+	go cf.streamResourceEvents(done, events, stack.StackName(), time.Time{})
 	go cf.streamEnvironmentResponse(done, resp, stack)
 	return events, resp
 }
@@ -60,6 +61,24 @@ func (cf CloudFormation) DeleteEnvironment(appName, envName string) error {
 	return cf.cfnClient.DeleteAndWait(conf.StackName())
 }
 
+// ManagedELBAccessLogsBucket returns the name of the bucket created by Copilot for the access logs of the environment's
+// public load balancer. If the environment doesn't have such a bucket or its stack doesn't exist, returns an empty string.
+func (cf CloudFormation) ManagedELBAccessLogsBucket(appName, envName string) (string, error) {
+	conf := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+		AppName: appName,
+		Name:    envName,
+	})
+	descr, err := cf.cfnClient.Describe(conf.StackName())
+	if err != nil {
+		var errNotFound *cloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return conf.ManagedELBAccessLogsBucket(descr.SDK()), nil
+}
+
 // streamEnvironmentResponse sends a CreateEnvironmentResponse to the response channel once the stack creation halts.
 // The done channel is closed once this method exits to notify other streams that they should stop working.
 func (cf CloudFormation) streamEnvironmentResponse(done chan struct{}, resp chan deploy.CreateEnvironmentResponse, stack *stack.EnvStackConfig) {
@@ -183,3 +183,83 @@ func TestCloudFormation_UpgradeLegacyEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_ManagedELBAccessLogsBucket(t *testing.T) {
+	testCases := map[string]struct {
+		mockCfnClient func(ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedBucket string
+		wantedErr    error
+	}{
+		"returns the bucket created by the environment stack": {
+			mockCfnClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Outputs: []*awscfn.Output{
+						{
+							OutputKey:   aws.String("PublicLoadBalancerAccessLogsBucket"),
+							OutputValue: aws.String("phonetool-test-elbaccesslogsbucket"),
+						},
+						{
+							OutputKey:   aws.String("ELBAccessLogsBucket"),
+							OutputValue: aws.String("phonetool-test-elbaccesslogsbucket"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedBucket: "phonetool-test-elbaccesslogsbucket",
+		},
+		"returns an empty string for an existing bucket": {
+			mockCfnClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Outputs: []*awscfn.Output{
+						{
+							OutputKey:   aws.String("PublicLoadBalancerAccessLogsBucket"),
+							OutputValue: aws.String("my-logs"),
+						},
+					},
+				}, nil)
+				return m
+			},
+		},
+		"returns an empty string if the stack doesn't exist": {
+			mockCfnClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, &cloudformation.ErrStackNotFound{})
+				return m
+			},
+		},
+		"returns unexpected errors": {
+			mockCfnClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				cfnClient: tc.mockCfnClient(ctrl),
+			}
+
+			// WHEN
+			bucket, err := cf.ManagedELBAccessLogsBucket("phonetool", "test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBucket, bucket)
+		})
+	}
+}
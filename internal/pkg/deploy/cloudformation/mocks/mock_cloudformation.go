@@ -10,6 +10,7 @@ import (
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockStackConfiguration is a mock of StackConfiguration interface
@@ -243,6 +244,21 @@ func (mr *MockcfnClientMockRecorder) Describe(stackName interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockcfnClient)(nil).Describe), stackName)
 }
 
+// ListStacksWithTags mocks base method
+func (m *MockcfnClient) ListStacksWithTags(tags map[string]string) ([]cloudformation0.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStacksWithTags", tags)
+	ret0, _ := ret[0].([]cloudformation0.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStacksWithTags indicates an expected call of ListStacksWithTags
+func (mr *MockcfnClientMockRecorder) ListStacksWithTags(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStacksWithTags", reflect.TypeOf((*MockcfnClient)(nil).ListStacksWithTags), tags)
+}
+
 // Events mocks base method
 func (m *MockcfnClient) Events(stackName string) ([]cloudformation0.StackEvent, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +274,64 @@ func (mr *MockcfnClientMockRecorder) Events(stackName interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockcfnClient)(nil).Events), stackName)
 }
 
+// EventsSince mocks base method
+func (m *MockcfnClient) EventsSince(stackName string, since time.Time) ([]cloudformation0.StackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventsSince", stackName, since)
+	ret0, _ := ret[0].([]cloudformation0.StackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EventsSince indicates an expected call of EventsSince
+func (mr *MockcfnClientMockRecorder) EventsSince(stackName, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventsSince", reflect.TypeOf((*MockcfnClient)(nil).EventsSince), stackName, since)
+}
+
+// CreateChangeSet mocks base method
+func (m *MockcfnClient) CreateChangeSet(arg0 *cloudformation0.Stack) (*cloudformation0.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0)
+	ret0, _ := ret[0].(*cloudformation0.ChangeSetDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet
+func (mr *MockcfnClientMockRecorder) CreateChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockcfnClient)(nil).CreateChangeSet), arg0)
+}
+
+// ExecuteChangeSet mocks base method
+func (m *MockcfnClient) ExecuteChangeSet(stackName, changeSetName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteChangeSet", stackName, changeSetName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteChangeSet indicates an expected call of ExecuteChangeSet
+func (mr *MockcfnClientMockRecorder) ExecuteChangeSet(stackName, changeSetName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).ExecuteChangeSet), stackName, changeSetName)
+}
+
+// DeleteChangeSet mocks base method
+func (m *MockcfnClient) DeleteChangeSet(stackName, changeSetName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", stackName, changeSetName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet
+func (mr *MockcfnClientMockRecorder) DeleteChangeSet(stackName, changeSetName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).DeleteChangeSet), stackName, changeSetName)
+}
+
 // MockstackSetClient is a mock of stackSetClient interface
 type MockstackSetClient struct {
 	ctrl     *gomock.Controller
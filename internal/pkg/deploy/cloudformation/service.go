@@ -6,7 +6,10 @@ package cloudformation
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 )
@@ -35,6 +38,126 @@ func (cf CloudFormation) DeployService(conf StackConfiguration, opts ...cloudfor
 	return cf.cfnClient.UpdateAndWait(stack)
 }
 
+// StreamServiceDeployment deploys a service stack and streams resource update events while the deployment is taking place.
+// Only the events that occur after the deployment starts are streamed.
+// Once the CloudFormation stack operation halts, the events channel is closed and the
+// result of DeployService is sent to the second channel.
+func (cf CloudFormation) StreamServiceDeployment(conf StackConfiguration, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	done := make(chan struct{})
+	events := make(chan []deploy.ResourceEvent)
+	resp := make(chan error, 1)
+
+	go cf.streamResourceEvents(done, events, conf.StackName(), time.Now())
+	go func() {
+		defer close(done)
+		resp <- cf.DeployService(conf, opts...)
+	}()
+	return events, resp
+}
+
+// StreamServiceRollback updates the service stack with the template that it's currently deployed with and the given parameters.
+// It streams resource update events while the update is taking place.
+// Once the CloudFormation stack operation halts, the events channel is closed and the
+// result of the update is sent to the second channel.
+func (cf CloudFormation) StreamServiceRollback(stackName string, params map[string]string, opts ...cloudformation.StackOption) (<-chan []deploy.ResourceEvent, <-chan error) {
+	stack := cloudformation.NewStack(stackName, "", cloudformation.WithPreviousTemplate(), cloudformation.WithParameters(params))
+	for _, opt := range opts {
+		opt(stack)
+	}
+
+	done := make(chan struct{})
+	events := make(chan []deploy.ResourceEvent)
+	resp := make(chan error, 1)
+
+	go cf.streamResourceEvents(done, events, stackName, time.Now())
+	go func() {
+		defer close(done)
+		resp <- cf.cfnClient.UpdateAndWait(stack)
+	}()
+	return events, resp
+}
+
+// ChangeSet represents a change set that was created for a stack but hasn't been executed yet.
+type ChangeSet struct {
+	Name      string
+	StackName string
+	Changes   []deploy.ResourceChange
+
+	createsStack bool
+}
+
+// CreateServiceChangeSet creates a change set for the service stack without executing it, so that the changes can be reviewed.
+// If there are no changes to deploy, returns a cloudformation.ErrChangeSetEmpty.
+func (cf CloudFormation) CreateServiceChangeSet(conf StackConfiguration, opts ...cloudformation.StackOption) (*ChangeSet, error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	descr, err := cf.cfnClient.CreateChangeSet(stack)
+	if err != nil {
+		return nil, err
+	}
+	var changes []deploy.ResourceChange
+	for _, change := range descr.Changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		changes = append(changes, deploy.ResourceChange{
+			Resource: deploy.Resource{
+				LogicalName: aws.StringValue(change.ResourceChange.LogicalResourceId),
+				Type:        aws.StringValue(change.ResourceChange.ResourceType),
+			},
+			Action:      aws.StringValue(change.ResourceChange.Action),
+			Replacement: aws.StringValue(change.ResourceChange.Replacement),
+		})
+	}
+	return &ChangeSet{
+		Name:         descr.Name,
+		StackName:    descr.StackName,
+		Changes:      changes,
+		createsStack: descr.Type == sdkcloudformation.ChangeSetTypeCreate,
+	}, nil
+}
+
+// StreamChangeSetExecution executes a change set created with CreateServiceChangeSet and streams resource update events
+// while the stack is being deployed.
+// Once the CloudFormation stack operation halts, the events channel is closed and the
+// result of the deployment is sent to the second channel.
+func (cf CloudFormation) StreamChangeSetExecution(cs *ChangeSet) (<-chan []deploy.ResourceEvent, <-chan error) {
+	done := make(chan struct{})
+	events := make(chan []deploy.ResourceEvent)
+	resp := make(chan error, 1)
+
+	go cf.streamResourceEvents(done, events, cs.StackName, time.Now())
+	go func() {
+		defer close(done)
+		resp <- cf.executeChangeSet(cs)
+	}()
+	return events, resp
+}
+
+// DeleteChangeSet removes a change set created with CreateServiceChangeSet.
+// If the change set would have created the stack, the stack that's pending review is removed as well.
+func (cf CloudFormation) DeleteChangeSet(cs *ChangeSet) error {
+	if cs.createsStack {
+		return cf.cfnClient.DeleteAndWait(cs.StackName)
+	}
+	return cf.cfnClient.DeleteChangeSet(cs.StackName, cs.Name)
+}
+
+func (cf CloudFormation) executeChangeSet(cs *ChangeSet) error {
+	if err := cf.cfnClient.ExecuteChangeSet(cs.StackName, cs.Name); err != nil {
+		return err
+	}
+	if cs.createsStack {
+		return cf.cfnClient.WaitForCreate(cs.StackName)
+	}
+	return cf.cfnClient.WaitForUpdate(cs.StackName)
+}
+
 // DeleteService removes the CloudFormation stack of a deployed service.
 func (cf CloudFormation) DeleteService(in deploy.DeleteWorkloadInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
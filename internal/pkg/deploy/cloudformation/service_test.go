@@ -4,6 +4,7 @@
 package cloudformation
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -112,6 +113,226 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_StreamServiceDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedEvents []deploy.ResourceEvent
+		wantedErr    error
+	}{
+		"streams events and sends the deployment error": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateAndWait(gomock.Any()).Return(errors.New("some error"))
+				m.EXPECT().EventsSince("webhook", gomock.Any()).Return([]cloudformation.StackEvent{
+					{
+						LogicalResourceId:    aws.String("Service"),
+						ResourceType:         aws.String("AWS::ECS::Service"),
+						ResourceStatus:       aws.String("CREATE_FAILED"),
+						ResourceStatusReason: aws.String("Service did not stabilize. Some code"),
+					},
+				}, nil).AnyTimes()
+				return m
+			},
+			wantedEvents: []deploy.ResourceEvent{
+				{
+					Resource: deploy.Resource{
+						LogicalName: "Service",
+						Type:        "AWS::ECS::Service",
+					},
+					Status:       "CREATE_FAILED",
+					StatusReason: "Service did not stabilize",
+				},
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"sends nil once the stack is deployed": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateAndWait(gomock.Any()).Return(nil)
+				m.EXPECT().EventsSince("webhook", gomock.Any()).Return(nil, nil).AnyTimes()
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+			}
+
+			// WHEN
+			events, resp := c.StreamServiceDeployment(conf)
+
+			// THEN
+			var lastEvents []deploy.ResourceEvent
+			for e := range events {
+				lastEvents = e
+			}
+			require.Equal(t, tc.wantedEvents, lastEvents)
+			require.Equal(t, tc.wantedErr, <-resp)
+		})
+	}
+}
+
+func TestCloudFormation_StreamServiceRollback(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().UpdateAndWait(cloudformation.NewStack("webhook", "",
+		cloudformation.WithPreviousTemplate(),
+		cloudformation.WithParameters(map[string]string{
+			"ContainerImage": "nginx:v1",
+		}),
+		cloudformation.WithRoleARN("myrole"))).Return(nil)
+	m.EXPECT().EventsSince("webhook", gomock.Any()).Return(nil, nil).AnyTimes()
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	events, resp := c.StreamServiceRollback("webhook", map[string]string{
+		"ContainerImage": "nginx:v1",
+	}, cloudformation.WithRoleARN("myrole"))
+
+	// THEN
+	for range events {
+	}
+	require.NoError(t, <-resp)
+}
+
+func TestCloudFormation_CreateServiceChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedChangeSet *ChangeSet
+		wantedErr       error
+	}{
+		"returns the error if the change set can't be created": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"converts the resource changes of the change set": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.ChangeSetDescription{
+					Name:      "copilot-1234",
+					StackName: "webhook",
+					Type:      sdkcloudformation.ChangeSetTypeUpdate,
+					Changes: []*sdkcloudformation.Change{
+						{
+							ResourceChange: &sdkcloudformation.ResourceChange{
+								Action:            aws.String("Modify"),
+								LogicalResourceId: aws.String("TargetGroup"),
+								ResourceType:      aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+								Replacement:       aws.String("True"),
+							},
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedChangeSet: &ChangeSet{
+				Name:      "copilot-1234",
+				StackName: "webhook",
+				Changes: []deploy.ResourceChange{
+					{
+						Resource: deploy.Resource{
+							LogicalName: "TargetGroup",
+							Type:        "AWS::ElasticLoadBalancingV2::TargetGroup",
+						},
+						Action:      "Modify",
+						Replacement: "True",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+			conf := &mockStackConfig{
+				name:     "webhook",
+				template: "template",
+			}
+
+			// WHEN
+			cs, err := c.CreateServiceChangeSet(conf)
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+			require.Equal(t, tc.wantedChangeSet, cs)
+		})
+	}
+}
+
+func TestCloudFormation_DeleteChangeSet(t *testing.T) {
+	testCases := map[string]struct {
+		in         *ChangeSet
+		createMock func(ctrl *gomock.Controller) cfnClient
+	}{
+		"deletes only the change set if the stack already exists": {
+			in: &ChangeSet{
+				Name:      "copilot-1234",
+				StackName: "webhook",
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DeleteChangeSet("webhook", "copilot-1234").Return(nil)
+				return m
+			},
+		},
+		"deletes the stack under review if the change set creates it": {
+			in: &ChangeSet{
+				Name:         "copilot-1234",
+				StackName:    "webhook",
+				createsStack: true,
+			},
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DeleteAndWait("webhook").Return(nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			err := c.DeleteChangeSet(tc.in)
+
+			// THEN
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCloudFormation_DeleteService(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteWorkloadInput
@@ -52,6 +52,8 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 			rc:     rc,
 			parser: parser,
 			addons: addons,
+
+			logging: envManifest.BackendServiceConfig.Logging,
 		},
 		manifest: envManifest,
 
@@ -77,15 +79,50 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
 	}
+	capacityProviders, err := s.manifest.Count.CapacityProviders()
+	if err != nil {
+		return "", fmt.Errorf("convert the capacity providers of service %s: %w", s.name, err)
+	}
+	publishers, err := s.manifest.Publish.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the publish configuration for service %s: %w", s.name, err)
+	}
+	network, err := s.manifest.Network.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the network configuration for service %s: %w", s.name, err)
+	}
+	var rulePriorityLambda string
+	var listenerRule *template.ListenerRuleOpts
+	var additionalRules []*template.ListenerRuleOpts
+	if s.manifest.HasInternalRoutingRule() {
+		lambda, err := s.parser.Read(lbWebSvcRulePriorityGeneratorPath)
+		if err != nil {
+			return "", fmt.Errorf("read rule priority lambda: %w", err)
+		}
+		rulePriorityLambda = lambda.String()
+		listenerRule, additionalRules, err = s.manifest.RoutingRule.ListenerRulesOpts()
+		if err != nil {
+			return "", fmt.Errorf("convert the http configuration for service %s: %w", s.name, err)
+		}
+	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
 		Variables:          s.manifest.BackendServiceConfig.Variables,
 		Secrets:            s.manifest.BackendServiceConfig.Secrets,
 		NestedStack:        outputs,
 		Sidecars:           sidecars,
 		Autoscaling:        autoscaling,
+		CapacityProviders:  capacityProviders,
 		HealthCheck:        s.manifest.BackendServiceConfig.Image.HealthCheckOpts(),
 		LogConfig:          s.manifest.LogConfigOpts(),
 		DesiredCountLambda: desiredCountLambda.String(),
+		EnableExec:         aws.BoolValue(s.manifest.BackendServiceConfig.Exec),
+		Publish:            publishers,
+		Network:            network,
+
+		RulePriorityLambda:      rulePriorityLambda,
+		ListenerRule:            listenerRule,
+		AdditionalListenerRules: additionalRules,
+		InternalALB:             s.manifest.HasInternalRoutingRule(),
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
@@ -99,6 +136,14 @@ func (s *BackendService) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
+	if s.manifest.HasInternalRoutingRule() {
+		// The internal load balancer only has an HTTP listener.
+		ruleParams, err := routingRuleParams(s.name, s.manifest.RoutingRule, s.manifest.Image.Port, s.manifest.Sidecars, false)
+		if err != nil {
+			return nil, err
+		}
+		svcParams = append(svcParams, ruleParams...)
+	}
 	return append(svcParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(BackendServiceContainerPortParamKey),
@@ -62,6 +62,16 @@ func TestBackendService_Template(t *testing.T) {
 	testBackendSvcManifestWithBadAutoScaling.Count.Autoscaling = manifest.Autoscaling{
 		Range: manifest.Range("badRange"),
 	}
+	testBackendSvcManifestWithRoutingRule := manifest.NewBackendService(baseProps)
+	testBackendSvcManifestWithRoutingRule.RoutingRule = manifest.RoutingRule{
+		Path: aws.String("api"),
+		Headers: map[string][]string{
+			"X-Client": {"mobile"},
+		},
+		AdditionalRules: []manifest.ListenerRule{
+			{Path: aws.String("/v2")},
+		},
+	}
 	testCases := map[string]struct {
 		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *BackendService)
 		manifest         *manifest.BackendService
@@ -148,6 +158,10 @@ func TestBackendService_Template(t *testing.T) {
 						StackName:       addon.StackName,
 						VariableOutputs: []string{"Hello"},
 					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				svc.parser = m
 				svc.addons = mockTemplater{
@@ -158,6 +172,37 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedTemplate: "template",
 		},
+		"render template with listener rules on the internal load balancer": {
+			manifest: testBackendSvcManifestWithRoutingRule,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("priority")}, nil)
+				m.EXPECT().ParseBackendService(template.WorkloadOpts{
+					DesiredCountLambda: "something",
+					NestedStack: &template.WorkloadNestedStackOpts{
+						StackName: addon.StackName,
+					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
+					RulePriorityLambda: "priority",
+					ListenerRule: &template.ListenerRuleOpts{
+						Headers: map[string][]string{
+							"X-Client": {"mobile"},
+						},
+					},
+					AdditionalListenerRules: []*template.ListenerRuleOpts{
+						{Path: "/v2"},
+					},
+					InternalALB: true,
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{}
+			},
+			wantedTemplate: "template",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -256,3 +301,119 @@ func TestBackendService_Parameters(t *testing.T) {
 		},
 	}, params)
 }
+
+func TestBackendService_ParametersWithRoutingRule(t *testing.T) {
+	testCases := map[string]struct {
+		rule manifest.RoutingRule
+
+		wantedParams []*cloudformation.Parameter
+		wantedErr    error
+	}{
+		"adds the parameters of the target group": {
+			rule: manifest.RoutingRule{
+				Path: aws.String("api"),
+				HealthCheck: manifest.HealthCheckArgsOrString{
+					HealthCheckPath: aws.String("/healthz"),
+				},
+			},
+			wantedParams: []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
+					ParameterValue: aws.String("api"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckPathParamKey),
+					ParameterValue: aws.String("/healthz"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckSuccessCodesParamKey),
+					ParameterValue: aws.String("200"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckHealthyThresholdParamKey),
+					ParameterValue: aws.String("2"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckUnhealthyThresholdParamKey),
+					ParameterValue: aws.String("2"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckIntervalParamKey),
+					ParameterValue: aws.String("10"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckTimeoutParamKey),
+					ParameterValue: aws.String("5"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckGracePeriodParamKey),
+					ParameterValue: aws.String("60"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDeregistrationDelayParamKey),
+					ParameterValue: aws.String("60"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceProtocolVersionParamKey),
+					ParameterValue: aws.String("HTTP1"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("8080"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+					ParameterValue: aws.String("false"),
+				},
+			},
+		},
+		"error if the protocol version requires HTTPS": {
+			rule: manifest.RoutingRule{
+				Path:            aws.String("api"),
+				ProtocolVersion: aws.String("grpc"),
+			},
+			wantedErr: errors.New(`"http.protocol_version" GRPC requires an environment with an HTTPS listener`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := manifest.NewBackendService(manifest.BackendServiceProps{
+				WorkloadProps: manifest.WorkloadProps{
+					Name:       "frontend",
+					Dockerfile: "./frontend/Dockerfile",
+				},
+				Port: 8080,
+			})
+			mft.RoutingRule = tc.rule
+			conf := &BackendService{
+				wkld: &wkld{
+					name: aws.StringValue(mft.Name),
+					env:  testEnvName,
+					app:  testAppName,
+					tc:   mft.BackendServiceConfig.TaskConfig,
+					rc: RuntimeConfig{
+						ImageRepoURL: testImageRepoURL,
+						ImageTag:     testImageTag,
+					},
+				},
+				manifest: mft,
+			}
+
+			// WHEN
+			params, err := conf.Parameters()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Subset(t, params, tc.wantedParams)
+		})
+	}
+}
@@ -4,7 +4,10 @@
 package stack
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
@@ -20,6 +24,8 @@ type envReadParser interface {
 	ParseEnv(data *template.EnvOpts, options ...template.ParseOption) (*template.Content, error)
 }
 
+var errNATPerAZSubnets = errors.New(`"per-az" NAT gateways require a public subnet for each private subnet`)
+
 // EnvStackConfig is for providing all the values to set up an
 // environment stack and to interpret the outputs from it.
 type EnvStackConfig struct {
@@ -42,11 +48,15 @@ const (
 	// Output keys.
 	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
+	envOutputELBAccessLogsBucket = "ELBAccessLogsBucket"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
 	DefaultPublicSubnetCIDRs  = "10.0.0.0/24,10.0.1.0/24"
 	DefaultPrivateSubnetCIDRs = "10.0.2.0/24,10.0.3.0/24"
+	DefaultAZCount            = 2
+
+	defaultSubnetPrefixLength = 24
 )
 
 // NewEnvStackConfig sets up a struct which can provide values to CloudFormation for
@@ -58,6 +68,33 @@ func NewEnvStackConfig(input *deploy.CreateEnvironmentInput) *EnvStackConfig {
 	}
 }
 
+// DefaultSubnetCIDRs returns the CIDR ranges of one public and one private subnet in each of azCount availability zones.
+// The subnets are consecutive /24 ranges at the start of the VPC CIDR, the public subnets followed by the private ones.
+// For example, two zones in DefaultVPCCIDR result in DefaultPublicSubnetCIDRs and DefaultPrivateSubnetCIDRs.
+func DefaultSubnetCIDRs(vpcCIDR string, azCount int) (public, private []string, err error) {
+	_, vpc, err := net.ParseCIDR(vpcCIDR)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse VPC CIDR %s: %w", vpcCIDR, err)
+	}
+	start := vpc.IP.To4()
+	ones, _ := vpc.Mask.Size()
+	if start == nil || ones > defaultSubnetPrefixLength || 2*azCount > 1<<(defaultSubnetPrefixLength-ones) {
+		return nil, nil, fmt.Errorf("VPC CIDR %s is too small for %d public and %d private /%d subnets",
+			vpcCIDR, azCount, azCount, defaultSubnetPrefixLength)
+	}
+	for i := 0; i < 2*azCount; i++ {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(start)+uint32(i)<<(32-defaultSubnetPrefixLength))
+		cidr := fmt.Sprintf("%s/%d", ip, defaultSubnetPrefixLength)
+		if i < azCount {
+			public = append(public, cidr)
+		} else {
+			private = append(private, cidr)
+		}
+	}
+	return public, private, nil
+}
+
 // Template returns the environment CloudFormation template.
 func (e *EnvStackConfig) Template() (string, error) {
 	dnsLambda, err := e.parser.Read(dnsDelegationTemplatePath)
@@ -81,6 +118,11 @@ func (e *EnvStackConfig) Template() (string, error) {
 	if e.in.AdjustVPCConfig != nil {
 		vpcConf = e.in.AdjustVPCConfig
 	}
+	if e.in.ImportVPCConfig == nil && e.in.NATGateways == manifest.NATGatewaysPerAZ &&
+		len(vpcConf.PublicSubnetCIDRs) < len(vpcConf.PrivateSubnetCIDRs) {
+		// The route of each private subnet goes through the NAT gateway in the public subnet with the same index.
+		return "", errNATPerAZSubnets
+	}
 
 	content, err := e.parser.ParseEnv(&template.EnvOpts{
 		ACMValidationLambda:       acmLambda.String(),
@@ -88,6 +130,13 @@ func (e *EnvStackConfig) Template() (string, error) {
 		EnableLongARNFormatLambda: enableLongARNsLambda.String(),
 		ImportVPC:                 e.in.ImportVPCConfig,
 		VPCConfig:                 vpcConf,
+		NATGateways:               e.in.NATGateways,
+		VPCEndpoints:              e.in.VPCEndpoints,
+		ImportCertARNs:            e.in.ImportCertARNs,
+		WebACLARN:                 e.in.WebACLARN,
+		ELBAccessLogs:             e.in.ELBAccessLogs,
+		InternalALB:               e.in.InternalALB,
+		EnableContainerInsights:   e.in.EnableContainerInsights,
 		Version:                   e.in.Version,
 	}, template.WithFuncs(map[string]interface{}{
 		"inc": template.IncFunc,
@@ -173,3 +222,14 @@ func (e *EnvStackConfig) ToEnv(stack *cloudformation.Stack) (*config.Environment
 		ExecutionRoleARN: stackOutputs[envOutputCFNExecutionRoleARN],
 	}, nil
 }
+
+// ManagedELBAccessLogsBucket returns the name of the bucket created by the environment stack for the access logs
+// of the public load balancer. If the stack doesn't create such a bucket, returns an empty string.
+func (e *EnvStackConfig) ManagedELBAccessLogsBucket(stack *cloudformation.Stack) string {
+	for _, output := range stack.Outputs {
+		if aws.StringValue(output.OutputKey) == envOutputELBAccessLogsBucket {
+			return aws.StringValue(output.OutputValue)
+		}
+	}
+	return ""
+}
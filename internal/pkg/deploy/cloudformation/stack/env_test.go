@@ -34,6 +34,21 @@ func TestEnvTemplate(t *testing.T) {
 			},
 			want: errors.New("some error"),
 		},
+		"should return error if there are fewer public than private subnets for per-az NAT gateways": {
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("customresources")}, nil).Times(3)
+				m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).Times(0)
+				e.parser = m
+				e.in.NATGateways = "per-az"
+				e.in.AdjustVPCConfig = &config.AdjustVPC{
+					CIDR:               DefaultVPCCIDR,
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.1.0/24", "10.0.2.0/24"},
+				}
+			},
+			want: errNATPerAZSubnets,
+		},
 		"should return template body when present": {
 			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
 				m := mocks.NewMockenvReadParser(ctrl)
@@ -307,3 +322,56 @@ func mockDeployEnvironmentInput() *deploy.CreateEnvironmentInput {
 		ToolsAccountPrincipalARN: "arn:aws:iam::000000000:root",
 	}
 }
+
+func TestDefaultSubnetCIDRs(t *testing.T) {
+	testCases := map[string]struct {
+		inVPCCIDR string
+		inAZCount int
+
+		wantedPublic  []string
+		wantedPrivate []string
+		wantedErr     string
+	}{
+		"matches the default subnets with two availability zones": {
+			inVPCCIDR: DefaultVPCCIDR,
+			inAZCount: DefaultAZCount,
+
+			wantedPublic:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+			wantedPrivate: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+		},
+		"starts at the beginning of the VPC CIDR": {
+			inVPCCIDR: "10.1.4.0/22",
+			inAZCount: 2,
+
+			wantedPublic:  []string{"10.1.4.0/24", "10.1.5.0/24"},
+			wantedPrivate: []string{"10.1.6.0/24", "10.1.7.0/24"},
+		},
+		"three availability zones": {
+			inVPCCIDR: "10.0.0.0/16",
+			inAZCount: 3,
+
+			wantedPublic:  []string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"},
+			wantedPrivate: []string{"10.0.3.0/24", "10.0.4.0/24", "10.0.5.0/24"},
+		},
+		"error if the VPC CIDR is too small": {
+			inVPCCIDR: "10.1.4.0/22",
+			inAZCount: 3,
+
+			wantedErr: "VPC CIDR 10.1.4.0/22 is too small for 3 public and 3 private /24 subnets",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			public, private, err := DefaultSubnetCIDRs(tc.inVPCCIDR, tc.inAZCount)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPublic, public)
+			require.Equal(t, tc.wantedPrivate, private)
+		})
+	}
+}
@@ -6,6 +6,8 @@ package stack
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -18,6 +20,7 @@ import (
 const (
 	lbWebSvcRulePriorityGeneratorPath = "custom-resources/alb-rule-priority-generator.js"
 	desiredCountGeneratorPath         = "custom-resources/desired-count-delegation.js"
+	backlogPerTaskCalculatorPath      = "custom-resources/backlog-per-task-calculator.js"
 )
 
 // Parameter logical IDs for a load balanced web service.
@@ -25,12 +28,45 @@ const (
 	LBWebServiceHTTPSParamKey           = "HTTPSEnabled"
 	LBWebServiceContainerPortParamKey   = "ContainerPort"
 	LBWebServiceRulePathParamKey        = "RulePath"
-	LBWebServiceHealthCheckPathParamKey = "HealthCheckPath"
 	LBWebServiceTargetContainerParamKey = "TargetContainer"
 	LBWebServiceTargetPortParamKey      = "TargetPort"
 	LBWebServiceStickinessParamKey      = "Stickiness"
+
+	// Parameters of the target group's health check.
+	LBWebServiceHealthCheckPathParamKey               = "HealthCheckPath"
+	LBWebServiceHealthCheckSuccessCodesParamKey       = "HealthCheckSuccessCodes"
+	LBWebServiceHealthCheckHealthyThresholdParamKey   = "HealthCheckHealthyThreshold"
+	LBWebServiceHealthCheckUnhealthyThresholdParamKey = "HealthCheckUnhealthyThreshold"
+	LBWebServiceHealthCheckIntervalParamKey           = "HealthCheckInterval"
+	LBWebServiceHealthCheckTimeoutParamKey            = "HealthCheckTimeout"
+	LBWebServiceHealthCheckGracePeriodParamKey        = "HealthCheckGracePeriod"
+
+	LBWebServiceDeregistrationDelayParamKey = "DeregistrationDelay"
+	LBWebServiceProtocolVersionParamKey     = "ProtocolVersion"
+)
+
+// Default values of the target group of a load balanced web service.
+const (
+	defaultHealthCheckHealthyThreshold   = 2
+	defaultHealthCheckUnhealthyThreshold = 2
+	defaultHealthCheckInterval           = 10 * time.Second
+	defaultHealthCheckTimeout            = 5 * time.Second
+	defaultHealthCheckGracePeriod        = 60 * time.Second
+	defaultDeregistrationDelay           = 60 * time.Second
+
+	defaultHTTPSuccessCodes = "200"
+	defaultGRPCSuccessCodes = "12" // Same default as Elastic Load Balancing for gRPC health checks.
 )
 
+// Protocol versions of the requests sent to the tasks by the load balancer.
+const (
+	protocolVersionHTTP1 = "HTTP1"
+	protocolVersionHTTP2 = "HTTP2"
+	protocolVersionGRPC  = "GRPC"
+)
+
+var validProtocolVersions = []string{protocolVersionHTTP1, protocolVersionHTTP2, protocolVersionGRPC}
+
 type loadBalancedWebSvcReadParser interface {
 	template.ReadParser
 	ParseLoadBalancedWebService(template.WorkloadOpts) (*template.Content, error)
@@ -39,8 +75,9 @@ type loadBalancedWebSvcReadParser interface {
 // LoadBalancedWebService represents the configuration needed to create a CloudFormation stack from a load balanced web service manifest.
 type LoadBalancedWebService struct {
 	*wkld
-	manifest     *manifest.LoadBalancedWebService
-	httpsEnabled bool
+	manifest          *manifest.LoadBalancedWebService
+	httpsEnabled      bool
+	importedCertsOnly bool
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -65,6 +102,8 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 			rc:     rc,
 			parser: parser,
 			addons: addons,
+
+			logging: envManifest.Logging,
 		},
 		manifest:     envManifest,
 		httpsEnabled: false,
@@ -85,6 +124,18 @@ func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, a
 	return webSvc, nil
 }
 
+// NewHTTPSLoadBalancedWebServiceWithImportedCerts creates a new LoadBalancedWebService stack from its manifest for an
+// environment whose HTTPS listener uses imported certificates, in an application without a domain.
+// Since the environment has no hosted zone, requests are routed by path and aliases instead of the service's subdomain.
+func NewHTTPSLoadBalancedWebServiceWithImportedCerts(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig) (*LoadBalancedWebService, error) {
+	webSvc, err := NewHTTPSLoadBalancedWebService(mft, env, app, rc)
+	if err != nil {
+		return nil, err
+	}
+	webSvc.importedCertsOnly = true
+	return webSvc, nil
+}
+
 // Template returns the CloudFormation template for the service parametrized for the environment.
 func (s *LoadBalancedWebService) Template() (string, error) {
 	rulePriorityLambda, err := s.parser.Read(lbWebSvcRulePriorityGeneratorPath)
@@ -107,15 +158,38 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
 	}
+	capacityProviders, err := s.manifest.Count.CapacityProviders()
+	if err != nil {
+		return "", fmt.Errorf("convert the capacity providers of service %s: %w", s.name, err)
+	}
+	publishers, err := s.manifest.Publish.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the publish configuration for service %s: %w", s.name, err)
+	}
+	network, err := s.manifest.Network.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the network configuration for service %s: %w", s.name, err)
+	}
+	listenerRule, additionalRules, err := s.manifest.RoutingRule.ListenerRulesOpts()
+	if err != nil {
+		return "", fmt.Errorf("convert the http configuration for service %s: %w", s.name, err)
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
-		Variables:          s.manifest.Variables,
-		Secrets:            s.manifest.Secrets,
-		NestedStack:        outputs,
-		Sidecars:           sidecars,
-		LogConfig:          s.manifest.LogConfigOpts(),
-		Autoscaling:        autoscaling,
-		RulePriorityLambda: rulePriorityLambda.String(),
-		DesiredCountLambda: desiredCountLambda.String(),
+		Variables:               s.manifest.Variables,
+		Secrets:                 s.manifest.Secrets,
+		NestedStack:             outputs,
+		Sidecars:                sidecars,
+		LogConfig:               s.manifest.LogConfigOpts(),
+		Autoscaling:             autoscaling,
+		CapacityProviders:       capacityProviders,
+		RulePriorityLambda:      rulePriorityLambda.String(),
+		DesiredCountLambda:      desiredCountLambda.String(),
+		EnableExec:              aws.BoolValue(s.manifest.Exec),
+		Publish:                 publishers,
+		Network:                 network,
+		ListenerRule:            listenerRule,
+		AdditionalListenerRules: additionalRules,
+		ImportedCertsOnly:       s.importedCertsOnly,
 	})
 	if err != nil {
 		return "", err
@@ -123,51 +197,99 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	return content.String(), nil
 }
 
-func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
-	containerName := s.name
-	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)
-	// Route load balancer traffic to main container by default.
-	targetContainer = aws.String(containerName)
-	targetPort = aws.String(containerPort)
-	mftTargetContainer := s.manifest.TargetContainer
-	if mftTargetContainer != nil {
-		sidecar, ok := s.manifest.Sidecars[*mftTargetContainer]
-		if ok {
-			targetContainer = mftTargetContainer
-			targetPort = sidecar.Port
-		} else {
-			return nil, nil, fmt.Errorf("target container %s doesn't exist", *s.manifest.TargetContainer)
-		}
-	}
-	return
-}
-
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, error) {
 	svcParams, err := s.wkld.Parameters()
 	if err != nil {
 		return nil, err
 	}
-	targetContainer, targetPort, err := s.loadBalancerTarget()
+	ruleParams, err := routingRuleParams(s.name, s.manifest.RoutingRule, s.manifest.Image.Port, s.manifest.Sidecars, s.httpsEnabled)
 	if err != nil {
 		return nil, err
 	}
+	svcParams = append(svcParams, ruleParams...)
 	return append(svcParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceContainerPortParamKey),
 			ParameterValue: aws.String(strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.Image.Port)), 10)),
 		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+			ParameterValue: aws.String(strconv.FormatBool(s.httpsEnabled)),
+		},
+	}...), nil
+}
+
+// routingRuleParams returns the parameters of the listener rule and target group that route requests
+// from a load balancer to the container of a service.
+func routingRuleParams(svcName string, rule manifest.RoutingRule, port *uint16, sidecars map[string]*manifest.SidecarConfig, httpsEnabled bool) ([]*cloudformation.Parameter, error) {
+	targetContainer, targetPort, err := loadBalancerTarget(svcName, rule.TargetContainer, port, sidecars)
+	if err != nil {
+		return nil, err
+	}
+	protocolVersion, err := protocolVersion(rule.ProtocolVersion)
+	if err != nil {
+		return nil, err
+	}
+	if protocolVersion != protocolVersionHTTP1 && !httpsEnabled {
+		return nil, fmt.Errorf(`"http.protocol_version" %s requires an environment with an HTTPS listener`, protocolVersion)
+	}
+	hc := rule.HealthCheck.HealthCheckArgs
+	successCodes := defaultHTTPSuccessCodes
+	if protocolVersion == protocolVersionGRPC {
+		successCodes = defaultGRPCSuccessCodes
+	}
+	if hc.SuccessCodes != nil {
+		successCodes = aws.StringValue(hc.SuccessCodes)
+	}
+	healthyThreshold := int64(defaultHealthCheckHealthyThreshold)
+	if hc.HealthyThreshold != nil {
+		healthyThreshold = aws.Int64Value(hc.HealthyThreshold)
+	}
+	unhealthyThreshold := int64(defaultHealthCheckUnhealthyThreshold)
+	if hc.UnhealthyThreshold != nil {
+		unhealthyThreshold = aws.Int64Value(hc.UnhealthyThreshold)
+	}
+	return []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
-			ParameterValue: s.manifest.Path,
+			ParameterValue: rule.Path,
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceHealthCheckPathParamKey),
-			ParameterValue: s.manifest.HealthCheckPath,
+			ParameterValue: aws.String(rule.HealthCheck.Path()),
 		},
 		{
-			ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
-			ParameterValue: aws.String(strconv.FormatBool(s.httpsEnabled)),
+			ParameterKey:   aws.String(LBWebServiceHealthCheckSuccessCodesParamKey),
+			ParameterValue: aws.String(successCodes),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckHealthyThresholdParamKey),
+			ParameterValue: aws.String(strconv.FormatInt(healthyThreshold, 10)),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckUnhealthyThresholdParamKey),
+			ParameterValue: aws.String(strconv.FormatInt(unhealthyThreshold, 10)),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckIntervalParamKey),
+			ParameterValue: durationSecondsOrDefault(hc.Interval, defaultHealthCheckInterval),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckTimeoutParamKey),
+			ParameterValue: durationSecondsOrDefault(hc.Timeout, defaultHealthCheckTimeout),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckGracePeriodParamKey),
+			ParameterValue: durationSecondsOrDefault(hc.GracePeriod, defaultHealthCheckGracePeriod),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceDeregistrationDelayParamKey),
+			ParameterValue: durationSecondsOrDefault(rule.DeregistrationDelay, defaultDeregistrationDelay),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceProtocolVersionParamKey),
+			ParameterValue: aws.String(protocolVersion),
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
@@ -179,9 +301,47 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
-			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(s.manifest.Stickiness))),
+			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(rule.Stickiness))),
 		},
-	}...), nil
+	}, nil
+}
+
+// loadBalancerTarget returns the container and port that the load balancer routes traffic to.
+// Traffic is routed to the main container by default.
+func loadBalancerTarget(svcName string, mftTargetContainer *string, port *uint16, sidecars map[string]*manifest.SidecarConfig) (targetContainer *string, targetPort *string, err error) {
+	targetContainer = aws.String(svcName)
+	targetPort = aws.String(strconv.FormatUint(uint64(aws.Uint16Value(port)), 10))
+	if mftTargetContainer != nil {
+		sidecar, ok := sidecars[*mftTargetContainer]
+		if ok {
+			targetContainer = mftTargetContainer
+			targetPort = sidecar.Port
+		} else {
+			return nil, nil, fmt.Errorf("target container %s doesn't exist", *mftTargetContainer)
+		}
+	}
+	return
+}
+
+// protocolVersion returns the upper-cased protocol version of the target group, HTTP1 by default.
+func protocolVersion(mftVersion *string) (string, error) {
+	if mftVersion == nil {
+		return protocolVersionHTTP1, nil
+	}
+	version := strings.ToUpper(aws.StringValue(mftVersion))
+	for _, valid := range validProtocolVersions {
+		if version == valid {
+			return version, nil
+		}
+	}
+	return "", fmt.Errorf(`"http.protocol_version" must be one of %s, got "%s"`, strings.Join(validProtocolVersions, ", "), aws.StringValue(mftVersion))
+}
+
+func durationSecondsOrDefault(d *time.Duration, defaultValue time.Duration) *string {
+	if d == nil {
+		d = &defaultValue
+	}
+	return aws.String(strconv.Itoa(int(d.Seconds())))
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -156,6 +157,10 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
 					RulePriorityLambda: "lambda",
 					DesiredCountLambda: "something",
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 
 				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
@@ -165,6 +170,29 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 
 			wantedTemplate: "template",
 		},
+		"render template for an environment with imported certificates": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
+				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
+				m.EXPECT().Read(lbWebSvcRulePriorityGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("lambda")}, nil)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseLoadBalancedWebService(template.WorkloadOpts{
+					RulePriorityLambda: "lambda",
+					DesiredCountLambda: "something",
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
+					ImportedCertsOnly: true,
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+
+				c.parser = m
+				c.wkld.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+				c.httpsEnabled = true
+				c.importedCertsOnly = true
+			},
+
+			wantedTemplate: "template",
+		},
 		"render template with addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, c *LoadBalancedWebService) {
 				m := mocks.NewMockloadBalancedWebSvcReadParser(ctrl)
@@ -179,6 +207,10 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 					},
 					RulePriorityLambda: "lambda",
 					DesiredCountLambda: "something",
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
 				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
 					tpl: `Resources:
@@ -299,7 +331,29 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		Port: 80,
 	})
 	testLBWebServiceManifestWithBadSidecar.TargetContainer = aws.String("xray")
-	expectedParams := []*cloudformation.Parameter{
+	interval, timeout, gracePeriod, deregistrationDelay := 15*time.Second, 10*time.Second, 2*time.Minute, 30*time.Second
+	testLBWebServiceManifestWithHealthCheck := manifest.NewLoadBalancedWebService(baseProps)
+	testLBWebServiceManifestWithHealthCheck.Count = manifest.Count{
+		Value: aws.Int(1),
+		Autoscaling: manifest.Autoscaling{
+			Range: manifest.Range("2-100"),
+		},
+	}
+	testLBWebServiceManifestWithHealthCheck.HealthCheck = manifest.HealthCheckArgsOrString{
+		HealthCheckArgs: manifest.HTTPHealthCheckArgs{
+			Path:               aws.String("/grpc.health.v1.Health/Check"),
+			HealthyThreshold:   aws.Int64(3),
+			UnhealthyThreshold: aws.Int64(5),
+			Interval:           &interval,
+			Timeout:            &timeout,
+			GracePeriod:        &gracePeriod,
+		},
+	}
+	testLBWebServiceManifestWithHealthCheck.DeregistrationDelay = &deregistrationDelay
+	testLBWebServiceManifestWithHealthCheck.ProtocolVersion = aws.String("grpc")
+	testLBWebServiceManifestWithBadProtocolVersion := manifest.NewLoadBalancedWebService(baseProps)
+	testLBWebServiceManifestWithBadProtocolVersion.ProtocolVersion = aws.String("HTTP3")
+	baseParams := []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadAppNameParamKey),
 			ParameterValue: aws.String("phonetool"),
@@ -324,10 +378,6 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 			ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
 			ParameterValue: aws.String("frontend"),
 		},
-		{
-			ParameterKey:   aws.String(LBWebServiceHealthCheckPathParamKey),
-			ParameterValue: aws.String("/"),
-		},
 		{
 			ParameterKey:   aws.String(WorkloadTaskCPUParamKey),
 			ParameterValue: aws.String("256"),
@@ -349,6 +399,44 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 			ParameterValue: aws.String(""),
 		},
 	}
+	expectedParams := append(baseParams[:len(baseParams):len(baseParams)], []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckPathParamKey),
+			ParameterValue: aws.String("/"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckSuccessCodesParamKey),
+			ParameterValue: aws.String("200"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckHealthyThresholdParamKey),
+			ParameterValue: aws.String("2"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckUnhealthyThresholdParamKey),
+			ParameterValue: aws.String("2"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckIntervalParamKey),
+			ParameterValue: aws.String("10"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckTimeoutParamKey),
+			ParameterValue: aws.String("5"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceHealthCheckGracePeriodParamKey),
+			ParameterValue: aws.String("60"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceDeregistrationDelayParamKey),
+			ParameterValue: aws.String("60"),
+		},
+		{
+			ParameterKey:   aws.String(LBWebServiceProtocolVersionParamKey),
+			ParameterValue: aws.String("HTTP1"),
+		},
+	}...)
 	testCases := map[string]struct {
 		httpsEnabled bool
 		manifest     *manifest.LoadBalancedWebService
@@ -448,6 +536,77 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 				},
 			}...),
 		},
+		"with custom health check and gRPC targets": {
+			httpsEnabled: true,
+			manifest:     testLBWebServiceManifestWithHealthCheck,
+
+			expectedParams: append(baseParams, []*cloudformation.Parameter{
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckPathParamKey),
+					ParameterValue: aws.String("/grpc.health.v1.Health/Check"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckSuccessCodesParamKey),
+					ParameterValue: aws.String("12"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckHealthyThresholdParamKey),
+					ParameterValue: aws.String("3"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckUnhealthyThresholdParamKey),
+					ParameterValue: aws.String("5"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckIntervalParamKey),
+					ParameterValue: aws.String("15"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckTimeoutParamKey),
+					ParameterValue: aws.String("10"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHealthCheckGracePeriodParamKey),
+					ParameterValue: aws.String("120"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceDeregistrationDelayParamKey),
+					ParameterValue: aws.String("30"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceProtocolVersionParamKey),
+					ParameterValue: aws.String("GRPC"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
+					ParameterValue: aws.String("true"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetContainerParamKey),
+					ParameterValue: aws.String("frontend"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceTargetPortParamKey),
+					ParameterValue: aws.String("80"),
+				},
+				{
+					ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
+					ParameterValue: aws.String("false"),
+				},
+			}...),
+		},
+		"with gRPC targets without HTTPS": {
+			httpsEnabled: false,
+			manifest:     testLBWebServiceManifestWithHealthCheck,
+
+			expectedErr: fmt.Errorf(`"http.protocol_version" GRPC requires an environment with an HTTPS listener`),
+		},
+		"with bad protocol version": {
+			httpsEnabled: true,
+			manifest:     testLBWebServiceManifestWithBadProtocolVersion,
+
+			expectedErr: fmt.Errorf(`"http.protocol_version" must be one of HTTP1, HTTP2, GRPC, got "HTTP3"`),
+		},
 		"with bad sidecar container": {
 			httpsEnabled: true,
 			manifest:     testLBWebServiceManifestWithBadSidecar,
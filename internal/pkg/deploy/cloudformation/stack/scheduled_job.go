@@ -6,11 +6,13 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -30,6 +32,11 @@ type ScheduledJob struct {
 	parser scheduledJobParser
 }
 
+// Parameter logical IDs for a scheduled job.
+const (
+	ScheduledJobScheduleParamKey = "Schedule"
+)
+
 var (
 	fmtRateScheduleExpression = "rate(%d %s)" // rate({duration} {units})
 	fmtCronScheduleExpression = "cron(%s)"
@@ -82,6 +89,8 @@ func NewScheduledJob(mft *manifest.ScheduledJob, env, app string, rc RuntimeConf
 			rc:     rc,
 			parser: parser,
 			addons: addons,
+
+			logging: envManifest.ScheduledJobConfig.Logging,
 		},
 		manifest: envManifest,
 
@@ -111,6 +120,11 @@ func (j *ScheduledJob) Template() (string, error) {
 		return "", fmt.Errorf("convert retry/timeout config for job %s: %w", j.name, err)
 	}
 
+	network, err := j.manifest.Network.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the network configuration for job %s: %w", j.name, err)
+	}
+
 	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
 		Variables:          j.manifest.Variables,
 		Secrets:            j.manifest.Secrets,
@@ -119,6 +133,7 @@ func (j *ScheduledJob) Template() (string, error) {
 		ScheduleExpression: schedule,
 		StateMachine:       stateMachine,
 		LogConfig:          j.manifest.LogConfigOpts(),
+		Network:            network,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse scheduled job template: %w", err)
@@ -126,6 +141,58 @@ func (j *ScheduledJob) Template() (string, error) {
 	return content.String(), nil
 }
 
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (j *ScheduledJob) Parameters() ([]*cloudformation.Parameter, error) {
+	schedule, err := j.awsSchedule()
+	if err != nil {
+		return nil, fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+	}
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(WorkloadAppNameParamKey),
+			ParameterValue: aws.String(j.app),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadEnvNameParamKey),
+			ParameterValue: aws.String(j.env),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadNameParamKey),
+			ParameterValue: aws.String(j.name),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+			ParameterValue: aws.String(fmt.Sprintf("%s:%s", j.rc.ImageRepoURL, j.rc.ImageTag)),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskCPUParamKey),
+			ParameterValue: aws.String(strconv.Itoa(aws.IntValue(j.tc.CPU))),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskMemoryParamKey),
+			ParameterValue: aws.String(strconv.Itoa(aws.IntValue(j.tc.Memory))),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
+			ParameterValue: aws.String(strconv.Itoa(j.logging.LogRetention())),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadAddonsTemplateURLParamKey),
+			ParameterValue: aws.String(j.rc.AddonsTemplateURL),
+		},
+		{
+			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
+			ParameterValue: aws.String(schedule),
+		},
+	}, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (j *ScheduledJob) SerializedParameters() (string, error) {
+	return j.wkld.templateConfiguration(j)
+}
+
 // awsSchedule converts the Schedule string to the format required by Cloudwatch Events
 // https://docs.aws.amazon.com/lambda/latest/dg/services-cloudwatchevents-expressions.html
 // Cron expressions must have an sixth "year" field, and must contain at least one ? (either-or)
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -52,6 +53,10 @@ func TestScheduledJob_Template(t *testing.T) {
 						Timeout: aws.Int(5400),
 						Retries: aws.Int(3),
 					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
 				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{err: &addon.ErrDirNotExist{}}
 				j.parser = m
@@ -74,6 +79,10 @@ func TestScheduledJob_Template(t *testing.T) {
 						Timeout: aws.Int(5400),
 						Retries: aws.Int(3),
 					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.EnablePublicIP,
+						SubnetsType:    template.PublicSubnetsPlacement,
+					},
 				})).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
 				addons := mockTemplater{
 					tpl: `Resources:
@@ -160,6 +169,70 @@ Outputs:
 	}
 }
 
+func TestScheduledJob_Parameters(t *testing.T) {
+	// GIVEN
+	conf := &ScheduledJob{
+		wkld: &wkld{
+			name: aws.StringValue(testScheduledJobManifest.Name),
+			env:  testJobEnvName,
+			app:  testJobAppName,
+			tc:   testScheduledJobManifest.TaskConfig,
+			rc: RuntimeConfig{
+				ImageRepoURL: testJobImageRepoURL,
+				ImageTag:     testJobImageTag,
+			},
+			logging: &manifest.Logging{
+				Retention: aws.Int(7),
+			},
+		},
+		manifest: testScheduledJobManifest,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(WorkloadAppNameParamKey),
+			ParameterValue: aws.String("cuteoverload"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadEnvNameParamKey),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadNameParamKey),
+			ParameterValue: aws.String("mailer"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+			ParameterValue: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/cuteoverload/mailer:stable"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskCPUParamKey),
+			ParameterValue: aws.String("256"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskMemoryParamKey),
+			ParameterValue: aws.String("512"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
+			ParameterValue: aws.String("7"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadAddonsTemplateURLParamKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
+			ParameterValue: aws.String("cron(0 0 * * ? *)"),
+		},
+	}, params)
+}
+
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule  string
@@ -5,12 +5,15 @@ package stack
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 )
 
@@ -25,7 +28,6 @@ const (
 	taskContainerImageParamKey = "ContainerImage"
 	taskTaskRoleParamKey       = "TaskRole"
 	taskExecutionRoleParamKey  = "ExecutionRole"
-	taskCommandParamKey        = "Command"
 
 	taskLogRetentionInDays = "1"
 )
@@ -40,7 +42,7 @@ type taskStackConfig struct {
 func NewTaskStackConfig(taskOpts *deploy.CreateTaskResourcesInput) *taskStackConfig {
 	return &taskStackConfig{
 		CreateTaskResourcesInput: taskOpts,
-		parser: template.New(),
+		parser:                   template.New(),
 	}
 }
 
@@ -49,19 +51,69 @@ func (t *taskStackConfig) StackName() string {
 	return NameForTask(t.Name)
 }
 
+// taskSecretsResources holds the resources that the default execution role of the task reads secrets from.
+type taskSecretsResources struct {
+	SSMParameterNames []string // Names of SSM parameters in the region and account of the task.
+	SSMParameterARNs  []string
+	SecretARNs        []string // ARNs of Secrets Manager secrets.
+}
+
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
-	content, err := t.parser.Parse(taskTemplatePath, struct{
-		EnvVars map[string]string
+	secrets, err := t.secretsResources()
+	if err != nil {
+		return "", err
+	}
+	content, err := t.parser.Parse(taskTemplatePath, struct {
+		EnvVars          map[string]string
+		Secrets          map[string]string
+		SecretsResources taskSecretsResources
+		Command          []string
+		EntryPoint       []string
 	}{
-		EnvVars: t.EnvVars,
-	})
+		EnvVars:          t.EnvVars,
+		Secrets:          t.Secrets,
+		SecretsResources: secrets,
+		Command:          t.Command,
+		EntryPoint:       t.EntryPoint,
+	}, template.WithFuncs(map[string]interface{}{
+		"fmtSlice":   template.FmtSliceFunc,
+		"quoteSlice": template.QuoteSliceFunc,
+	}))
 	if err != nil {
 		return "", fmt.Errorf("read template for task stack: %w", err)
 	}
 	return content.String(), nil
 }
 
+// secretsResources groups the secrets of the task by the service that stores them.
+func (t *taskStackConfig) secretsResources() (taskSecretsResources, error) {
+	var resources taskSecretsResources
+	for name, valueFrom := range t.Secrets {
+		if !arn.IsARN(valueFrom) {
+			resources.SSMParameterNames = append(resources.SSMParameterNames, strings.TrimPrefix(valueFrom, "/"))
+			continue
+		}
+		parsed, err := arn.Parse(valueFrom)
+		if err != nil {
+			return taskSecretsResources{}, fmt.Errorf("parse ARN %s of secret %s: %w", valueFrom, name, err)
+		}
+		switch parts := strings.SplitN(valueFrom, ":", 8); {
+		case parsed.Service == "ssm":
+			resources.SSMParameterARNs = append(resources.SSMParameterARNs, valueFrom)
+		case parsed.Service == "secretsmanager" && len(parts) >= 7:
+			// Remove the optional JSON key, version stage and version ID that follow the secret ARN.
+			resources.SecretARNs = append(resources.SecretARNs, strings.Join(parts[:7], ":"))
+		default:
+			return taskSecretsResources{}, fmt.Errorf("secret %s must be the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret, got %s", name, valueFrom)
+		}
+	}
+	sort.Strings(resources.SSMParameterNames)
+	sort.Strings(resources.SSMParameterARNs)
+	sort.Strings(resources.SecretARNs)
+	return resources, nil
+}
+
 // Parameters returns the parameter values to be passed to the task CloudFormation template.
 func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
@@ -90,13 +142,9 @@ func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterValue: aws.String(t.TaskRole),
 		},
 		{
-			ParameterKey: aws.String(taskExecutionRoleParamKey),
+			ParameterKey:   aws.String(taskExecutionRoleParamKey),
 			ParameterValue: aws.String(t.ExecutionRole),
 		},
-		{
-			ParameterKey:   aws.String(taskCommandParamKey),
-			ParameterValue: aws.String(t.Command),
-		},
 	}, nil
 }
 
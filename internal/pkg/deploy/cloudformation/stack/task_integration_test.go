@@ -0,0 +1,78 @@
+// +build integration
+
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack_test
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestTask_Template ensures that the container definition and the execution role of a task reference its secrets.
+func TestTask_Template(t *testing.T) {
+	taskInput := deploy.CreateTaskResourcesInput{
+		Name:       "my-task",
+		Image:      "7456.dkr.ecr.us-east-2.amazonaws.com/my-task:0.1",
+		EntryPoint: []string{"/bin/sh", "-c"},
+		Command:    []string{"psql \"$DB_URL\" -f migrate.sql"},
+		Secrets: map[string]string{
+			"DB_URL":      "/my-app/test/db-url",
+			"DB_PASSWORD": "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf:password::",
+			"API_KEY":     "arn:aws:ssm:us-east-1:123456789012:parameter/api-key",
+		},
+	}
+	task := stack.NewTaskStackConfig(&taskInput)
+
+	tpl, err := task.Template()
+	require.NoError(t, err)
+
+	var got struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []struct {
+						EntryPoint []string `yaml:"EntryPoint"`
+						Command    []string `yaml:"Command"`
+						Secrets    []struct {
+							Name      string `yaml:"Name"`
+							ValueFrom string `yaml:"ValueFrom"`
+						} `yaml:"Secrets"`
+					} `yaml:"ContainerDefinitions"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+			DefaultExecutionRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyDocument struct {
+							Statement []struct {
+								Action   []string    `yaml:"Action"`
+								Resource []yaml.Node `yaml:"Resource"`
+							} `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"DefaultExecutionRole"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &got))
+
+	container := got.Resources.TaskDefinition.Properties.ContainerDefinitions[0]
+	require.Equal(t, []string{"/bin/sh", "-c"}, container.EntryPoint)
+	require.Equal(t, []string{`psql "$DB_URL" -f migrate.sql`}, container.Command)
+	require.Len(t, container.Secrets, 3)
+
+	statements := got.Resources.DefaultExecutionRole.Properties.Policies[0].PolicyDocument.Statement
+	require.Len(t, statements, 3)
+	require.Equal(t, []string{"ssm:GetParameters"}, statements[0].Action)
+	require.Equal(t, "arn:aws:ssm:${AWS::Region}:${AWS::AccountId}:parameter/my-app/test/db-url", statements[0].Resource[0].Value)
+	require.Equal(t, "arn:aws:ssm:us-east-1:123456789012:parameter/api-key", statements[0].Resource[1].Value)
+	require.Equal(t, []string{"secretsmanager:GetSecretValue"}, statements[1].Action)
+	require.Equal(t, "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf", statements[1].Resource[0].Value)
+	require.Equal(t, []string{"kms:Decrypt"}, statements[2].Action)
+}
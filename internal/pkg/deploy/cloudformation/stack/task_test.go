@@ -25,20 +25,27 @@ const (
 
 func TestTaskStackConfig_Template(t *testing.T) {
 	testCases := map[string]struct {
+		inSecrets      map[string]string
 		mockReadParser func(m *mocks.MockReadParser)
 
 		wantedTemplate string
 		wantedError    error
 	}{
+		"should return error if a secret is not stored in SSM or Secrets Manager": {
+			inSecrets: map[string]string{
+				"DB_PASSWORD": "arn:aws:s3:::my-bucket/password",
+			},
+			wantedError: errors.New("secret DB_PASSWORD must be the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret, got arn:aws:s3:::my-bucket/password"),
+		},
 		"should return error if unable to read": {
 			mockReadParser: func(m *mocks.MockReadParser) {
-				m.EXPECT().Parse(taskTemplatePath, gomock.Any()).Return(nil, errors.New("error reading template"))
+				m.EXPECT().Parse(taskTemplatePath, gomock.Any(), gomock.Any()).Return(nil, errors.New("error reading template"))
 			},
 			wantedError: errors.New("read template for task stack: error reading template"),
 		},
 		"should return template body when present": {
 			mockReadParser: func(m *mocks.MockReadParser) {
-				m.EXPECT().Parse(taskTemplatePath, gomock.Any()).Return(&template.Content{
+				m.EXPECT().Parse(taskTemplatePath, gomock.Any(), gomock.Any()).Return(&template.Content{
 					Buffer: bytes.NewBufferString("This is the task template"),
 				}, nil)
 			},
@@ -56,7 +63,9 @@ func TestTaskStackConfig_Template(t *testing.T) {
 				tc.mockReadParser(mockReadParser)
 			}
 
-			taskInput := deploy.CreateTaskResourcesInput{}
+			taskInput := deploy.CreateTaskResourcesInput{
+				Secrets: tc.inSecrets,
+			}
 
 			taskStackConfig := &taskStackConfig{
 				CreateTaskResourcesInput: &taskInput,
@@ -104,10 +113,6 @@ func TestTaskStackConfig_Parameters(t *testing.T) {
 			ParameterKey:   aws.String(taskExecutionRoleParamKey),
 			ParameterValue: aws.String("execution-role"),
 		},
-		{
-			ParameterKey:   aws.String(taskCommandParamKey),
-			ParameterValue: aws.String("echo hooray"),
-		},
 	}
 
 	taskInput := deploy.CreateTaskResourcesInput{
@@ -118,7 +123,7 @@ func TestTaskStackConfig_Parameters(t *testing.T) {
 		Image:         "7456.dkr.ecr.us-east-2.amazonaws.com/my-task:0.1",
 		TaskRole:      "task-role",
 		ExecutionRole: "execution-role",
-		Command:       "echo hooray",
+		Command:       []string{"echo", "hooray"},
 	}
 
 	task := &taskStackConfig{
@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+type workerSvcReadParser interface {
+	template.ReadParser
+	ParseWorkerService(template.WorkloadOpts) (*template.Content, error)
+}
+
+// WorkerService represents the configuration needed to create a CloudFormation stack from a worker service manifest.
+type WorkerService struct {
+	*wkld
+	manifest *manifest.WorkerService
+
+	parser workerSvcReadParser
+}
+
+// NewWorkerService creates a new WorkerService stack from a manifest file.
+func NewWorkerService(mft *manifest.WorkerService, env, app string, rc RuntimeConfig) (*WorkerService, error) {
+	parser := template.New()
+	addons, err := addon.New(aws.StringValue(mft.Name))
+	if err != nil {
+		return nil, fmt.Errorf("new addons: %w", err)
+	}
+	envManifest, err := mft.ApplyEnv(env) // Apply environment overrides to the manifest values.
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", env, err)
+	}
+	return &WorkerService{
+		wkld: &wkld{
+			name:   aws.StringValue(mft.Name),
+			env:    env,
+			app:    app,
+			tc:     envManifest.WorkerServiceConfig.TaskConfig,
+			rc:     rc,
+			parser: parser,
+			addons: addons,
+
+			logging: envManifest.WorkerServiceConfig.Logging,
+		},
+		manifest: envManifest,
+
+		parser: parser,
+	}, nil
+}
+
+// Template returns the CloudFormation template for the worker service.
+func (s *WorkerService) Template() (string, error) {
+	desiredCountLambda, err := s.parser.Read(desiredCountGeneratorPath)
+	if err != nil {
+		return "", fmt.Errorf("read desired count lambda: %w", err)
+	}
+	backlogPerTaskLambda, err := s.parser.Read(backlogPerTaskCalculatorPath)
+	if err != nil {
+		return "", fmt.Errorf("read backlog per task calculator lambda: %w", err)
+	}
+	outputs, err := s.addonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := s.manifest.Sidecar.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
+	}
+	autoscaling, err := s.manifest.Count.Autoscaling.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the Auto Scaling configuration for service %s: %w", s.name, err)
+	}
+	capacityProviders, err := s.manifest.Count.CapacityProviders()
+	if err != nil {
+		return "", fmt.Errorf("convert the capacity providers of service %s: %w", s.name, err)
+	}
+	publishers, err := s.manifest.Publish.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the publish configuration for service %s: %w", s.name, err)
+	}
+	network, err := s.manifest.Network.Options()
+	if err != nil {
+		return "", fmt.Errorf("convert the network configuration for service %s: %w", s.name, err)
+	}
+	content, err := s.parser.ParseWorkerService(template.WorkloadOpts{
+		Variables:            s.manifest.WorkerServiceConfig.Variables,
+		Secrets:              s.manifest.WorkerServiceConfig.Secrets,
+		NestedStack:          outputs,
+		Sidecars:             sidecars,
+		Autoscaling:          autoscaling,
+		CapacityProviders:    capacityProviders,
+		HealthCheck:          s.manifest.WorkerServiceConfig.Image.HealthCheckOpts(),
+		LogConfig:            s.manifest.LogConfigOpts(),
+		DesiredCountLambda:   desiredCountLambda.String(),
+		BacklogPerTaskLambda: backlogPerTaskLambda.String(),
+		EnableExec:           aws.BoolValue(s.manifest.WorkerServiceConfig.Exec),
+		Subscribe:            s.manifest.Subscribe.SubscribeOpts(),
+		Publish:              publishers,
+		Network:              network,
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse worker service template: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (s *WorkerService) Parameters() ([]*cloudformation.Parameter, error) {
+	return s.wkld.Parameters()
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (s *WorkerService) SerializedParameters() (string, error) {
+	return s.wkld.templateConfiguration(s)
+}
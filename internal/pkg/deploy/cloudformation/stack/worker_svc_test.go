@@ -0,0 +1,230 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkerSvcManifest = manifest.NewWorkerService(manifest.WorkerServiceProps{
+	WorkloadProps: manifest.WorkloadProps{
+		Name:       "processor",
+		Dockerfile: "./processor/Dockerfile",
+	},
+	Topics: []manifest.TopicSubscription{
+		{
+			Name:    aws.String("orders"),
+			Service: aws.String("api"),
+		},
+	},
+})
+
+func TestWorkerService_Template(t *testing.T) {
+	testWorkerSvcManifestWithBadAutoScaling := manifest.NewWorkerService(manifest.WorkerServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "processor",
+			Dockerfile: "./processor/Dockerfile",
+		},
+	})
+	testWorkerSvcManifestWithBadAutoScaling.Count.Autoscaling = manifest.Autoscaling{
+		Range: manifest.Range("badRange"),
+	}
+	testWorkerSvcManifestWithBadTopic := manifest.NewWorkerService(manifest.WorkerServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "processor",
+			Dockerfile: "./processor/Dockerfile",
+		},
+	})
+	testWorkerSvcManifestWithBadTopic.Publish.Topics = []manifest.Topic{
+		{Name: aws.String("orders.fifo")},
+	}
+	testWorkerSvcManifestWithBadPlacement := manifest.NewWorkerService(manifest.WorkerServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "processor",
+			Dockerfile: "./processor/Dockerfile",
+		},
+	})
+	testWorkerSvcManifestWithBadPlacement.Network.VPC.Placement = aws.String("isolated")
+	testPrivateWorkerSvcManifest := *testWorkerSvcManifest
+	testPrivateWorkerSvcManifest.Network.VPC.Placement = aws.String(manifest.PrivateSubnetPlacement)
+	testCases := map[string]struct {
+		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService)
+		manifest         *manifest.WorkerService
+		wantedTemplate   string
+		wantedErr        error
+	}{
+		"unavailable backlog per task lambda template": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(backlogPerTaskCalculatorPath).Return(nil, errors.New("some error"))
+				svc.parser = m
+			},
+			wantedErr: fmt.Errorf("read backlog per task calculator lambda: some error"),
+		},
+		"failed parsing Auto Scaling template": {
+			manifest: testWorkerSvcManifestWithBadAutoScaling,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil).Times(2)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("convert the Auto Scaling configuration for service processor: %w", errors.New("invalid range value badRange. Should be in format of ${min}-${max}")),
+		},
+		"failed parsing publish configuration": {
+			manifest: testWorkerSvcManifestWithBadTopic,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil).Times(2)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("convert the publish configuration for service processor: %w", errors.New(`topic name "orders.fifo" must contain only alphanumeric characters and hyphens`)),
+		},
+		"failed parsing network configuration": {
+			manifest: testWorkerSvcManifestWithBadPlacement,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil).Times(2)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedErr: fmt.Errorf("convert the network configuration for service processor: %w", errors.New(`field "network.vpc.placement" must be one of public, private, got "isolated"`)),
+		},
+		"render template": {
+			manifest: &testPrivateWorkerSvcManifest,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *WorkerService) {
+				m := mocks.NewMockworkerSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("desired count")}, nil)
+				m.EXPECT().Read(backlogPerTaskCalculatorPath).Return(&template.Content{Buffer: bytes.NewBufferString("backlog")}, nil)
+				m.EXPECT().ParseWorkerService(template.WorkloadOpts{
+					DesiredCountLambda:   "desired count",
+					BacklogPerTaskLambda: "backlog",
+					Subscribe: &template.SubscribeOpts{
+						Topics: []*template.TopicSubscription{
+							{
+								Name:    "orders",
+								Service: "api",
+							},
+						},
+						DeadLetterTries: aws.Int(10),
+					},
+					Network: &template.NetworkOpts{
+						AssignPublicIP: template.DisablePublicIP,
+						SubnetsType:    template.PrivateSubnetsPlacement,
+					},
+				}).Return(&template.Content{Buffer: bytes.NewBufferString("template")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrDirNotExist{}}
+			},
+			wantedTemplate: "template",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			conf := &WorkerService{
+				wkld: &wkld{
+					name: aws.StringValue(testWorkerSvcManifest.Name),
+					env:  testEnvName,
+					app:  testAppName,
+					rc: RuntimeConfig{
+						ImageRepoURL: testImageRepoURL,
+						ImageTag:     testImageTag,
+					},
+				},
+				manifest: tc.manifest,
+			}
+			tc.mockDependencies(t, ctrl, conf)
+
+			// WHEN
+			template, err := conf.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, template)
+			}
+		})
+	}
+}
+
+func TestWorkerService_Parameters(t *testing.T) {
+	// GIVEN
+	conf := &WorkerService{
+		wkld: &wkld{
+			name: aws.StringValue(testWorkerSvcManifest.Name),
+			env:  testEnvName,
+			app:  testAppName,
+			tc:   testWorkerSvcManifest.WorkerServiceConfig.TaskConfig,
+			rc: RuntimeConfig{
+				ImageRepoURL: testImageRepoURL,
+				ImageTag:     testImageTag,
+			},
+		},
+		manifest: testWorkerSvcManifest,
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(WorkloadAppNameParamKey),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadEnvNameParamKey),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadNameParamKey),
+			ParameterValue: aws.String("processor"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+			ParameterValue: aws.String("12345.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:manual-bf3678c"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskCPUParamKey),
+			ParameterValue: aws.String("256"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskMemoryParamKey),
+			ParameterValue: aws.String("512"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadTaskCountParamKey),
+			ParameterValue: aws.String("1"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
+			ParameterValue: aws.String("30"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadAddonsTemplateURLParamKey),
+			ParameterValue: aws.String(""),
+		},
+	}, params)
+}
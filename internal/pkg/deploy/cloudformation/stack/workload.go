@@ -56,6 +56,8 @@ type wkld struct {
 	tc   manifest.TaskConfig
 	rc   RuntimeConfig
 
+	logging *manifest.Logging
+
 	parser template.Parser
 	addons templater
 }
@@ -67,14 +69,9 @@ func (w *wkld) StackName() string {
 
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
-	desiredCount := w.tc.Count.Value
-	// If auto scaling is configured, override the desired count value.
-	if !w.tc.Count.Autoscaling.IsEmpty() {
-		min, _, err := w.tc.Count.Autoscaling.Range.Parse()
-		if err != nil {
-			return nil, fmt.Errorf("parse task count value %s: %w", string(w.tc.Count.Autoscaling.Range), err)
-		}
-		desiredCount = aws.Int(min)
+	desiredCount, err := w.tc.Count.Desired()
+	if err != nil {
+		return nil, err
 	}
 	return []*cloudformation.Parameter{
 		{
@@ -107,7 +104,7 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 		},
 		{
 			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
-			ParameterValue: aws.String("30"),
+			ParameterValue: aws.String(strconv.Itoa(w.logging.LogRetention())),
 		},
 		{
 			ParameterKey:   aws.String(WorkloadAddonsTemplateURLParamKey),
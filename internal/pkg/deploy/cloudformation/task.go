@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -48,3 +49,61 @@ func (cf CloudFormation) DeployTask(input *deploy.CreateTaskResourcesInput, opts
 
 	return nil
 }
+
+// ListTaskStacks returns the task groups that run in the environment of the application.
+func (cf CloudFormation) ListTaskStacks(appName, envName string) ([]deploy.TaskStackInfo, error) {
+	stacks, err := cf.cfnClient.ListStacksWithTags(map[string]string{
+		deploy.TaskTagKey: "",
+		deploy.AppTagKey:  appName,
+		deploy.EnvTagKey:  envName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toTaskStackInfos(stacks), nil
+}
+
+// ListDefaultTaskStacks returns the task groups that run in the default cluster.
+func (cf CloudFormation) ListDefaultTaskStacks() ([]deploy.TaskStackInfo, error) {
+	stacks, err := cf.cfnClient.ListStacksWithTags(map[string]string{
+		deploy.TaskTagKey: "",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var defaultStacks []cloudformation.StackDescription
+	for _, s := range stacks {
+		if _, ok := stackTags(s)[deploy.AppTagKey]; ok {
+			continue
+		}
+		defaultStacks = append(defaultStacks, s)
+	}
+	return toTaskStackInfos(defaultStacks), nil
+}
+
+// DeleteTask deletes the CloudFormation stack of a task group and waits until the deletion is done.
+func (cf CloudFormation) DeleteTask(name string) error {
+	return cf.cfnClient.DeleteAndWait(stack.NameForTask(name))
+}
+
+func toTaskStackInfos(stacks []cloudformation.StackDescription) []deploy.TaskStackInfo {
+	var infos []deploy.TaskStackInfo
+	for _, s := range stacks {
+		tags := stackTags(s)
+		infos = append(infos, deploy.TaskStackInfo{
+			Name:      tags[deploy.TaskTagKey],
+			StackName: aws.StringValue(s.StackName),
+			App:       tags[deploy.AppTagKey],
+			Env:       tags[deploy.EnvTagKey],
+		})
+	}
+	return infos
+}
+
+func stackTags(s cloudformation.StackDescription) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range s.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags
+}
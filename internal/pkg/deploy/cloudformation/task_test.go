@@ -7,6 +7,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 
 	"github.com/stretchr/testify/require"
@@ -81,3 +83,106 @@ func TestCloudFormation_DeployTask(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_ListTaskStacks(t *testing.T) {
+	mockStacks := []cloudformation.StackDescription{
+		{
+			StackName: aws.String("task-db-migrate"),
+			Tags: []*sdkcloudformation.Tag{
+				{Key: aws.String("copilot-task"), Value: aws.String("db-migrate")},
+				{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+				{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		mockCfnClient func(m *mocks.MockcfnClient)
+
+		wantedTasks []deploy.TaskStackInfo
+		wantedError error
+	}{
+		"returns error if failed to list stacks": {
+			mockCfnClient: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the task groups of the environment": {
+			mockCfnClient: func(m *mocks.MockcfnClient) {
+				m.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-task":        "",
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+				}).Return(mockStacks, nil)
+			},
+			wantedTasks: []deploy.TaskStackInfo{
+				{
+					Name:      "db-migrate",
+					StackName: "task-db-migrate",
+					App:       "phonetool",
+					Env:       "test",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCfnClient := mocks.NewMockcfnClient(ctrl)
+			tc.mockCfnClient(mockCfnClient)
+
+			cf := CloudFormation{
+				cfnClient: mockCfnClient,
+			}
+
+			tasks, err := cf.ListTaskStacks("phonetool", "test")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_ListDefaultTaskStacks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCfnClient := mocks.NewMockcfnClient(ctrl)
+	mockCfnClient.EXPECT().ListStacksWithTags(map[string]string{
+		"copilot-task": "",
+	}).Return([]cloudformation.StackDescription{
+		{
+			StackName: aws.String("task-db-migrate"),
+			Tags: []*sdkcloudformation.Tag{
+				{Key: aws.String("copilot-task"), Value: aws.String("db-migrate")},
+				{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+				{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+			},
+		},
+		{
+			StackName: aws.String("task-cleanup"),
+			Tags: []*sdkcloudformation.Tag{
+				{Key: aws.String("copilot-task"), Value: aws.String("cleanup")},
+			},
+		},
+	}, nil)
+	cf := CloudFormation{
+		cfnClient: mockCfnClient,
+	}
+
+	tasks, err := cf.ListDefaultTaskStacks()
+
+	require.NoError(t, err)
+	require.Equal(t, []deploy.TaskStackInfo{
+		{
+			Name:      "cleanup",
+			StackName: "task-cleanup",
+		},
+	}, tasks)
+}
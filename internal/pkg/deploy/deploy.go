@@ -24,7 +24,8 @@ const (
 )
 
 const (
-	ecsServiceResourceType = "ecs:service"
+	ecsServiceResourceType   = "ecs:service"
+	stateMachineResourceType = "states:stateMachine"
 )
 
 // Resource represents an AWS resource.
@@ -40,6 +41,13 @@ type ResourceEvent struct {
 	StatusReason string
 }
 
+// ResourceChange represents a change that a deployment will apply to an AWS resource.
+type ResourceChange struct {
+	Resource
+	Action      string // Either "Add", "Modify", or "Remove".
+	Replacement string // Either "True", "False", or "Conditional" if the resource is modified.
+}
+
 type resourceGetter interface {
 	GetResourcesByTags(resourceType string, tags map[string]string) ([]*rg.Resource, error)
 }
@@ -117,11 +125,11 @@ type result struct {
 	err  error
 }
 
-func (s *Store) deployedServices(rgClient resourceGetter, app, env, svc string) result {
-	resources, err := rgClient.GetResourcesByTags(ecsServiceResourceType, map[string]string{
+func (s *Store) deployedWorkloads(rgClient resourceGetter, resourceType, app, env, name string) result {
+	resources, err := rgClient.GetResourcesByTags(resourceType, map[string]string{
 		AppTagKey:     app,
 		EnvTagKey:     env,
-		ServiceTagKey: svc,
+		ServiceTagKey: name,
 	})
 	if err != nil {
 		return result{err: fmt.Errorf("get resources by Copilot tags: %w", err)}
@@ -136,6 +144,16 @@ func (s *Store) deployedServices(rgClient resourceGetter, app, env, svc string)
 
 // ListEnvironmentsDeployedTo returns all the environment that a service is deployed in.
 func (s *Store) ListEnvironmentsDeployedTo(appName string, svcName string) ([]string, error) {
+	return s.listEnvironmentsDeployedTo(ecsServiceResourceType, appName, svcName)
+}
+
+// ListEnvironmentsJobDeployedTo returns all the environments that a job is deployed in.
+// Jobs don't run as ECS services, so they are looked up by their Step Functions state machine instead.
+func (s *Store) ListEnvironmentsJobDeployedTo(appName string, jobName string) ([]string, error) {
+	return s.listEnvironmentsDeployedTo(stateMachineResourceType, appName, jobName)
+}
+
+func (s *Store) listEnvironmentsDeployedTo(resourceType, appName, name string) ([]string, error) {
 	envs, err := s.configStore.ListEnvironments(appName)
 	if err != nil {
 		return nil, fmt.Errorf("list environment for app %s: %w", appName, err)
@@ -149,7 +167,7 @@ func (s *Store) ListEnvironmentsDeployedTo(appName string, svcName string) ([]st
 				deployedEnv <- result{err: err}
 				return
 			}
-			deployedEnv <- s.deployedServices(rgClient, appName, env.Name, svcName)
+			deployedEnv <- s.deployedWorkloads(rgClient, resourceType, appName, env.Name, name)
 		}(env)
 	}
 	var envsWithDeployment []string
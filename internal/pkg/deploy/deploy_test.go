@@ -243,6 +243,46 @@ func TestStore_ListEnvironmentsDeployedTo(t *testing.T) {
 	}
 }
 
+func TestStore_ListEnvironmentsJobDeployedTo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockConfigStore := mocks.NewMockConfigStoreClient(ctrl)
+	mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+	mockConfigStore.EXPECT().ListEnvironments("mockApp").Return([]*config.Environment{
+		{
+			App:  "mockApp",
+			Name: "mockEnv1",
+		},
+		{
+			App:  "mockApp",
+			Name: "mockEnv2",
+		},
+	}, nil)
+	mockRgGetter.EXPECT().GetResourcesByTags(stateMachineResourceType, map[string]string{
+		AppTagKey:     "mockApp",
+		EnvTagKey:     "mockEnv1",
+		ServiceTagKey: "mockJob",
+	}).Return([]*rg.Resource{}, nil)
+	mockRgGetter.EXPECT().GetResourcesByTags(stateMachineResourceType, map[string]string{
+		AppTagKey:     "mockApp",
+		EnvTagKey:     "mockEnv2",
+		ServiceTagKey: "mockJob",
+	}).Return([]*rg.Resource{{ARN: "mockStateMachineARN"}}, nil)
+
+	store := &Store{
+		configStore:         mockConfigStore,
+		newRgClientFromRole: func(string, string) (resourceGetter, error) { return mockRgGetter, nil },
+	}
+
+	// WHEN
+	envs, err := store.ListEnvironmentsJobDeployedTo("mockApp", "mockJob")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []string{"mockEnv2"}, envs)
+}
+
 func TestStore_IsDeployed(t *testing.T) {
 	testCases := map[string]struct {
 		inputApp   string
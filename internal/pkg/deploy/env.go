@@ -7,13 +7,14 @@ package deploy
 
 import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
 const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.0.0"
+	LatestEnvTemplateVersion = "v1.1.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -26,6 +27,14 @@ type CreateEnvironmentInput struct {
 	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources under the application.
 	ImportVPCConfig          *config.ImportVPC // Optional configuration if users have an existing VPC.
 	AdjustVPCConfig          *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	ImportCertARNs           []string          // Optional ARNs of existing ACM certificates for the HTTPS listener of the public load balancer.
+	WebACLARN                string            // Optional ARN of an existing WAFv2 web ACL associated with the public load balancer.
+	EnableContainerInsights  bool              // Whether or not CloudWatch Container Insights is enabled for the cluster.
+	NATGateways              string            // Optional NAT gateways for the private subnets of the VPC, either "per-az" or "single".
+	VPCEndpoints             bool              // Whether or not to create VPC endpoints for the AWS services used by the tasks.
+
+	ELBAccessLogs *template.ELBAccessLogsOpts // Optional configuration of the access logs of the public load balancer.
+	InternalALB   bool                        // Whether or not to create an internal load balancer for backend services.
 
 	// The version of the environment template to creat the stack. If empty, creates the legacy stack.
 	Version string
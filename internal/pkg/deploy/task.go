@@ -7,18 +7,29 @@ package deploy
 
 // CreateTaskResourcesInput holds the fields required to create a task stack.
 type CreateTaskResourcesInput struct {
-	Name     string
-	CPU      int
-	Memory   int
+	Name   string
+	CPU    int
+	Memory int
 
-	Image    string
-	TaskRole string
+	Image         string
+	TaskRole      string
 	ExecutionRole string
-	Command  string
-	EnvVars  map[string]string
+	Command       []string
+	EntryPoint    []string
+	EnvVars       map[string]string
+	Secrets       map[string]string // Names of SSM parameters, or ARNs of SSM parameters or Secrets Manager secrets, by environment variable.
 
-	App      string
-	Env      string
+	App string
+	Env string
 
 	AdditionalTags map[string]string
 }
+
+// TaskStackInfo holds the name of a task group and the stack that holds its resources.
+type TaskStackInfo struct {
+	Name      string
+	StackName string
+
+	App string // Empty if the task group runs in the default cluster.
+	Env string
+}
@@ -61,8 +61,9 @@ func NewBackendServiceDescriber(opt NewBackendServiceConfig) (*BackendServiceDes
 	return describer, nil
 }
 
-// URI returns the service discovery namespace and is used to make
-// BackendServiceDescriber have the same signature as WebServiceDescriber.
+// URI returns the internal load balancer's DNS name and the path of the service if the service is routed through it.
+// Otherwise, returns the service discovery namespace.
+// It is used to make BackendServiceDescriber have the same signature as WebServiceDescriber.
 func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if err := d.initServiceDescriber(envName); err != nil {
 		return "", err
@@ -71,6 +72,17 @@ func (d *BackendServiceDescriber) URI(envName string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("retrieve service deployment configuration: %w", err)
 	}
+	if path, ok := svcParams[stack.LBWebServiceRulePathParamKey]; ok {
+		envOutputs, err := d.svcDescriber[envName].EnvOutputs()
+		if err != nil {
+			return "", fmt.Errorf("get output for environment %s: %w", envName, err)
+		}
+		uri := &WebServiceURI{
+			DNSName: envOutputs[envOutputInternalLoadBalancerDNSName],
+			Path:    path,
+		}
+		return uri.String(), nil
+	}
 	s := serviceDiscovery{
 		Service: d.svc,
 		Port:    svcParams[stack.LBWebServiceContainerPortParamKey],
@@ -89,6 +101,8 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var configs []*ServiceConfig
 	var services []*ServiceDiscovery
 	var envVars []*EnvVars
+	var publishers []*Topic
+	var rules []*SecurityGroupRule
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -115,6 +129,16 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve environment variables: %w", err)
 		}
 		envVars = append(envVars, flattenEnvVars(env, backendSvcEnvVars)...)
+		envTopics, err := flattenTopics(env, backendSvcEnvVars)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve topics: %w", err)
+		}
+		publishers = append(publishers, envTopics...)
+		envRules, err := d.svcDescriber[env].SecurityGroupRules()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve security group rules: %w", err)
+		}
+		rules = append(rules, flattenSecurityGroupRules(env, envRules)...)
 	}
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
@@ -135,25 +159,29 @@ func (d *BackendServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	return &backendSvcDesc{
-		Service:          d.svc,
-		Type:             manifest.BackendServiceType,
-		App:              d.app,
-		Configurations:   configs,
-		ServiceDiscovery: services,
-		Variables:        envVars,
-		Resources:        resources,
+		Service:            d.svc,
+		Type:               manifest.BackendServiceType,
+		App:                d.app,
+		Configurations:     configs,
+		ServiceDiscovery:   services,
+		Variables:          envVars,
+		Topics:             publishers,
+		SecurityGroupRules: rules,
+		Resources:          resources,
 	}, nil
 }
 
 // backendSvcDesc contains serialized parameters for a backend service.
 type backendSvcDesc struct {
-	Service          string             `json:"service"`
-	Type             string             `json:"type"`
-	App              string             `json:"application"`
-	Configurations   configurations     `json:"configurations"`
-	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
-	Variables        envVars            `json:"variables"`
-	Resources        cfnResources       `json:"resources,omitempty"`
+	Service            string             `json:"service"`
+	Type               string             `json:"type"`
+	App                string             `json:"application"`
+	Configurations     configurations     `json:"configurations"`
+	ServiceDiscovery   serviceDiscoveries `json:"serviceDiscovery"`
+	Variables          envVars            `json:"variables"`
+	Topics             topics             `json:"topics,omitempty"`
+	SecurityGroupRules securityGroupRules `json:"securityGroupRules,omitempty"`
+	Resources          cfnResources       `json:"resources,omitempty"`
 }
 
 // JSONString returns the stringified backendService struct with json format.
@@ -183,6 +211,16 @@ func (w *backendSvcDesc) HumanString() string {
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.Topics) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPublishes To\n\n"))
+		writer.Flush()
+		w.Topics.humanString(writer)
+	}
+	if len(w.SecurityGroupRules) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecurity Group Rules\n\n"))
+		writer.Flush()
+		w.SecurityGroupRules.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
 	"github.com/golang/mock/gomock"
@@ -21,6 +22,95 @@ type backendSvcDescriberMocks struct {
 	svcDescriber *mocks.MocksvcDescriber
 }
 
+func TestBackendServiceDescriber_URI(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "api"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(mocks backendSvcDescriberMocks)
+
+		wantedURI   string
+		wantedError error
+	}{
+		"fail to get parameters of service stack": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				m.svcDescriber.EXPECT().Params().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve service deployment configuration: some error"),
+		},
+		"service discovery endpoint": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				m.svcDescriber.EXPECT().Params().Return(map[string]string{
+					stack.LBWebServiceContainerPortParamKey: "8080",
+				}, nil)
+			},
+			wantedURI: "api.phonetool.local:8080",
+		},
+		"fail to get output of environment stack": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "8080",
+						stack.LBWebServiceRulePathParamKey:      "api",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get output for environment test: some error"),
+		},
+		"routed through the internal load balancer": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "8080",
+						stack.LBWebServiceRulePathParamKey:      "api",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputInternalLoadBalancerDNSName: "internal-abc.us-west-2.elb.amazonaws.com",
+					}, nil),
+				)
+			},
+			wantedURI: "http://internal-abc.us-west-2.elb.amazonaws.com/api",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
+			tc.setupMocks(backendSvcDescriberMocks{
+				svcDescriber: mockSvcDescriber,
+			})
+
+			d := &BackendServiceDescriber{
+				app: testApp,
+				svc: testSvc,
+				svcDescriber: map[string]svcDescriber{
+					testEnv: mockSvcDescriber,
+				},
+				initServiceDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			actual, err := d.URI(testEnv)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedURI, actual)
+			}
+		})
+	}
+}
+
 func TestBackendServiceDescriber_Describe(t *testing.T) {
 	const (
 		testApp     = "phonetool"
@@ -71,6 +161,41 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve environment variables: some error"),
 		},
+		"return error if fail to parse the topics": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(map[string]string{
+						"COPILOT_SNS_TOPIC_ARNS": "orders",
+					}, nil),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve topics: unmarshal COPILOT_SNS_TOPIC_ARNS: invalid character 'o' looking for beginning of value"),
+		},
+		"return error if fail to retrieve security group rules": {
+			setupMocks: func(m backendSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceContainerPortParamKey: "80",
+						stack.WorkloadTaskCountParamKey:         "1",
+						stack.WorkloadTaskCPUParamKey:           "256",
+						stack.WorkloadTaskMemoryParamKey:        "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(map[string]string{
+						"COPILOT_ENVIRONMENT_NAME": testEnv,
+					}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve security group rules: some error"),
+		},
 		"success": {
 			shouldOutputResources: true,
 			setupMocks: func(m backendSvcDescriberMocks) {
@@ -87,6 +212,10 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return([]*ec2.SecurityGroupRule{
+						{Protocol: "tcp", FromPort: 5000, ToPort: 5000, Source: "sg-1234", Description: "Ingress from the frontend workload on port 5000"},
+						{Protocol: "-1", Source: "sg-5678"},
+					}, nil),
 
 					m.svcDescriber.EXPECT().Params().Return(map[string]string{
 						stack.LBWebServiceContainerPortParamKey: "5000",
@@ -98,6 +227,7 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return(nil, nil),
 
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
@@ -151,6 +281,20 @@ func TestBackendServiceDescriber_Describe(t *testing.T) {
 						Value:       "test",
 					},
 				},
+				SecurityGroupRules: []*SecurityGroupRule{
+					{
+						Environment: "test",
+						Source:      "Ingress from the frontend workload on port 5000",
+						Protocol:    "tcp",
+						Ports:       "5000",
+					},
+					{
+						Environment: "test",
+						Source:      "sg-5678",
+						Protocol:    "all",
+						Ports:       "all",
+					},
+				},
 				Resources: map[string][]*CfnResource{
 					"test": {
 						{
@@ -238,6 +382,11 @@ Variables
   COPILOT_ENVIRONMENT_NAME  prod                prod
   -                         test                test
 
+Security Group Rules
+
+  Environment       Source                              Protocol            Ports
+  test              Ingress from the frontend workload  tcp                 5000
+
 Resources
 
   test
@@ -246,7 +395,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Backend Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"securityGroupRules\":[{\"environment\":\"test\",\"source\":\"Ingress from the frontend workload\",\"protocol\":\"tcp\",\"ports\":\"5000\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -300,14 +449,23 @@ Resources
 					},
 				},
 			}
+			rules := []*SecurityGroupRule{
+				{
+					Environment: "test",
+					Source:      "Ingress from the frontend workload",
+					Protocol:    "tcp",
+					Ports:       "5000",
+				},
+			}
 			backendSvc := &backendSvcDesc{
-				Service:          "my-svc",
-				Type:             "Backend Service",
-				Configurations:   config,
-				App:              "my-app",
-				Variables:        envVars,
-				ServiceDiscovery: sds,
-				Resources:        resources,
+				Service:            "my-svc",
+				Type:               "Backend Service",
+				Configurations:     config,
+				App:                "my-app",
+				Variables:          envVars,
+				ServiceDiscovery:   sds,
+				SecurityGroupRules: rules,
+				Resources:          resources,
 			}
 			human := backendSvc.HumanString()
 			json, _ := backendSvc.JSONString()
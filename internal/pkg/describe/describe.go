@@ -4,11 +4,14 @@
 package describe
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/dustin/go-humanize"
 )
 
@@ -22,6 +25,9 @@ const (
 	noAdditionalFormatting = 0
 )
 
+// envVarSNSTopicARNs is the environment variable that holds the ARNs of the topics a service publishes to.
+const envVarSNSTopicARNs = "COPILOT_SNS_TOPIC_ARNS"
+
 // humanizeTime is overriden in tests so that its output is constant as time passes.
 var humanizeTime = humanize.Time
 
@@ -62,6 +68,88 @@ func flattenEnvVars(envName string, m map[string]string) []*EnvVars {
 	return envVarList
 }
 
+// Topic contains serialized parameters for an SNS topic that a service publishes to.
+type Topic struct {
+	Environment string `json:"environment"`
+	Name        string `json:"name"`
+	ARN         string `json:"arn"`
+}
+
+type topics []*Topic
+
+func (t topics) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\n", "Name", "Environment", "ARN")
+	for _, topic := range t {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", topic.Name, topic.Environment, topic.ARN)
+	}
+}
+
+// flattenTopics returns the topics that a service publishes to from the JSON object
+// stored in its environment variables, sorted by name.
+func flattenTopics(envName string, vars map[string]string) ([]*Topic, error) {
+	value, ok := vars[envVarSNSTopicARNs]
+	if !ok {
+		return nil, nil
+	}
+	arns := make(map[string]string)
+	if err := json.Unmarshal([]byte(value), &arns); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", envVarSNSTopicARNs, err)
+	}
+	var topics []*Topic
+	for name, arn := range arns {
+		topics = append(topics, &Topic{
+			Environment: envName,
+			Name:        name,
+			ARN:         arn,
+		})
+	}
+	sort.SliceStable(topics, func(i, j int) bool { return topics[i].Name < topics[j].Name })
+	return topics, nil
+}
+
+// SecurityGroupRule contains serialized parameters for an inbound rule of the security groups attached to a service's tasks.
+type SecurityGroupRule struct {
+	Environment string `json:"environment"`
+	Source      string `json:"source"`
+	Protocol    string `json:"protocol"`
+	Ports       string `json:"ports"`
+}
+
+type securityGroupRules []*SecurityGroupRule
+
+func (r securityGroupRules) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", "Environment", "Source", "Protocol", "Ports")
+	for _, rule := range r {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", rule.Environment, rule.Source, rule.Protocol, rule.Ports)
+	}
+}
+
+// flattenSecurityGroupRules returns the rules of an environment in a human friendly format.
+// The source of a rule is its description if it has one, since the ID of a security group isn't meaningful to users.
+func flattenSecurityGroupRules(envName string, rules []*ec2.SecurityGroupRule) []*SecurityGroupRule {
+	var flattened []*SecurityGroupRule
+	for _, rule := range rules {
+		source := rule.Source
+		if rule.Description != "" {
+			source = rule.Description
+		}
+		protocol, ports := rule.Protocol, fmt.Sprintf("%d-%d", rule.FromPort, rule.ToPort)
+		switch {
+		case rule.Protocol == "-1":
+			protocol, ports = "all", "all"
+		case rule.FromPort == rule.ToPort:
+			ports = fmt.Sprintf("%d", rule.FromPort)
+		}
+		flattened = append(flattened, &SecurityGroupRule{
+			Environment: envName,
+			Source:      source,
+			Protocol:    protocol,
+			Ports:       ports,
+		})
+	}
+	return flattened
+}
+
 // HumanString returns the stringified CfnResource struct with human readable format.
 func (c CfnResource) HumanString() string {
 	return fmt.Sprintf("    %s\t%s\n", c.Type, c.PhysicalID)
@@ -7,9 +7,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -18,9 +21,16 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	envOutputPublicLoadBalancerWebACLArn        = "PublicLoadBalancerWebACLArn"
+	envOutputPublicLoadBalancerAccessLogsBucket = "PublicLoadBalancerAccessLogsBucket"
+)
+
 // EnvDescription contains the information about an environment.
 type EnvDescription struct {
 	Environment *config.Environment `json:"environment"`
+	WebACLARN   string              `json:"webACLARN,omitempty"`
+	AccessLogs  string              `json:"accessLogsBucket,omitempty"`
 	Services    []*config.Workload  `json:"services"`
 	Tags        map[string]string   `json:"tags,omitempty"`
 	Resources   []*CfnResource      `json:"resources,omitempty"`
@@ -75,9 +85,9 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		return nil, err
 	}
 
-	tags, err := d.stackTags()
+	envStack, err := d.stackDescriber.Stack(stack.NameForEnv(d.app, d.env.Name))
 	if err != nil {
-		return nil, fmt.Errorf("retrieve environment tags: %w", err)
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 
 	var stackResources []*CfnResource
@@ -88,10 +98,13 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		}
 	}
 
+	outputs := stackOutputs(envStack)
 	return &EnvDescription{
 		Environment: d.env,
+		WebACLARN:   outputs[envOutputPublicLoadBalancerWebACLArn],
+		AccessLogs:  outputs[envOutputPublicLoadBalancerAccessLogsBucket],
 		Services:    svcs,
-		Tags:        tags,
+		Tags:        stackTags(envStack),
 		Resources:   stackResources,
 	}, nil
 }
@@ -118,16 +131,56 @@ func (d *EnvDescriber) Version() (string, error) {
 	return metadata.Version, nil
 }
 
-func (d *EnvDescriber) stackTags() (map[string]string, error) {
-	tags := make(map[string]string)
-	envStack, err := d.stackDescriber.Stack(stack.NameForEnv(d.app, d.env.Name))
+// HasDefaultVPC returns true if the environment stack creates a VPC with the default CIDR ranges of Copilot.
+// It returns false if the environment imports its VPC or the CIDR ranges were customized.
+func (d *EnvDescriber) HasDefaultVPC() (bool, error) {
+	body, err := d.stackDescriber.Template(stack.NameForEnv(d.app, d.env.Name))
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+	tpl := struct {
+		Resources map[string]struct {
+			Type       string `yaml:"Type"`
+			Properties struct {
+				CidrBlock string `yaml:"CidrBlock"`
+			} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}{}
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return false, fmt.Errorf("unmarshal template of environment %s: %w", d.env.Name, err)
+	}
+	var vpcCIDRs, subnetCIDRs []string
+	for _, resource := range tpl.Resources {
+		switch resource.Type {
+		case "AWS::EC2::VPC":
+			vpcCIDRs = append(vpcCIDRs, resource.Properties.CidrBlock)
+		case "AWS::EC2::Subnet":
+			subnetCIDRs = append(subnetCIDRs, resource.Properties.CidrBlock)
+		}
 	}
+	if len(vpcCIDRs) != 1 || vpcCIDRs[0] != stack.DefaultVPCCIDR {
+		return false, nil
+	}
+	defaultSubnetCIDRs := append(strings.Split(stack.DefaultPublicSubnetCIDRs, ","), strings.Split(stack.DefaultPrivateSubnetCIDRs, ",")...)
+	sort.Strings(subnetCIDRs)
+	sort.Strings(defaultSubnetCIDRs)
+	return reflect.DeepEqual(subnetCIDRs, defaultSubnetCIDRs), nil
+}
+
+func stackTags(envStack *cloudformation.Stack) map[string]string {
+	tags := make(map[string]string)
 	for _, tag := range envStack.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	return tags, nil
+	return tags
+}
+
+func stackOutputs(envStack *cloudformation.Stack) map[string]string {
+	outputs := make(map[string]string)
+	for _, out := range envStack.Outputs {
+		outputs[*out.OutputKey] = *out.OutputValue
+	}
+	return outputs
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
@@ -178,6 +231,12 @@ func (e *EnvDescription) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%t\n", "Production", e.Environment.Prod)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Environment.Region)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.Environment.AccountID)
+	if e.WebACLARN != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Web ACL", e.WebACLARN)
+	}
+	if e.AccessLogs != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Access Logs", e.AccessLogs)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
 	fmt.Fprintf(writer, "  %s\t%s\n", "Name", "Type")
@@ -110,7 +110,7 @@ func TestEnvDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("list deployed services in env testEnv: some error"),
 		},
-		"error if fail to get env tags": {
+		"error if fail to get env stack": {
 			setupMocks: func(m envDescriberMocks) {
 				gomock.InOrder(
 					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
@@ -121,7 +121,7 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(nil, mockError),
 				)
 			},
-			wantedError: fmt.Errorf("retrieve environment tags: some error"),
+			wantedError: fmt.Errorf("retrieve environment stack: some error"),
 		},
 		"error if fail to get env resources": {
 			shouldOutputResources: true,
@@ -160,6 +160,38 @@ func TestEnvDescriber_Describe(t *testing.T) {
 				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
 			},
 		},
+		"success with web ACL and access logs": {
+			shouldOutputResources: false,
+			setupMocks: func(m envDescriberMocks) {
+				gomock.InOrder(
+					m.configStoreSvc.EXPECT().ListServices(testApp).Return([]*config.Workload{
+						testSvc1, testSvc2, testSvc3,
+					}, nil),
+					m.deployStoreSvc.EXPECT().ListDeployedServices(testApp, testEnv.Name).
+						Return([]string{"testSvc1", "testSvc2"}, nil),
+					m.stackDescriber.EXPECT().Stack("testApp-testEnv").Return(&cloudformation.Stack{
+						Tags: stackTags,
+						Outputs: []*cloudformation.Output{
+							{
+								OutputKey:   aws.String("PublicLoadBalancerWebACLArn"),
+								OutputValue: aws.String("arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc"),
+							},
+							{
+								OutputKey:   aws.String("PublicLoadBalancerAccessLogsBucket"),
+								OutputValue: aws.String("testapp-testenv-elbaccesslogsbucket"),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedEnv: &EnvDescription{
+				Environment: testEnv,
+				WebACLARN:   "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc",
+				AccessLogs:  "testapp-testenv-elbaccesslogsbucket",
+				Services:    envSvcs,
+				Tags:        map[string]string{"copilot-application": "testApp", "copilot-environment": "testEnv"},
+			},
+		},
 		"success with resources": {
 			shouldOutputResources: true,
 			setupMocks: func(m envDescriberMocks) {
@@ -283,6 +315,99 @@ func TestEnvDescriber_Version(t *testing.T) {
 	}
 }
 
+func TestEnvDescriber_HasDefaultVPC(t *testing.T) {
+	const defaultVPCTemplate = `Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+  PublicSubnet1:
+    Type: AWS::EC2::Subnet
+    Properties:
+      CidrBlock: 10.0.0.0/24
+      VpcId: !Ref VPC
+  PublicSubnet2:
+    Type: AWS::EC2::Subnet
+    Properties:
+      CidrBlock: 10.0.1.0/24
+      VpcId: !Ref VPC
+  PrivateSubnet1:
+    Type: AWS::EC2::Subnet
+    Properties:
+      CidrBlock: 10.0.2.0/24
+      VpcId: !Ref VPC
+  PrivateSubnet2:
+    Type: AWS::EC2::Subnet
+    Properties:
+      CidrBlock: 10.0.3.0/24
+      VpcId: !Ref VPC
+`
+	testCases := map[string]struct {
+		template    string
+		templateErr error
+
+		wanted    bool
+		wantedErr error
+	}{
+		"should wrap error if template can't be retrieved": {
+			templateErr: errors.New("some error"),
+			wantedErr:   errors.New("some error"),
+		},
+		"should return true if the VPC and subnets use the default CIDR ranges": {
+			template: defaultVPCTemplate,
+			wanted:   true,
+		},
+		"should return false if the VPC CIDR range was customized": {
+			template: `Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.1.0.0/16
+`,
+		},
+		"should return false if the subnet CIDR ranges were customized": {
+			template: defaultVPCTemplate + `  PrivateSubnet3:
+    Type: AWS::EC2::Subnet
+    Properties:
+      CidrBlock: 10.0.4.0/24
+      VpcId: !Ref VPC
+`,
+		},
+		"should return false if the VPC is imported": {
+			template: `Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			m.EXPECT().Template("phonetool-test").Return(tc.template, tc.templateErr)
+			d := &EnvDescriber{
+				app:            "phonetool",
+				env:            &config.Environment{Name: "test"},
+				stackDescriber: m,
+			}
+
+			// WHEN
+			actual, err := d.HasDefaultVPC()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, actual)
+			}
+		})
+	}
+}
+
 func TestEnvDescription_JSONString(t *testing.T) {
 	testApp := &config.Application{
 		Name: "testApp",
@@ -373,6 +498,8 @@ func TestEnvDescription_HumanString(t *testing.T) {
   Production        false
   Region            us-west-2
   Account ID        123456789012
+  Web ACL           arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc
+  Access Logs       testapp-testenv-elbaccesslogsbucket
 
 Services
 
@@ -400,6 +527,8 @@ Resources
 
 	d := &EnvDescription{
 		Environment: testEnv,
+		WebACLARN:   "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc",
+		AccessLogs:  "testapp-testenv-elbaccesslogsbucket",
 		Services:    allSvcs,
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
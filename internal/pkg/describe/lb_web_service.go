@@ -16,23 +16,32 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
 const (
-	envOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
-	envOutputSubdomain                 = "EnvironmentSubdomain"
+	envOutputPublicLoadBalancerDNSName   = "PublicLoadBalancerDNSName"
+	envOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
+	envOutputSubdomain                   = "EnvironmentSubdomain"
+
+	svcOutputAliases = "Aliases"
 )
 
 // WebServiceURI represents the unique identifier to access a web service.
 type WebServiceURI struct {
 	DNSName string // The environment's subdomain if the service is served on HTTPS. Otherwise, the public load balancer's DNS.
 	Path    string // Empty if the service is served on HTTPS. Otherwise, the pattern used to match the service.
+	HTTPS   bool   // True if the service is routed by path on HTTPS, when the environment uses imported certificates.
 }
 
 func (uri *WebServiceURI) String() string {
+	protocol := "http"
+	if uri.HTTPS {
+		protocol = "https"
+	}
 	switch uri.Path {
 	// When the service is using host based routing, the service
 	// is included in the DNS name (svc.myenv.myproj.dns.com)
@@ -41,12 +50,12 @@ func (uri *WebServiceURI) String() string {
 	// When the service is using the root path, there is no "path"
 	// (for example http://lb.us-west-2.amazon.com/)
 	case "/":
-		return fmt.Sprintf("http://%s", uri.DNSName)
+		return fmt.Sprintf("%s://%s", protocol, uri.DNSName)
 	// Otherwise, if there is a path for the service, link to the
 	// LoadBalancer DNS name and the path
 	// (for example http://lb.us-west-2.amazon.com/svc)
 	default:
-		return fmt.Sprintf("http://%s/%s", uri.DNSName, uri.Path)
+		return fmt.Sprintf("%s://%s/%s", protocol, uri.DNSName, uri.Path)
 	}
 }
 
@@ -62,9 +71,11 @@ func (s *serviceDiscovery) String() string {
 
 type svcDescriber interface {
 	Params() (map[string]string, error)
+	Outputs() (map[string]string, error)
 	EnvOutputs() (map[string]string, error)
 	EnvVars() (map[string]string, error)
 	ServiceStackResources() ([]*cloudformation.StackResource, error)
+	SecurityGroupRules() ([]*ec2.SecurityGroupRule, error)
 }
 
 // WebServiceDescriber retrieves information about a load balanced web service.
@@ -77,8 +88,9 @@ type WebServiceDescriber struct {
 	svcDescriber         map[string]svcDescriber
 	initServiceDescriber func(string) error
 
-	// cache only last svc paramerters
-	svcParams map[string]string
+	// cache only last svc paramerters and env outputs
+	svcParams  map[string]string
+	envOutputs map[string]string
 }
 
 // NewWebServiceConfig contains fields that initiates WebServiceDescriber struct.
@@ -127,6 +139,9 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	var configs []*ServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*EnvVars
+	var publishers []*Topic
+	var rules []*SecurityGroupRule
+	var aliases []*DNSAlias
 	for _, env := range environments {
 		err := d.initServiceDescriber(env)
 		if err != nil {
@@ -140,6 +155,11 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			URL:         webServiceURI,
 		})
+		svcOutputs, err := d.svcDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get outputs for service %s: %w", d.svc, err)
+		}
+		aliases = append(aliases, flattenAliases(env, svcOutputs[svcOutputAliases], d.envOutputs[envOutputPublicLoadBalancerDNSName])...)
 		configs = append(configs, &ServiceConfig{
 			Environment: env,
 			Port:        d.svcParams[stack.LBWebServiceContainerPortParamKey],
@@ -157,6 +177,16 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			return nil, fmt.Errorf("retrieve environment variables: %w", err)
 		}
 		envVars = append(envVars, flattenEnvVars(env, webSvcEnvVars)...)
+		envTopics, err := flattenTopics(env, webSvcEnvVars)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve topics: %w", err)
+		}
+		publishers = append(publishers, envTopics...)
+		envRules, err := d.svcDescriber[env].SecurityGroupRules()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve security group rules: %w", err)
+		}
+		rules = append(rules, flattenSecurityGroupRules(env, envRules)...)
 	}
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
 	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
@@ -177,14 +207,17 @@ func (d *WebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	return &webSvcDesc{
-		Service:          d.svc,
-		Type:             manifest.LoadBalancedWebServiceType,
-		App:              d.app,
-		Configurations:   configs,
-		Routes:           routes,
-		ServiceDiscovery: serviceDiscoveries,
-		Variables:        envVars,
-		Resources:        resources,
+		Service:            d.svc,
+		Type:               manifest.LoadBalancedWebServiceType,
+		App:                d.app,
+		Configurations:     configs,
+		Routes:             routes,
+		Aliases:            aliases,
+		ServiceDiscovery:   serviceDiscoveries,
+		Variables:          envVars,
+		Topics:             publishers,
+		SecurityGroupRules: rules,
+		Resources:          resources,
 	}, nil
 }
 
@@ -204,10 +237,12 @@ func (d *WebServiceDescriber) URI(envName string) (string, error) {
 		return "", fmt.Errorf("get parameters for service %s: %w", d.svc, err)
 	}
 	d.svcParams = svcParams
+	d.envOutputs = envOutputs
 
 	uri := &WebServiceURI{
 		DNSName: envOutputs[envOutputPublicLoadBalancerDNSName],
 		Path:    svcParams[stack.LBWebServiceRulePathParamKey],
+		HTTPS:   svcParams[stack.LBWebServiceHTTPSParamKey] == "true",
 	}
 	_, isHTTPS := envOutputs[envOutputSubdomain]
 	if isHTTPS {
@@ -258,6 +293,37 @@ type WebServiceRoute struct {
 	URL         string `json:"url"`
 }
 
+// DNSAlias contains a host name forwarded to a web service and the DNS name that its CNAME record must point to.
+type DNSAlias struct {
+	Environment string `json:"environment"`
+	Name        string `json:"name"`
+	Target      string `json:"cnameTarget"`
+}
+
+type dnsAliases []*DNSAlias
+
+func (a dnsAliases) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\n", "Environment", "Alias", "CNAME Target")
+	for _, alias := range a {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", alias.Environment, alias.Name, alias.Target)
+	}
+}
+
+func flattenAliases(env, aliases, target string) []*DNSAlias {
+	if aliases == "" {
+		return nil
+	}
+	var out []*DNSAlias
+	for _, alias := range strings.Split(aliases, ",") {
+		out = append(out, &DNSAlias{
+			Environment: env,
+			Name:        alias,
+			Target:      target,
+		})
+	}
+	return out
+}
+
 // ServiceDiscovery contains serialized service discovery info for an service.
 type ServiceDiscovery struct {
 	Environment []string `json:"environment"`
@@ -275,14 +341,17 @@ func (s serviceDiscoveries) humanString(w io.Writer) {
 
 // webSvcDesc contains serialized parameters for a web service.
 type webSvcDesc struct {
-	Service          string             `json:"service"`
-	Type             string             `json:"type"`
-	App              string             `json:"application"`
-	Configurations   configurations     `json:"configurations"`
-	Routes           []*WebServiceRoute `json:"routes"`
-	ServiceDiscovery serviceDiscoveries `json:"serviceDiscovery"`
-	Variables        envVars            `json:"variables"`
-	Resources        cfnResources       `json:"resources,omitempty"`
+	Service            string             `json:"service"`
+	Type               string             `json:"type"`
+	App                string             `json:"application"`
+	Configurations     configurations     `json:"configurations"`
+	Routes             []*WebServiceRoute `json:"routes"`
+	Aliases            dnsAliases         `json:"aliases,omitempty"`
+	ServiceDiscovery   serviceDiscoveries `json:"serviceDiscovery"`
+	Variables          envVars            `json:"variables"`
+	Topics             topics             `json:"topics,omitempty"`
+	SecurityGroupRules securityGroupRules `json:"securityGroupRules,omitempty"`
+	Resources          cfnResources       `json:"resources,omitempty"`
 }
 
 // JSONString returns the stringified webSvcDesc struct in json format.
@@ -312,12 +381,27 @@ func (w *webSvcDesc) HumanString() string {
 	for _, route := range w.Routes {
 		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
 	}
+	if len(w.Aliases) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAliases\n\n"))
+		writer.Flush()
+		w.Aliases.humanString(writer)
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
 	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
 	writer.Flush()
 	w.Variables.humanString(writer)
+	if len(w.Topics) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPublishes To\n\n"))
+		writer.Flush()
+		w.Topics.humanString(writer)
+	}
+	if len(w.SecurityGroupRules) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecurity Group Rules\n\n"))
+		writer.Flush()
+		w.SecurityGroupRules.humanString(writer)
+	}
 	if len(w.Resources) != 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
 		writer.Flush()
@@ -20,6 +20,7 @@ func TestWebServiceURI_String(t *testing.T) {
 	testCases := map[string]struct {
 		dnsName string
 		path    string
+		https   bool
 
 		wanted string
 	}{
@@ -41,6 +42,13 @@ func TestWebServiceURI_String(t *testing.T) {
 
 			wanted: "https://jobs.test.phonetool.com",
 		},
+		"https with imported certificates": {
+			dnsName: "abc.us-west-1.elb.amazonaws.com",
+			path:    "svc",
+			https:   true,
+
+			wanted: "https://abc.us-west-1.elb.amazonaws.com/svc",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -48,6 +56,7 @@ func TestWebServiceURI_String(t *testing.T) {
 			uri := &WebServiceURI{
 				DNSName: tc.dnsName,
 				Path:    tc.path,
+				HTTPS:   tc.https,
 			}
 
 			require.Equal(t, tc.wanted, uri.String())
@@ -200,6 +209,21 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("retrieve service URI: get output for environment test: some error"),
 		},
+		"return error if fail to retrieve service outputs": {
+			setupMocks: func(m webSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+					}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("get outputs for service jobs: some error"),
+		},
 		"return error if fail to retrieve service deployment configuration": {
 			setupMocks: func(m webSvcDescriberMocks) {
 				gomock.InOrder(
@@ -214,6 +238,7 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskMemoryParamKey:        "512",
 						stack.LBWebServiceRulePathParamKey:      testSvcPath,
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
 				)
 			},
@@ -234,10 +259,12 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return(nil, nil),
 					m.svcDescriber.EXPECT().ServiceStackResources().Return(nil, mockErr),
 				)
 			},
@@ -259,10 +286,14 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "256",
 						stack.WorkloadTaskMemoryParamKey:        "512",
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{
+						"Aliases": "example.com,api.example.com",
+					}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": testEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return(nil, nil),
 
 					m.svcDescriber.EXPECT().EnvOutputs().Return(map[string]string{
 						envOutputPublicLoadBalancerDNSName: prodEnvLBDNSName,
@@ -274,10 +305,12 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						stack.WorkloadTaskCPUParamKey:           "512",
 						stack.WorkloadTaskMemoryParamKey:        "1024",
 					}, nil),
+					m.svcDescriber.EXPECT().Outputs().Return(map[string]string{}, nil),
 					m.svcDescriber.EXPECT().EnvVars().Return(
 						map[string]string{
 							"COPILOT_ENVIRONMENT_NAME": prodEnv,
 						}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return(nil, nil),
 
 					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
 						{
@@ -323,6 +356,18 @@ func TestWebServiceDescriber_Describe(t *testing.T) {
 						URL:         "http://abc.us-west-1.elb.amazonaws.com/*",
 					},
 				},
+				Aliases: []*DNSAlias{
+					{
+						Environment: "test",
+						Name:        "example.com",
+						Target:      "abc.us-west-1.elb.amazonaws.com",
+					},
+					{
+						Environment: "test",
+						Name:        "api.example.com",
+						Target:      "abc.us-west-1.elb.amazonaws.com",
+					},
+				},
 				ServiceDiscovery: []*ServiceDiscovery{
 					{
 						Environment: []string{"test", "prod"},
@@ -423,6 +468,11 @@ Routes
   test              http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend
   prod              http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend
 
+Aliases
+
+  Environment       Alias               CNAME Target
+  prod              api.example.com     my-pr-Publi.us-west-2.elb.amazonaws.com
+
 Service Discovery
 
   Environment       Namespace
@@ -442,7 +492,7 @@ Resources
   prod
     AWS::EC2::SecurityGroupIngress  ContainerSecurityGroupIngressFromPublicALB
 `,
-			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
+			wantedJSONString: "{\"service\":\"my-svc\",\"type\":\"Load Balanced Web Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"80\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"port\":\"5000\",\"tasks\":\"3\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"routes\":[{\"environment\":\"test\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/frontend\"},{\"environment\":\"prod\",\"url\":\"http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend\"}],\"aliases\":[{\"environment\":\"prod\",\"name\":\"api.example.com\",\"cnameTarget\":\"my-pr-Publi.us-west-2.elb.amazonaws.com\"}],\"serviceDiscovery\":[{\"environment\":[\"test\",\"prod\"],\"namespace\":\"http://my-svc.my-app.local:5000\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::EC2::SecurityGroupIngress\",\"physicalID\":\"ContainerSecurityGroupIngressFromPublicALB\"}],\"test\":[{\"type\":\"AWS::EC2::SecurityGroup\",\"physicalID\":\"sg-0758ed6b233743530\"}]}}\n",
 		},
 	}
 
@@ -486,6 +536,13 @@ Resources
 					URL:         "http://my-pr-Publi.us-west-2.elb.amazonaws.com/backend",
 				},
 			}
+			aliases := []*DNSAlias{
+				{
+					Environment: "prod",
+					Name:        "api.example.com",
+					Target:      "my-pr-Publi.us-west-2.elb.amazonaws.com",
+				},
+			}
 			sds := []*ServiceDiscovery{
 				{
 					Environment: []string{"test", "prod"},
@@ -513,6 +570,7 @@ Resources
 				App:              "my-app",
 				Variables:        envVars,
 				Routes:           routes,
+				Aliases:          aliases,
 				ServiceDiscovery: sds,
 				Resources:        resources,
 			}
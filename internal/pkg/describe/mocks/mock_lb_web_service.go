@@ -6,6 +6,7 @@ package mocks
 
 import (
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
 )
@@ -48,6 +49,21 @@ func (mr *MocksvcDescriberMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MocksvcDescriber)(nil).Params))
 }
 
+// Outputs mocks base method
+func (m *MocksvcDescriber) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs
+func (mr *MocksvcDescriberMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MocksvcDescriber)(nil).Outputs))
+}
+
 // EnvOutputs mocks base method
 func (m *MocksvcDescriber) EnvOutputs() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -92,3 +108,18 @@ func (mr *MocksvcDescriberMockRecorder) ServiceStackResources() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackResources", reflect.TypeOf((*MocksvcDescriber)(nil).ServiceStackResources))
 }
+
+// SecurityGroupRules mocks base method
+func (m *MocksvcDescriber) SecurityGroupRules() ([]*ec2.SecurityGroupRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityGroupRules")
+	ret0, _ := ret[0].([]*ec2.SecurityGroupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroupRules indicates an expected call of SecurityGroupRules
+func (mr *MocksvcDescriberMockRecorder) SecurityGroupRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroupRules", reflect.TypeOf((*MocksvcDescriber)(nil).SecurityGroupRules))
+}
@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/scheduled_job.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockjobDescriber is a mock of jobDescriber interface
+type MockjobDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobDescriberMockRecorder
+}
+
+// MockjobDescriberMockRecorder is the mock recorder for MockjobDescriber
+type MockjobDescriberMockRecorder struct {
+	mock *MockjobDescriber
+}
+
+// NewMockjobDescriber creates a new mock instance
+func NewMockjobDescriber(ctrl *gomock.Controller) *MockjobDescriber {
+	mock := &MockjobDescriber{ctrl: ctrl}
+	mock.recorder = &MockjobDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockjobDescriber) EXPECT() *MockjobDescriberMockRecorder {
+	return m.recorder
+}
+
+// Params mocks base method
+func (m *MockjobDescriber) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params
+func (mr *MockjobDescriberMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockjobDescriber)(nil).Params))
+}
+
+// EnvVars mocks base method
+func (m *MockjobDescriber) EnvVars() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvVars")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvVars indicates an expected call of EnvVars
+func (mr *MockjobDescriberMockRecorder) EnvVars() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvVars", reflect.TypeOf((*MockjobDescriber)(nil).EnvVars))
+}
+
+// ServiceStackResources mocks base method
+func (m *MockjobDescriber) ServiceStackResources() ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceStackResources")
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceStackResources indicates an expected call of ServiceStackResources
+func (mr *MockjobDescriberMockRecorder) ServiceStackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceStackResources", reflect.TypeOf((*MockjobDescriber)(nil).ServiceStackResources))
+}
+
+// StateMachineARN mocks base method
+func (m *MockjobDescriber) StateMachineARN() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineARN")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineARN indicates an expected call of StateMachineARN
+func (mr *MockjobDescriberMockRecorder) StateMachineARN() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineARN", reflect.TypeOf((*MockjobDescriber)(nil).StateMachineARN))
+}
+
+// MockstateMachineDescriber is a mock of stateMachineDescriber interface
+type MockstateMachineDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstateMachineDescriberMockRecorder
+}
+
+// MockstateMachineDescriberMockRecorder is the mock recorder for MockstateMachineDescriber
+type MockstateMachineDescriberMockRecorder struct {
+	mock *MockstateMachineDescriber
+}
+
+// NewMockstateMachineDescriber creates a new mock instance
+func NewMockstateMachineDescriber(ctrl *gomock.Controller) *MockstateMachineDescriber {
+	mock := &MockstateMachineDescriber{ctrl: ctrl}
+	mock.recorder = &MockstateMachineDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockstateMachineDescriber) EXPECT() *MockstateMachineDescriberMockRecorder {
+	return m.recorder
+}
+
+// StateMachineDefinition mocks base method
+func (m *MockstateMachineDescriber) StateMachineDefinition(stateMachineARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineDefinition", stateMachineARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineDefinition indicates an expected call of StateMachineDefinition
+func (mr *MockstateMachineDescriberMockRecorder) StateMachineDefinition(stateMachineARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineDefinition", reflect.TypeOf((*MockstateMachineDescriber)(nil).StateMachineDefinition), stateMachineARN)
+}
+
+// MockDeployedEnvJobsLister is a mock of DeployedEnvJobsLister interface
+type MockDeployedEnvJobsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeployedEnvJobsListerMockRecorder
+}
+
+// MockDeployedEnvJobsListerMockRecorder is the mock recorder for MockDeployedEnvJobsLister
+type MockDeployedEnvJobsListerMockRecorder struct {
+	mock *MockDeployedEnvJobsLister
+}
+
+// NewMockDeployedEnvJobsLister creates a new mock instance
+func NewMockDeployedEnvJobsLister(ctrl *gomock.Controller) *MockDeployedEnvJobsLister {
+	mock := &MockDeployedEnvJobsLister{ctrl: ctrl}
+	mock.recorder = &MockDeployedEnvJobsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeployedEnvJobsLister) EXPECT() *MockDeployedEnvJobsListerMockRecorder {
+	return m.recorder
+}
+
+// ListEnvironmentsJobDeployedTo mocks base method
+func (m *MockDeployedEnvJobsLister) ListEnvironmentsJobDeployedTo(appName, jobName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironmentsJobDeployedTo", appName, jobName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironmentsJobDeployedTo indicates an expected call of ListEnvironmentsJobDeployedTo
+func (mr *MockDeployedEnvJobsListerMockRecorder) ListEnvironmentsJobDeployedTo(appName, jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentsJobDeployedTo", reflect.TypeOf((*MockDeployedEnvJobsLister)(nil).ListEnvironmentsJobDeployedTo), appName, jobName)
+}
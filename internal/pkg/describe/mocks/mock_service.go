@@ -6,6 +6,7 @@ package mocks
 
 import (
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	gomock "github.com/golang/mock/gomock"
@@ -80,6 +81,35 @@ func (mr *MockstackAndResourcesDescriberMockRecorder) Metadata(stackName interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Metadata", reflect.TypeOf((*MockstackAndResourcesDescriber)(nil).Metadata), stackName)
 }
 
+// Template mocks base method
+func (m *MockstackAndResourcesDescriber) Template(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template
+func (mr *MockstackAndResourcesDescriberMockRecorder) Template(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockstackAndResourcesDescriber)(nil).Template), stackName)
+}
+
+// StackEventsPages mocks base method
+func (m *MockstackAndResourcesDescriber) StackEventsPages(stackName string, fn func([]*cloudformation.StackEvent) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackEventsPages", stackName, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StackEventsPages indicates an expected call of StackEventsPages
+func (mr *MockstackAndResourcesDescriberMockRecorder) StackEventsPages(stackName, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackEventsPages", reflect.TypeOf((*MockstackAndResourcesDescriber)(nil).StackEventsPages), stackName, fn)
+}
+
 // MockecsClient is a mock of ecsClient interface
 type MockecsClient struct {
 	ctrl     *gomock.Controller
@@ -118,6 +148,63 @@ func (mr *MockecsClientMockRecorder) TaskDefinition(taskDefName interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsClient)(nil).TaskDefinition), taskDefName)
 }
 
+// Service mocks base method
+func (m *MockecsClient) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service
+func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
+}
+
+// Mockec2Client is a mock of ec2Client interface
+type Mockec2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2ClientMockRecorder
+}
+
+// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client
+type Mockec2ClientMockRecorder struct {
+	mock *Mockec2Client
+}
+
+// NewMockec2Client creates a new mock instance
+func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
+	mock := &Mockec2Client{ctrl: ctrl}
+	mock.recorder = &Mockec2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+	return m.recorder
+}
+
+// SecurityGroupRules mocks base method
+func (m *Mockec2Client) SecurityGroupRules(groupIDs ...string) ([]*ec2.SecurityGroupRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range groupIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SecurityGroupRules", varargs...)
+	ret0, _ := ret[0].([]*ec2.SecurityGroupRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecurityGroupRules indicates an expected call of SecurityGroupRules
+func (mr *Mockec2ClientMockRecorder) SecurityGroupRules(groupIDs ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityGroupRules", reflect.TypeOf((*Mockec2Client)(nil).SecurityGroupRules), groupIDs...)
+}
+
 // MockConfigStoreSvc is a mock of ConfigStoreSvc interface
 type MockConfigStoreSvc struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,275 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+type jobDescriber interface {
+	Params() (map[string]string, error)
+	EnvVars() (map[string]string, error)
+	ServiceStackResources() ([]*cloudformation.StackResource, error)
+	StateMachineARN() (string, error)
+}
+
+type stateMachineDescriber interface {
+	StateMachineDefinition(stateMachineARN string) (string, error)
+}
+
+// DeployedEnvJobsLister wraps the method of deploy store to list the environments a job is deployed in.
+type DeployedEnvJobsLister interface {
+	ListEnvironmentsJobDeployedTo(appName string, jobName string) ([]string, error)
+}
+
+// ScheduledJobDescriber retrieves information about a scheduled job.
+type ScheduledJobDescriber struct {
+	app             string
+	job             string
+	enableResources bool
+
+	store            DeployedEnvJobsLister
+	jobDescriber     map[string]jobDescriber
+	sfnClient        map[string]stateMachineDescriber
+	initJobDescriber func(string) error
+}
+
+// NewScheduledJobConfig contains fields that initiates ScheduledJobDescriber struct.
+type NewScheduledJobConfig struct {
+	App             string
+	Job             string
+	EnableResources bool
+	ConfigStore     ConfigStoreSvc
+	DeployStore     DeployedEnvJobsLister
+}
+
+// NewScheduledJobDescriber instantiates a scheduled job describer.
+func NewScheduledJobDescriber(opt NewScheduledJobConfig) (*ScheduledJobDescriber, error) {
+	describer := &ScheduledJobDescriber{
+		app:             opt.App,
+		job:             opt.Job,
+		enableResources: opt.EnableResources,
+		store:           opt.DeployStore,
+		jobDescriber:    make(map[string]jobDescriber),
+		sfnClient:       make(map[string]stateMachineDescriber),
+	}
+	describer.initJobDescriber = func(env string) error {
+		if _, ok := describer.jobDescriber[env]; ok {
+			return nil
+		}
+		d, err := NewServiceDescriber(NewServiceConfig{
+			App:         opt.App,
+			Env:         env,
+			Svc:         opt.Job,
+			ConfigStore: opt.ConfigStore,
+		})
+		if err != nil {
+			return err
+		}
+		environment, err := opt.ConfigStore.GetEnvironment(opt.App, env)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", env, err)
+		}
+		sess, err := sessions.NewProvider().FromRole(environment.ManagerRoleARN, environment.Region)
+		if err != nil {
+			return err
+		}
+		describer.jobDescriber[env] = d
+		describer.sfnClient[env] = stepfunctions.New(sess)
+		return nil
+	}
+	return describer, nil
+}
+
+// Describe returns info of a scheduled job.
+func (d *ScheduledJobDescriber) Describe() (HumanJSONStringer, error) {
+	environments, err := d.store.ListEnvironmentsJobDeployedTo(d.app, d.job)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
+	}
+
+	var configs []*ScheduledJobConfig
+	var envVars []*EnvVars
+	for _, env := range environments {
+		err := d.initJobDescriber(env)
+		if err != nil {
+			return nil, err
+		}
+		jobParams, err := d.jobDescriber[env].Params()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve job deployment configuration: %w", err)
+		}
+		stateMachine, err := d.stateMachineConfig(env)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, &ScheduledJobConfig{
+			Environment: env,
+			Schedule:    jobParams[stack.ScheduledJobScheduleParamKey],
+			Timeout:     stateMachine.timeout(),
+			Retries:     stateMachine.retries(),
+			CPU:         jobParams[stack.WorkloadTaskCPUParamKey],
+			Memory:      jobParams[stack.WorkloadTaskMemoryParamKey],
+		})
+		jobEnvVars, err := d.jobDescriber[env].EnvVars()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve environment variables: %w", err)
+		}
+		envVars = append(envVars, flattenEnvVars(env, jobEnvVars)...)
+	}
+	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
+	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
+
+	resources := make(map[string][]*CfnResource)
+	if d.enableResources {
+		for _, env := range environments {
+			stackResources, err := d.jobDescriber[env].ServiceStackResources()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve job resources: %w", err)
+			}
+			resources[env] = flattenResources(stackResources)
+		}
+	}
+
+	return &scheduledJobDesc{
+		Job:            d.job,
+		Type:           manifest.ScheduledJobType,
+		App:            d.app,
+		Configurations: configs,
+		Variables:      envVars,
+		Resources:      resources,
+	}, nil
+}
+
+// stateMachineConfig returns the timeout and retries of the job, which are set in the definition of its state machine.
+func (d *ScheduledJobDescriber) stateMachineConfig(env string) (*stateMachineDefinition, error) {
+	arn, err := d.jobDescriber[env].StateMachineARN()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve state machine of job %s: %w", d.job, err)
+	}
+	raw, err := d.sfnClient[env].StateMachineDefinition(arn)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve state machine definition: %w", err)
+	}
+	var definition stateMachineDefinition
+	if err := json.Unmarshal([]byte(raw), &definition); err != nil {
+		return nil, fmt.Errorf("unmarshal state machine definition: %w", err)
+	}
+	return &definition, nil
+}
+
+// stateMachineDefinition holds the fields of the job's state machine definition that are configurable in the manifest.
+type stateMachineDefinition struct {
+	TimeoutSeconds *int `json:"TimeoutSeconds"`
+	States         map[string]struct {
+		Retry []struct {
+			MaxAttempts int `json:"MaxAttempts"`
+		} `json:"Retry"`
+	} `json:"States"`
+}
+
+func (s *stateMachineDefinition) timeout() string {
+	if s.TimeoutSeconds == nil {
+		return ""
+	}
+	return (time.Duration(*s.TimeoutSeconds) * time.Second).String()
+}
+
+func (s *stateMachineDefinition) retries() string {
+	retries := 0
+	for _, state := range s.States {
+		for _, retry := range state.Retry {
+			retries += retry.MaxAttempts
+		}
+	}
+	return strconv.Itoa(retries)
+}
+
+// ScheduledJobConfig contains serialized configuration parameters for a scheduled job.
+type ScheduledJobConfig struct {
+	Environment string `json:"environment"`
+	Schedule    string `json:"schedule"`
+	Timeout     string `json:"timeout,omitempty"`
+	Retries     string `json:"retries"`
+	CPU         string `json:"cpu"`
+	Memory      string `json:"memory"`
+}
+
+type scheduledJobConfigurations []*ScheduledJobConfig
+
+func (c scheduledJobConfigurations) humanString(w io.Writer) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n", "Environment", "Schedule", "Timeout", "Retries", "CPU (vCPU)", "Memory (MiB)")
+	for _, config := range c {
+		timeout := config.Timeout
+		if timeout == "" {
+			timeout = "-"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n", config.Environment, config.Schedule, timeout, config.Retries, cpuToString(config.CPU), config.Memory)
+	}
+}
+
+// scheduledJobDesc contains serialized parameters for a scheduled job.
+type scheduledJobDesc struct {
+	Job            string                     `json:"job"`
+	Type           string                     `json:"type"`
+	App            string                     `json:"application"`
+	Configurations scheduledJobConfigurations `json:"configurations"`
+	Variables      envVars                    `json:"variables"`
+	Resources      cfnResources               `json:"resources,omitempty"`
+}
+
+// JSONString returns the stringified scheduledJobDesc struct with json format.
+func (j *scheduledJobDesc) JSONString() (string, error) {
+	b, err := json.Marshal(j)
+	if err != nil {
+		return "", fmt.Errorf("marshal scheduled job description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified scheduledJobDesc struct with human readable format.
+func (j *scheduledJobDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", j.App)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", j.Job)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Type", j.Type)
+	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
+	writer.Flush()
+	j.Configurations.humanString(writer)
+	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
+	writer.Flush()
+	j.Variables.humanString(writer)
+	if len(j.Resources) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
+		writer.Flush()
+
+		// Go maps don't have a guaranteed order.
+		// Show the resources by the order of environments displayed under Configurations for a consistent view.
+		for _, config := range j.Configurations {
+			fmt.Fprintf(writer, "\n  %s\n", config.Environment)
+			for _, resource := range j.Resources[config.Environment] {
+				fmt.Fprintf(writer, "    %s\t%s\n", resource.Type, resource.PhysicalID)
+			}
+		}
+	}
+	writer.Flush()
+	return b.String()
+}
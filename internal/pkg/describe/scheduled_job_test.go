@@ -0,0 +1,325 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type scheduledJobDescriberMocks struct {
+	storeSvc     *mocks.MockDeployedEnvJobsLister
+	jobDescriber *mocks.MockjobDescriber
+	sfnClient    *mocks.MockstateMachineDescriber
+}
+
+func TestScheduledJobDescriber_Describe(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testJob = "report"
+		prodEnv = "prod"
+
+		testARN = "arn:aws:states:us-west-2:123456789:stateMachine:phonetool-test-report"
+		prodARN = "arn:aws:states:us-west-2:123456789:stateMachine:phonetool-prod-report"
+
+		testDefinition = `{"Version":"1.0","Comment":"Run AWS Fargate task","TimeoutSeconds":5400,"StartAt":"Run Fargate Task","States":{"Run Fargate Task":{"Type":"Task","Resource":"arn:aws:states:::ecs:runTask.sync","Retry":[{"ErrorEquals":["States.ALL"],"IntervalSeconds":10,"MaxAttempts":3,"BackoffRate":1.5}],"End":true}}}`
+		prodDefinition = `{"Version":"1.0","Comment":"Run AWS Fargate task","StartAt":"Run Fargate Task","States":{"Run Fargate Task":{"Type":"Task","Resource":"arn:aws:states:::ecs:runTask.sync","End":true}}}`
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		shouldOutputResources bool
+
+		setupMocks func(mocks scheduledJobDescriberMocks)
+
+		wantedJob   *scheduledJobDesc
+		wantedError error
+	}{
+		"return error if fail to list environment": {
+			setupMocks: func(m scheduledJobDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsJobDeployedTo(testApp, testJob).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list deployed environments for application phonetool: some error"),
+		},
+		"return error if fail to retrieve job deployment configuration": {
+			setupMocks: func(m scheduledJobDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsJobDeployedTo(testApp, testJob).Return([]string{testEnv}, nil),
+					m.jobDescriber.EXPECT().Params().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve job deployment configuration: some error"),
+		},
+		"return error if fail to retrieve the state machine": {
+			setupMocks: func(m scheduledJobDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsJobDeployedTo(testApp, testJob).Return([]string{testEnv}, nil),
+					m.jobDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.jobDescriber.EXPECT().StateMachineARN().Return("", mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve state machine of job report: some error"),
+		},
+		"return error if fail to retrieve the state machine definition": {
+			setupMocks: func(m scheduledJobDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsJobDeployedTo(testApp, testJob).Return([]string{testEnv}, nil),
+					m.jobDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.jobDescriber.EXPECT().StateMachineARN().Return(testARN, nil),
+					m.sfnClient.EXPECT().StateMachineDefinition(testARN).Return("", mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve state machine definition: some error"),
+		},
+		"return error if fail to retrieve environment variables": {
+			setupMocks: func(m scheduledJobDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsJobDeployedTo(testApp, testJob).Return([]string{testEnv}, nil),
+					m.jobDescriber.EXPECT().Params().Return(map[string]string{}, nil),
+					m.jobDescriber.EXPECT().StateMachineARN().Return(testARN, nil),
+					m.sfnClient.EXPECT().StateMachineDefinition(testARN).Return(testDefinition, nil),
+					m.jobDescriber.EXPECT().EnvVars().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment variables: some error"),
+		},
+		"success": {
+			shouldOutputResources: true,
+			setupMocks: func(m scheduledJobDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsJobDeployedTo(testApp, testJob).Return([]string{testEnv, prodEnv}, nil),
+
+					m.jobDescriber.EXPECT().Params().Return(map[string]string{
+						stack.ScheduledJobScheduleParamKey: "rate(1 hour)",
+						stack.WorkloadTaskCPUParamKey:      "256",
+						stack.WorkloadTaskMemoryParamKey:   "512",
+					}, nil),
+					m.jobDescriber.EXPECT().StateMachineARN().Return(testARN, nil),
+					m.sfnClient.EXPECT().StateMachineDefinition(testARN).Return(testDefinition, nil),
+					m.jobDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_ENVIRONMENT_NAME": testEnv,
+						}, nil),
+
+					m.jobDescriber.EXPECT().Params().Return(map[string]string{
+						stack.ScheduledJobScheduleParamKey: "cron(0 9 * * ? *)",
+						stack.WorkloadTaskCPUParamKey:      "512",
+						stack.WorkloadTaskMemoryParamKey:   "1024",
+					}, nil),
+					m.jobDescriber.EXPECT().StateMachineARN().Return(prodARN, nil),
+					m.sfnClient.EXPECT().StateMachineDefinition(prodARN).Return(prodDefinition, nil),
+					m.jobDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_ENVIRONMENT_NAME": prodEnv,
+						}, nil),
+
+					m.jobDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
+						{
+							ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+							PhysicalResourceId: aws.String(testARN),
+						},
+					}, nil),
+					m.jobDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
+						{
+							ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+							PhysicalResourceId: aws.String(prodARN),
+						},
+					}, nil),
+				)
+			},
+			wantedJob: &scheduledJobDesc{
+				Job:  testJob,
+				Type: "Scheduled Job",
+				App:  testApp,
+				Configurations: []*ScheduledJobConfig{
+					{
+						Environment: "test",
+						Schedule:    "rate(1 hour)",
+						Timeout:     "1h30m0s",
+						Retries:     "3",
+						CPU:         "256",
+						Memory:      "512",
+					},
+					{
+						Environment: "prod",
+						Schedule:    "cron(0 9 * * ? *)",
+						Retries:     "0",
+						CPU:         "512",
+						Memory:      "1024",
+					},
+				},
+				Variables: []*EnvVars{
+					{
+						Environment: "prod",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "prod",
+					},
+					{
+						Environment: "test",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "test",
+					},
+				},
+				Resources: map[string][]*CfnResource{
+					"test": {
+						{
+							Type:       "AWS::StepFunctions::StateMachine",
+							PhysicalID: testARN,
+						},
+					},
+					"prod": {
+						{
+							Type:       "AWS::StepFunctions::StateMachine",
+							PhysicalID: prodARN,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := scheduledJobDescriberMocks{
+				storeSvc:     mocks.NewMockDeployedEnvJobsLister(ctrl),
+				jobDescriber: mocks.NewMockjobDescriber(ctrl),
+				sfnClient:    mocks.NewMockstateMachineDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &ScheduledJobDescriber{
+				app:             testApp,
+				job:             testJob,
+				enableResources: tc.shouldOutputResources,
+				store:           m.storeSvc,
+				jobDescriber: map[string]jobDescriber{
+					"test": m.jobDescriber,
+					"prod": m.jobDescriber,
+				},
+				sfnClient: map[string]stateMachineDescriber{
+					"test": m.sfnClient,
+					"prod": m.sfnClient,
+				},
+				initJobDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			job, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedJob, job, "expected output content match")
+			}
+		})
+	}
+}
+
+func TestScheduledJobDesc_String(t *testing.T) {
+	testCases := map[string]struct {
+		wantedHumanString string
+		wantedJSONString  string
+	}{
+		"correct output": {
+			wantedHumanString: `About
+
+  Application       my-app
+  Name              my-job
+  Type              Scheduled Job
+
+Configurations
+
+  Environment       Schedule            Timeout             Retries             CPU (vCPU)          Memory (MiB)
+  test              rate(1 hour)        1h30m0s             3                   0.25                512
+  prod              rate(1 day)         -                   0                   0.5                 1024
+
+Variables
+
+  Name                      Environment         Value
+  COPILOT_ENVIRONMENT_NAME  prod                prod
+  -                         test                test
+
+Resources
+
+  test
+    AWS::StepFunctions::StateMachine  arn:aws:states:us-west-2:123456789:stateMachine:my-app-test-my-job
+
+  prod
+    AWS::StepFunctions::StateMachine  arn:aws:states:us-west-2:123456789:stateMachine:my-app-prod-my-job
+`,
+			wantedJSONString: "{\"job\":\"my-job\",\"type\":\"Scheduled Job\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"schedule\":\"rate(1 hour)\",\"timeout\":\"1h30m0s\",\"retries\":\"3\",\"cpu\":\"256\",\"memory\":\"512\"},{\"environment\":\"prod\",\"schedule\":\"rate(1 day)\",\"retries\":\"0\",\"cpu\":\"512\",\"memory\":\"1024\"}],\"variables\":[{\"environment\":\"prod\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"prod\"},{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"resources\":{\"prod\":[{\"type\":\"AWS::StepFunctions::StateMachine\",\"physicalID\":\"arn:aws:states:us-west-2:123456789:stateMachine:my-app-prod-my-job\"}],\"test\":[{\"type\":\"AWS::StepFunctions::StateMachine\",\"physicalID\":\"arn:aws:states:us-west-2:123456789:stateMachine:my-app-test-my-job\"}]}}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			job := &scheduledJobDesc{
+				Job:  "my-job",
+				Type: "Scheduled Job",
+				App:  "my-app",
+				Configurations: []*ScheduledJobConfig{
+					{
+						Environment: "test",
+						Schedule:    "rate(1 hour)",
+						Timeout:     "1h30m0s",
+						Retries:     "3",
+						CPU:         "256",
+						Memory:      "512",
+					},
+					{
+						Environment: "prod",
+						Schedule:    "rate(1 day)",
+						Retries:     "0",
+						CPU:         "512",
+						Memory:      "1024",
+					},
+				},
+				Variables: []*EnvVars{
+					{
+						Environment: "prod",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "prod",
+					},
+					{
+						Environment: "test",
+						Name:        "COPILOT_ENVIRONMENT_NAME",
+						Value:       "test",
+					},
+				},
+				Resources: map[string][]*CfnResource{
+					"test": {
+						{
+							Type:       "AWS::StepFunctions::StateMachine",
+							PhysicalID: "arn:aws:states:us-west-2:123456789:stateMachine:my-app-test-my-job",
+						},
+					},
+					"prod": {
+						{
+							Type:       "AWS::StepFunctions::StateMachine",
+							PhysicalID: "arn:aws:states:us-west-2:123456789:stateMachine:my-app-prod-my-job",
+						},
+					},
+				},
+			}
+			human := job.HumanString()
+			json, _ := job.JSONString()
+
+			require.Equal(t, tc.wantedHumanString, human)
+			require.Equal(t, tc.wantedJSONString, json)
+		})
+	}
+}
@@ -6,9 +6,12 @@ package describe
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -16,6 +19,11 @@ import (
 )
 
 const (
+	// Logical IDs of resources in the workload stack.
+	taskDefinitionLogicalID = "TaskDefinition"
+	serviceLogicalID        = "Service"
+	stateMachineLogicalID   = "StateMachine"
+
 	// Ignored resources
 	rulePriorityFunction = "Custom::RulePriorityFunction"
 	waitCondition        = "AWS::CloudFormation::WaitCondition"
@@ -26,10 +34,17 @@ type stackAndResourcesDescriber interface {
 	Stack(stackName string) (*cloudformation.Stack, error)
 	StackResources(stackName string) ([]*cloudformation.StackResource, error)
 	Metadata(stackName string) (string, error)
+	Template(stackName string) (string, error)
+	StackEventsPages(stackName string, fn func(events []*cloudformation.StackEvent) bool) error
 }
 
 type ecsClient interface {
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+	Service(clusterName, serviceName string) (*ecs.Service, error)
+}
+
+type ec2Client interface {
+	SecurityGroupRules(groupIDs ...string) ([]*ec2.SecurityGroupRule, error)
 }
 
 // ConfigStoreSvc wraps methods of config store.
@@ -54,6 +69,15 @@ type ServiceConfig struct {
 	Memory      string `json:"memory"`
 }
 
+// ServiceDeployment represents a successful deployment of a task definition for a service.
+type ServiceDeployment struct {
+	TaskDefinition string    `json:"taskDefinition"`
+	Revision       int64     `json:"revision"`
+	Image          string    `json:"image"`
+	ImageTag       string    `json:"imageTag"`
+	DeployedAt     time.Time `json:"deployedAt"`
+}
+
 type configurations []*ServiceConfig
 
 func (c configurations) humanString(w io.Writer) {
@@ -63,6 +87,19 @@ func (c configurations) humanString(w io.Writer) {
 	}
 }
 
+// ServiceTaskConfig holds the configuration of the tasks run by a deployed service.
+type ServiceTaskConfig struct {
+	Image         string
+	TaskRole      string
+	ExecutionRole string
+	EntryPoint    []string
+	EnvVars       map[string]string
+	Secrets       map[string]string // Names or ARNs of SSM parameters or Secrets Manager secrets by environment variable.
+
+	Subnets        []string
+	SecurityGroups []string
+}
+
 // ServiceDescriber retrieves information about a service.
 type ServiceDescriber struct {
 	app     string
@@ -70,6 +107,7 @@ type ServiceDescriber struct {
 	env     string
 
 	ecsClient      ecsClient
+	ec2Client      ec2Client
 	stackDescriber stackAndResourcesDescriber
 }
 
@@ -98,6 +136,7 @@ func NewServiceDescriber(opt NewServiceConfig) (*ServiceDescriber, error) {
 		env:     opt.Env,
 
 		ecsClient:      ecs.New(sess),
+		ec2Client:      ec2.New(sess),
 		stackDescriber: d,
 	}, nil
 }
@@ -136,6 +175,107 @@ func (d *ServiceDescriber) ServiceStackResources() ([]*cloudformation.StackResou
 	return resources, nil
 }
 
+// ServiceARN returns the ARN of the ECS service created by the service stack.
+func (d *ServiceDescriber) ServiceARN() (*ecs.ServiceArn, error) {
+	id, err := d.physicalResourceID(serviceLogicalID)
+	if err != nil {
+		return nil, err
+	}
+	arn := ecs.ServiceArn(id)
+	return &arn, nil
+}
+
+// SecurityGroupRules returns the inbound rules of the security groups attached to the tasks of the service.
+func (d *ServiceDescriber) SecurityGroupRules() ([]*ec2.SecurityGroupRule, error) {
+	svc, err := d.ecsService()
+	if err != nil {
+		return nil, err
+	}
+	if svc.NetworkConfiguration == nil || svc.NetworkConfiguration.AwsvpcConfiguration == nil {
+		return nil, nil
+	}
+	return d.ec2Client.SecurityGroupRules(aws.StringValueSlice(svc.NetworkConfiguration.AwsvpcConfiguration.SecurityGroups)...)
+}
+
+// TaskConfig returns the image, roles, environment variables, secrets and network configuration
+// of the tasks that the ECS service of the service is currently running.
+func (d *ServiceDescriber) TaskConfig() (*ServiceTaskConfig, error) {
+	svc, err := d.ecsService()
+	if err != nil {
+		return nil, err
+	}
+	taskDef, err := d.ecsClient.TaskDefinition(aws.StringValue(svc.TaskDefinition))
+	if err != nil {
+		return nil, err
+	}
+	if len(taskDef.ContainerDefinitions) == 0 {
+		return nil, fmt.Errorf("task definition %s has no containers", aws.StringValue(svc.TaskDefinition))
+	}
+	// The main container is named after the service, the others are sidecars.
+	container := taskDef.ContainerDefinitions[0]
+	for _, c := range taskDef.ContainerDefinitions {
+		if aws.StringValue(c.Name) == d.service {
+			container = c
+			break
+		}
+	}
+	config := &ServiceTaskConfig{
+		Image:         aws.StringValue(container.Image),
+		TaskRole:      aws.StringValue(taskDef.TaskRoleArn),
+		ExecutionRole: aws.StringValue(taskDef.ExecutionRoleArn),
+		EntryPoint:    aws.StringValueSlice(container.EntryPoint),
+		EnvVars:       make(map[string]string),
+		Secrets:       make(map[string]string),
+	}
+	for _, env := range container.Environment {
+		config.EnvVars[aws.StringValue(env.Name)] = aws.StringValue(env.Value)
+	}
+	for _, secret := range container.Secrets {
+		config.Secrets[aws.StringValue(secret.Name)] = aws.StringValue(secret.ValueFrom)
+	}
+	if svc.NetworkConfiguration != nil && svc.NetworkConfiguration.AwsvpcConfiguration != nil {
+		config.Subnets = aws.StringValueSlice(svc.NetworkConfiguration.AwsvpcConfiguration.Subnets)
+		config.SecurityGroups = aws.StringValueSlice(svc.NetworkConfiguration.AwsvpcConfiguration.SecurityGroups)
+	}
+	return config, nil
+}
+
+// ecsService returns the ECS service created by the service stack.
+func (d *ServiceDescriber) ecsService() (*ecs.Service, error) {
+	arn, err := d.ServiceARN()
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := arn.ClusterName()
+	if err != nil {
+		return nil, err
+	}
+	name, err := arn.ServiceName()
+	if err != nil {
+		return nil, err
+	}
+	return d.ecsClient.Service(cluster, name)
+}
+
+// StateMachineARN returns the ARN of the Step Functions state machine created by the stack of a scheduled job.
+func (d *ServiceDescriber) StateMachineARN() (string, error) {
+	return d.physicalResourceID(stateMachineLogicalID)
+}
+
+func (d *ServiceDescriber) physicalResourceID(logicalID string) (string, error) {
+	stackName := stack.NameForService(d.app, d.env, d.service)
+	resources, err := d.stackDescriber.StackResources(stackName)
+	if err != nil {
+		return "", err
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == logicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("cannot find resource %s in stack %s", logicalID, stackName)
+}
+
 // EnvOutputs returns the output of the environment stack.
 func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	envStack, err := d.stackDescriber.Stack(stack.NameForEnv(d.app, d.env))
@@ -149,6 +289,19 @@ func (d *ServiceDescriber) EnvOutputs() (map[string]string, error) {
 	return outputs, nil
 }
 
+// Outputs returns the outputs of the service stack.
+func (d *ServiceDescriber) Outputs() (map[string]string, error) {
+	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
+	if err != nil {
+		return nil, err
+	}
+	outputs := make(map[string]string)
+	for _, out := range svcStack.Outputs {
+		outputs[*out.OutputKey] = *out.OutputValue
+	}
+	return outputs, nil
+}
+
 // Params returns the parameters of the service stack.
 func (d *ServiceDescriber) Params() (map[string]string, error) {
 	svcStack, err := d.stackDescriber.Stack(stack.NameForService(d.app, d.env, d.service))
@@ -161,3 +314,80 @@ func (d *ServiceDescriber) Params() (map[string]string, error) {
 	}
 	return params, nil
 }
+
+// Deployments returns up to limit of the most recent successful deployments of the service's task definition,
+// from newest to oldest. The first deployment is the one that's currently running.
+func (d *ServiceDescriber) Deployments(limit int) ([]ServiceDeployment, error) {
+	stackName := stack.NameForService(d.app, d.env, d.service)
+	var deployments []ServiceDeployment
+	seen := make(map[string]bool)
+	// Events are ordered from newest to oldest, so the last stack status seen tells us
+	// whether the stack operation that updated the task definition succeeded.
+	var nextStackStatus string
+	var taskDefErr error
+	// Only read the pages of events needed to find limit deployments, stacks can have thousands of events.
+	err := d.stackDescriber.StackEventsPages(stackName, func(events []*cloudformation.StackEvent) bool {
+		for _, event := range events {
+			if len(deployments) == limit {
+				return false
+			}
+			if aws.StringValue(event.LogicalResourceId) == stackName {
+				nextStackStatus = aws.StringValue(event.ResourceStatus)
+				continue
+			}
+			if aws.StringValue(event.LogicalResourceId) != taskDefinitionLogicalID {
+				continue
+			}
+			if !isCompleteStatus(aws.StringValue(event.ResourceStatus)) || !isSuccessfulDeploymentStatus(nextStackStatus) {
+				continue
+			}
+			arn := aws.StringValue(event.PhysicalResourceId)
+			if seen[arn] {
+				continue
+			}
+			seen[arn] = true
+			taskDef, err := d.ecsClient.TaskDefinition(arn)
+			if err != nil {
+				taskDefErr = err
+				return false
+			}
+			image := taskDef.ContainerImage(d.service)
+			deployments = append(deployments, ServiceDeployment{
+				TaskDefinition: arn,
+				Revision:       aws.Int64Value(taskDef.Revision),
+				Image:          image,
+				ImageTag:       imageTag(image),
+				DeployedAt:     aws.TimeValue(event.Timestamp),
+			})
+		}
+		return len(deployments) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	if taskDefErr != nil {
+		return nil, taskDefErr
+	}
+	return deployments, nil
+}
+
+func isCompleteStatus(status string) bool {
+	return status == cloudformation.ResourceStatusCreateComplete || status == cloudformation.ResourceStatusUpdateComplete
+}
+
+func isSuccessfulDeploymentStatus(status string) bool {
+	switch status {
+	case cloudformation.StackStatusCreateComplete, cloudformation.StackStatusUpdateCompleteCleanupInProgress, cloudformation.StackStatusUpdateComplete:
+		return true
+	}
+	return false
+}
+
+// imageTag returns the tag of an image URI, or the empty string if the image isn't tagged.
+func imageTag(image string) string {
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.LastIndex(name, ":"); i != -1 {
+		return name[i+1:]
+	}
+	return ""
+}
@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
@@ -21,6 +23,7 @@ import (
 type svcDescriberMocks struct {
 	mockStackDescriber *mocks.MockstackAndResourcesDescriber
 	mockecsClient      *mocks.MockecsClient
+	mockec2Client      *mocks.Mockec2Client
 }
 
 func TestServiceDescriber_EnvVars(t *testing.T) {
@@ -201,3 +204,481 @@ func TestServiceDescriber_ServiceStackResources(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDescriber_ServiceARN(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "api"
+	)
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedARN   ecs.ServiceArn
+		wantedError error
+	}{
+		"returns error when fail to describe stack resources": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns error if the stack doesn't have an ECS service": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TaskDefinition"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:1"),
+					},
+				}, nil)
+			},
+
+			wantedError: fmt.Errorf("cannot find resource Service in stack phonetool-test-api"),
+		},
+		"returns the ARN of the ECS service": {
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TaskDefinition"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:1"),
+					},
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1234567890:service/phonetool-test-Cluster-9F7Y0RLP60R7/phonetool-test-api-Service-JSOH5GYBFAIB"),
+					},
+				}, nil)
+			},
+
+			wantedARN: ecs.ServiceArn("arn:aws:ecs:us-west-2:1234567890:service/phonetool-test-Cluster-9F7Y0RLP60R7/phonetool-test-api-Service-JSOH5GYBFAIB"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			tc.setupMocks(svcDescriberMocks{
+				mockStackDescriber: mockStackDescriber,
+			})
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			arn, err := d.ServiceARN()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, *arn)
+		})
+	}
+}
+
+func TestServiceDescriber_SecurityGroupRules(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "api"
+	)
+	mockResources := []*cloudformation.StackResource{
+		{
+			LogicalResourceId:  aws.String("Service"),
+			PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1234567890:service/phonetool-test-Cluster/phonetool-test-api-Service"),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedRules []*ec2.SecurityGroupRule
+		wantedError error
+	}{
+		"returns error when fail to describe the ECS service": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(mockResources, nil),
+					m.mockecsClient.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns the rules of the security groups of the tasks": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(mockResources, nil),
+					m.mockecsClient.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(&ecs.Service{
+						NetworkConfiguration: &ecsapi.NetworkConfiguration{
+							AwsvpcConfiguration: &ecsapi.AwsVpcConfiguration{
+								SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+							},
+						},
+					}, nil),
+					m.mockec2Client.EXPECT().SecurityGroupRules("sg-1", "sg-2").Return([]*ec2.SecurityGroupRule{
+						{GroupID: "sg-2", Protocol: "tcp", FromPort: 80, ToPort: 80, Source: "sg-3"},
+					}, nil),
+				)
+			},
+
+			wantedRules: []*ec2.SecurityGroupRule{
+				{GroupID: "sg-2", Protocol: "tcp", FromPort: 80, ToPort: 80, Source: "sg-3"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcDescriberMocks{
+				mockStackDescriber: mocks.NewMockstackAndResourcesDescriber(ctrl),
+				mockecsClient:      mocks.NewMockecsClient(ctrl),
+				mockec2Client:      mocks.NewMockec2Client(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				ecsClient:      m.mockecsClient,
+				ec2Client:      m.mockec2Client,
+				stackDescriber: m.mockStackDescriber,
+			}
+
+			// WHEN
+			rules, err := d.SecurityGroupRules()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedRules, rules)
+		})
+	}
+}
+
+func TestServiceDescriber_TaskConfig(t *testing.T) {
+	const (
+		testApp        = "phonetool"
+		testEnv        = "test"
+		testSvc        = "api"
+		testTaskDefARN = "arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:3"
+	)
+	mockResources := []*cloudformation.StackResource{
+		{
+			LogicalResourceId:  aws.String("Service"),
+			PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1234567890:service/phonetool-test-Cluster/phonetool-test-api-Service"),
+		},
+	}
+	mockService := &ecs.Service{
+		TaskDefinition: aws.String(testTaskDefARN),
+		NetworkConfiguration: &ecsapi.NetworkConfiguration{
+			AwsvpcConfiguration: &ecsapi.AwsVpcConfiguration{
+				Subnets:        aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedConfig *ServiceTaskConfig
+		wantedError  error
+	}{
+		"returns error when fail to describe the task definition": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(mockResources, nil),
+					m.mockecsClient.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(mockService, nil),
+					m.mockecsClient.EXPECT().TaskDefinition(testTaskDefARN).Return(nil, errors.New("some error")),
+				)
+			},
+
+			wantedError: fmt.Errorf("some error"),
+		},
+		"returns the configuration of the main container and the network of the tasks": {
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackResources(stack.NameForService(testApp, testEnv, testSvc)).Return(mockResources, nil),
+					m.mockecsClient.EXPECT().Service("phonetool-test-Cluster", "phonetool-test-api-Service").Return(mockService, nil),
+					m.mockecsClient.EXPECT().TaskDefinition(testTaskDefARN).Return(&ecs.TaskDefinition{
+						TaskRoleArn:      aws.String("task-role"),
+						ExecutionRoleArn: aws.String("execution-role"),
+						ContainerDefinitions: []*ecsapi.ContainerDefinition{
+							{
+								Name:  aws.String("firelens_log_router"),
+								Image: aws.String("amazon/aws-for-fluent-bit"),
+							},
+							{
+								Name:       aws.String(testSvc),
+								Image:      aws.String("1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1"),
+								EntryPoint: aws.StringSlice([]string{"/bin/sh", "-c"}),
+								Environment: []*ecsapi.KeyValuePair{
+									{Name: aws.String("COPILOT_SERVICE_NAME"), Value: aws.String(testSvc)},
+								},
+								Secrets: []*ecsapi.Secret{
+									{Name: aws.String("DB_PASSWORD"), ValueFrom: aws.String("/phonetool/test/db-password")},
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+
+			wantedConfig: &ServiceTaskConfig{
+				Image:         "1234567890.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+				TaskRole:      "task-role",
+				ExecutionRole: "execution-role",
+				EntryPoint:    []string{"/bin/sh", "-c"},
+				EnvVars: map[string]string{
+					"COPILOT_SERVICE_NAME": testSvc,
+				},
+				Secrets: map[string]string{
+					"DB_PASSWORD": "/phonetool/test/db-password",
+				},
+				Subnets:        []string{"subnet-1", "subnet-2"},
+				SecurityGroups: []string{"sg-1", "sg-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := svcDescriberMocks{
+				mockStackDescriber: mocks.NewMockstackAndResourcesDescriber(ctrl),
+				mockecsClient:      mocks.NewMockecsClient(ctrl),
+				mockec2Client:      mocks.NewMockec2Client(ctrl),
+			}
+			tc.setupMocks(m)
+
+			d := &ServiceDescriber{
+				app:            testApp,
+				service:        testSvc,
+				env:            testEnv,
+				ecsClient:      m.mockecsClient,
+				ec2Client:      m.mockec2Client,
+				stackDescriber: m.mockStackDescriber,
+			}
+
+			// WHEN
+			config, err := d.TaskConfig()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedConfig, config)
+		})
+	}
+}
+
+func TestServiceDescriber_Deployments(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testSvc = "api"
+		testEnv = "test"
+
+		testStackName = "phonetool-test-api"
+		testTaskDefV1 = "arn:aws:ecs:us-west-2:1234:task-definition/phonetool-test-api:1"
+		testTaskDefV2 = "arn:aws:ecs:us-west-2:1234:task-definition/phonetool-test-api:2"
+		testTaskDefV3 = "arn:aws:ecs:us-west-2:1234:task-definition/phonetool-test-api:3"
+	)
+	testTime := time.Date(2020, time.December, 1, 10, 0, 0, 0, time.UTC)
+	stackEvent := func(logicalID, physicalID, status string, minutes int) *cloudformation.StackEvent {
+		return &cloudformation.StackEvent{
+			LogicalResourceId:  aws.String(logicalID),
+			PhysicalResourceId: aws.String(physicalID),
+			ResourceStatus:     aws.String(status),
+			Timestamp:          aws.Time(testTime.Add(time.Duration(minutes) * time.Minute)),
+		}
+	}
+	taskDef := func(revision int64, image string) *ecs.TaskDefinition {
+		return &ecs.TaskDefinition{
+			Revision: aws.Int64(revision),
+			ContainerDefinitions: []*ecsapi.ContainerDefinition{
+				{
+					Name:  aws.String(testSvc),
+					Image: aws.String(image),
+				},
+			},
+		}
+	}
+	// eventPages returns a fake StackEventsPages that passes each page to the callback until it returns false.
+	eventPages := func(pages ...[]*cloudformation.StackEvent) func(string, func([]*cloudformation.StackEvent) bool) error {
+		return func(_ string, fn func([]*cloudformation.StackEvent) bool) error {
+			for _, page := range pages {
+				if !fn(page) {
+					return nil
+				}
+			}
+			return nil
+		}
+	}
+	testCases := map[string]struct {
+		inLimit    int
+		setupMocks func(mocks svcDescriberMocks)
+
+		wantedDeployments []ServiceDeployment
+		wantedError       error
+	}{
+		"returns error if fails to get stack events": {
+			inLimit: 10,
+			setupMocks: func(m svcDescriberMocks) {
+				m.mockStackDescriber.EXPECT().StackEventsPages(testStackName, gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"skips task definitions that were rolled back": {
+			inLimit: 10,
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackEventsPages(testStackName, gomock.Any()).DoAndReturn(eventPages([]*cloudformation.StackEvent{
+						// Failed deployment of v3 that rolled back to v2.
+						stackEvent(testStackName, "", cloudformation.StackStatusUpdateRollbackComplete, 7),
+						stackEvent(testStackName, "", cloudformation.StackStatusUpdateRollbackCompleteCleanupInProgress, 6),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV2, cloudformation.ResourceStatusUpdateComplete, 5),
+						stackEvent(testStackName, "", cloudformation.StackStatusUpdateRollbackInProgress, 4),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV3, cloudformation.ResourceStatusUpdateComplete, 4),
+						// Successful deployment of v2.
+						stackEvent(testStackName, "", cloudformation.StackStatusUpdateComplete, 3),
+						stackEvent(testStackName, "", cloudformation.StackStatusUpdateCompleteCleanupInProgress, 2),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV2, cloudformation.ResourceStatusUpdateComplete, 2),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV2, cloudformation.ResourceStatusUpdateInProgress, 1),
+						// Creation of v1.
+						stackEvent(testStackName, "", cloudformation.StackStatusCreateComplete, 1),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV1, cloudformation.ResourceStatusCreateComplete, 0),
+					})),
+					m.mockecsClient.EXPECT().TaskDefinition(testTaskDefV2).Return(taskDef(2, "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2"), nil),
+					m.mockecsClient.EXPECT().TaskDefinition(testTaskDefV1).Return(taskDef(1, "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1"), nil),
+				)
+			},
+
+			wantedDeployments: []ServiceDeployment{
+				{
+					TaskDefinition: testTaskDefV2,
+					Revision:       2,
+					Image:          "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2",
+					ImageTag:       "v2",
+					DeployedAt:     testTime.Add(2 * time.Minute),
+				},
+				{
+					TaskDefinition: testTaskDefV1,
+					Revision:       1,
+					Image:          "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+					ImageTag:       "v1",
+					DeployedAt:     testTime,
+				},
+			},
+		},
+		"stops reading events once limit deployments are found": {
+			inLimit: 1,
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackEventsPages(testStackName, gomock.Any()).DoAndReturn(
+						func(_ string, fn func([]*cloudformation.StackEvent) bool) error {
+							if fn([]*cloudformation.StackEvent{
+								stackEvent(testStackName, "", cloudformation.StackStatusUpdateComplete, 3),
+								stackEvent(taskDefinitionLogicalID, testTaskDefV2, cloudformation.ResourceStatusUpdateComplete, 2),
+							}) {
+								return errors.New("read the next page")
+							}
+							return nil
+						}),
+					m.mockecsClient.EXPECT().TaskDefinition(testTaskDefV2).Return(taskDef(2, "nginx"), nil),
+				)
+			},
+
+			wantedDeployments: []ServiceDeployment{
+				{
+					TaskDefinition: testTaskDefV2,
+					Revision:       2,
+					Image:          "nginx",
+					DeployedAt:     testTime.Add(2 * time.Minute),
+				},
+			},
+		},
+		"returns at most limit deployments": {
+			inLimit: 1,
+			setupMocks: func(m svcDescriberMocks) {
+				gomock.InOrder(
+					m.mockStackDescriber.EXPECT().StackEventsPages(testStackName, gomock.Any()).DoAndReturn(eventPages([]*cloudformation.StackEvent{
+						stackEvent(testStackName, "", cloudformation.StackStatusUpdateComplete, 3),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV2, cloudformation.ResourceStatusUpdateComplete, 2),
+						stackEvent(testStackName, "", cloudformation.StackStatusCreateComplete, 1),
+						stackEvent(taskDefinitionLogicalID, testTaskDefV1, cloudformation.ResourceStatusCreateComplete, 0),
+					})),
+					m.mockecsClient.EXPECT().TaskDefinition(testTaskDefV2).Return(taskDef(2, "nginx"), nil),
+				)
+			},
+
+			wantedDeployments: []ServiceDeployment{
+				{
+					TaskDefinition: testTaskDefV2,
+					Revision:       2,
+					Image:          "nginx",
+					DeployedAt:     testTime.Add(2 * time.Minute),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockecsClient := mocks.NewMockecsClient(ctrl)
+			mockStackDescriber := mocks.NewMockstackAndResourcesDescriber(ctrl)
+			mocks := svcDescriberMocks{
+				mockecsClient:      mockecsClient,
+				mockStackDescriber: mockStackDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &ServiceDescriber{
+				app:     testApp,
+				service: testSvc,
+				env:     testEnv,
+
+				ecsClient:      mockecsClient,
+				stackDescriber: mockStackDescriber,
+			}
+
+			// WHEN
+			actual, err := d.Deployments(tc.inLimit)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDeployments, actual)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ type cfnStackDescriber interface {
 	DescribeStacks(input *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error)
 	DescribeStackResources(input *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error)
 	GetTemplateSummary(in *cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error)
+	GetTemplate(in *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error)
+	DescribeStackEvents(input *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error)
 }
 
 // stackDescriber retrieves information of a CloudFormation Stack.
@@ -64,3 +66,36 @@ func (d *stackDescriber) Metadata(stackName string) (string, error) {
 	}
 	return aws.StringValue(out.Metadata), nil
 }
+
+// Template returns the body of the CloudFormation stack's template.
+func (d *stackDescriber) Template(stackName string) (string, error) {
+	out, err := d.stackDescribers.GetTemplate(&cloudformation.GetTemplateInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get template for stack %s: %w", stackName, err)
+	}
+	return aws.StringValue(out.TemplateBody), nil
+}
+
+// StackEventsPages iterates over the pages of the stack's events, from newest to oldest.
+// It stops reading pages once fn returns false.
+func (d *stackDescriber) StackEventsPages(stackName string, fn func(events []*cloudformation.StackEvent) bool) error {
+	var nextToken *string
+	for {
+		out, err := d.stackDescribers.DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+		})
+		if err != nil {
+			return fmt.Errorf("describe events for stack %s: %w", stackName, err)
+		}
+		if !fn(out.StackEvents) {
+			return nil
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			return nil
+		}
+	}
+}
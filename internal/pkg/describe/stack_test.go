@@ -229,3 +229,113 @@ func TestStackDescriber_Metadata(t *testing.T) {
 		})
 	}
 }
+
+func TestStackDescriber_StackEventsPages(t *testing.T) {
+	testCases := map[string]struct {
+		stackName string
+		maxEvents int
+		mockCFN   func(ctrl *gomock.Controller) cfnStackDescriber
+
+		wantedEvents []*cloudformation.StackEvent
+		wantedErr    error
+	}{
+		"should wrap cfn error": {
+			stackName: "phonetool-test-api",
+			mockCFN: func(ctrl *gomock.Controller) cfnStackDescriber {
+				m := mocks.NewMockcfnStackDescriber(ctrl)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+
+			wantedErr: errors.New("describe events for stack phonetool-test-api: some error"),
+		},
+		"should retrieve events from all pages": {
+			stackName: "phonetool-test-api",
+			mockCFN: func(ctrl *gomock.Controller) cfnStackDescriber {
+				m := mocks.NewMockcfnStackDescriber(ctrl)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+						StackName: aws.String("phonetool-test-api"),
+					}).Return(&cloudformation.DescribeStackEventsOutput{
+						StackEvents: []*cloudformation.StackEvent{
+							{
+								EventId: aws.String("2"),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+						NextToken: aws.String("token"),
+						StackName: aws.String("phonetool-test-api"),
+					}).Return(&cloudformation.DescribeStackEventsOutput{
+						StackEvents: []*cloudformation.StackEvent{
+							{
+								EventId: aws.String("1"),
+							},
+						},
+					}, nil),
+				)
+				return m
+			},
+
+			wantedEvents: []*cloudformation.StackEvent{
+				{
+					EventId: aws.String("2"),
+				},
+				{
+					EventId: aws.String("1"),
+				},
+			},
+		},
+		"should stop reading pages once the callback returns false": {
+			stackName: "phonetool-test-api",
+			maxEvents: 1,
+			mockCFN: func(ctrl *gomock.Controller) cfnStackDescriber {
+				m := mocks.NewMockcfnStackDescriber(ctrl)
+				m.EXPECT().DescribeStackEvents(&cloudformation.DescribeStackEventsInput{
+					StackName: aws.String("phonetool-test-api"),
+				}).Return(&cloudformation.DescribeStackEventsOutput{
+					StackEvents: []*cloudformation.StackEvent{
+						{
+							EventId: aws.String("2"),
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				return m
+			},
+
+			wantedEvents: []*cloudformation.StackEvent{
+				{
+					EventId: aws.String("2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			d := &stackDescriber{
+				stackDescribers: tc.mockCFN(ctrl),
+			}
+
+			// WHEN
+			var actual []*cloudformation.StackEvent
+			err := d.StackEventsPages(tc.stackName, func(events []*cloudformation.StackEvent) bool {
+				actual = append(actual, events...)
+				return tc.maxEvents == 0 || len(actual) < tc.maxEvents
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEvents, actual)
+			}
+		})
+	}
+}
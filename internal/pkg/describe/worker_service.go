@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// WorkerServiceDescriber retrieves information about a worker service.
+type WorkerServiceDescriber struct {
+	app             string
+	svc             string
+	enableResources bool
+
+	store                DeployedEnvServicesLister
+	svcDescriber         map[string]svcDescriber
+	initServiceDescriber func(string) error
+}
+
+// NewWorkerServiceConfig contains fields that initiates WorkerServiceDescriber struct.
+type NewWorkerServiceConfig struct {
+	NewServiceConfig
+	EnableResources bool
+	DeployStore     DeployedEnvServicesLister
+}
+
+// NewWorkerServiceDescriber instantiates a worker service describer.
+func NewWorkerServiceDescriber(opt NewWorkerServiceConfig) (*WorkerServiceDescriber, error) {
+	describer := &WorkerServiceDescriber{
+		app:             opt.App,
+		svc:             opt.Svc,
+		enableResources: opt.EnableResources,
+		store:           opt.DeployStore,
+		svcDescriber:    make(map[string]svcDescriber),
+	}
+	describer.initServiceDescriber = func(env string) error {
+		if _, ok := describer.svcDescriber[env]; ok {
+			return nil
+		}
+		d, err := NewServiceDescriber(NewServiceConfig{
+			App:         opt.App,
+			Env:         env,
+			Svc:         opt.Svc,
+			ConfigStore: opt.ConfigStore,
+		})
+		if err != nil {
+			return err
+		}
+		describer.svcDescriber[env] = d
+		return nil
+	}
+	return describer, nil
+}
+
+// Describe returns info of a worker service.
+func (d *WorkerServiceDescriber) Describe() (HumanJSONStringer, error) {
+	environments, err := d.store.ListEnvironmentsDeployedTo(d.app, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
+	}
+
+	var configs []*ServiceConfig
+	var envVars []*EnvVars
+	var publishers []*Topic
+	var rules []*SecurityGroupRule
+	for _, env := range environments {
+		err := d.initServiceDescriber(env)
+		if err != nil {
+			return nil, err
+		}
+		svcParams, err := d.svcDescriber[env].Params()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service deployment configuration: %w", err)
+		}
+		configs = append(configs, &ServiceConfig{
+			Environment: env,
+			Port:        "-", // Worker services don't receive traffic.
+			Tasks:       svcParams[stack.WorkloadTaskCountParamKey],
+			CPU:         svcParams[stack.WorkloadTaskCPUParamKey],
+			Memory:      svcParams[stack.WorkloadTaskMemoryParamKey],
+		})
+		workerSvcEnvVars, err := d.svcDescriber[env].EnvVars()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve environment variables: %w", err)
+		}
+		envVars = append(envVars, flattenEnvVars(env, workerSvcEnvVars)...)
+		envTopics, err := flattenTopics(env, workerSvcEnvVars)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve topics: %w", err)
+		}
+		publishers = append(publishers, envTopics...)
+		envRules, err := d.svcDescriber[env].SecurityGroupRules()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve security group rules: %w", err)
+		}
+		rules = append(rules, flattenSecurityGroupRules(env, envRules)...)
+	}
+	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Environment < envVars[j].Environment })
+	sort.SliceStable(envVars, func(i, j int) bool { return envVars[i].Name < envVars[j].Name })
+
+	resources := make(map[string][]*CfnResource)
+	if d.enableResources {
+		for _, env := range environments {
+			err := d.initServiceDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			stackResources, err := d.svcDescriber[env].ServiceStackResources()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve service resources: %w", err)
+			}
+			resources[env] = flattenResources(stackResources)
+		}
+	}
+
+	return &workerSvcDesc{
+		Service:            d.svc,
+		Type:               manifest.WorkerServiceType,
+		App:                d.app,
+		Configurations:     configs,
+		Variables:          envVars,
+		Topics:             publishers,
+		SecurityGroupRules: rules,
+		Resources:          resources,
+	}, nil
+}
+
+// workerSvcDesc contains serialized parameters for a worker service.
+type workerSvcDesc struct {
+	Service            string             `json:"service"`
+	Type               string             `json:"type"`
+	App                string             `json:"application"`
+	Configurations     configurations     `json:"configurations"`
+	Variables          envVars            `json:"variables"`
+	Topics             topics             `json:"topics,omitempty"`
+	SecurityGroupRules securityGroupRules `json:"securityGroupRules,omitempty"`
+	Resources          cfnResources       `json:"resources,omitempty"`
+}
+
+// JSONString returns the stringified workerService struct with json format.
+func (w *workerSvcDesc) JSONString() (string, error) {
+	b, err := json.Marshal(w)
+	if err != nil {
+		return "", fmt.Errorf("marshal worker service description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified workerService struct with human readable format.
+func (w *workerSvcDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", w.App)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", w.Service)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Type", w.Type)
+	fmt.Fprint(writer, color.Bold.Sprint("\nConfigurations\n\n"))
+	writer.Flush()
+	w.Configurations.humanString(writer)
+	fmt.Fprint(writer, color.Bold.Sprint("\nVariables\n\n"))
+	writer.Flush()
+	w.Variables.humanString(writer)
+	if len(w.Topics) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nPublishes To\n\n"))
+		writer.Flush()
+		w.Topics.humanString(writer)
+	}
+	if len(w.SecurityGroupRules) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecurity Group Rules\n\n"))
+		writer.Flush()
+		w.SecurityGroupRules.humanString(writer)
+	}
+	if len(w.Resources) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
+		writer.Flush()
+
+		// Go maps don't have a guaranteed order.
+		// Show the resources by the order of environments displayed under Configurations for a consistent view.
+		w.Resources.humanStringByEnv(writer, w.Configurations)
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,228 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type workerSvcDescriberMocks struct {
+	storeSvc     *mocks.MockDeployedEnvServicesLister
+	svcDescriber *mocks.MocksvcDescriber
+}
+
+func TestWorkerServiceDescriber_Describe(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testEnv = "test"
+		testSvc = "processor"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		shouldOutputResources bool
+
+		setupMocks func(mocks workerSvcDescriberMocks)
+
+		wantedWorkerSvc *workerSvcDesc
+		wantedError     error
+	}{
+		"return error if fail to list environment": {
+			setupMocks: func(m workerSvcDescriberMocks) {
+				m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list deployed environments for application phonetool: some error"),
+		},
+		"return error if fail to retrieve environment variables": {
+			setupMocks: func(m workerSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.WorkloadTaskCountParamKey:  "1",
+						stack.WorkloadTaskCPUParamKey:    "256",
+						stack.WorkloadTaskMemoryParamKey: "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(nil, mockErr),
+				)
+			},
+			wantedError: fmt.Errorf("retrieve environment variables: some error"),
+		},
+		"success": {
+			shouldOutputResources: true,
+			setupMocks: func(m workerSvcDescriberMocks) {
+				gomock.InOrder(
+					m.storeSvc.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{testEnv}, nil),
+					m.svcDescriber.EXPECT().Params().Return(map[string]string{
+						stack.WorkloadTaskCountParamKey:  "1",
+						stack.WorkloadTaskCPUParamKey:    "256",
+						stack.WorkloadTaskMemoryParamKey: "512",
+					}, nil),
+					m.svcDescriber.EXPECT().EnvVars().Return(
+						map[string]string{
+							"COPILOT_QUEUE_URI":      "https://sqs.us-west-2.amazonaws.com/1234/queue",
+							"COPILOT_SNS_TOPIC_ARNS": `{"processed":"arn:aws:sns:us-west-2:1234:phonetool-test-processor-processed","failed":"arn:aws:sns:us-west-2:1234:phonetool-test-processor-failed"}`,
+						}, nil),
+					m.svcDescriber.EXPECT().SecurityGroupRules().Return(nil, nil),
+					m.svcDescriber.EXPECT().ServiceStackResources().Return([]*cloudformation.StackResource{
+						{
+							ResourceType:       aws.String("AWS::SQS::Queue"),
+							PhysicalResourceId: aws.String("https://sqs.us-west-2.amazonaws.com/1234/queue"),
+						},
+					}, nil),
+				)
+			},
+			wantedWorkerSvc: &workerSvcDesc{
+				Service: testSvc,
+				Type:    "Worker Service",
+				App:     testApp,
+				Configurations: []*ServiceConfig{
+					{
+						CPU:         "256",
+						Environment: "test",
+						Memory:      "512",
+						Port:        "-",
+						Tasks:       "1",
+					},
+				},
+				Variables: []*EnvVars{
+					{
+						Environment: "test",
+						Name:        "COPILOT_QUEUE_URI",
+						Value:       "https://sqs.us-west-2.amazonaws.com/1234/queue",
+					},
+					{
+						Environment: "test",
+						Name:        "COPILOT_SNS_TOPIC_ARNS",
+						Value:       `{"processed":"arn:aws:sns:us-west-2:1234:phonetool-test-processor-processed","failed":"arn:aws:sns:us-west-2:1234:phonetool-test-processor-failed"}`,
+					},
+				},
+				Topics: []*Topic{
+					{
+						Environment: "test",
+						Name:        "failed",
+						ARN:         "arn:aws:sns:us-west-2:1234:phonetool-test-processor-failed",
+					},
+					{
+						Environment: "test",
+						Name:        "processed",
+						ARN:         "arn:aws:sns:us-west-2:1234:phonetool-test-processor-processed",
+					},
+				},
+				Resources: map[string][]*CfnResource{
+					"test": {
+						{
+							Type:       "AWS::SQS::Queue",
+							PhysicalID: "https://sqs.us-west-2.amazonaws.com/1234/queue",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
+			mockSvcDescriber := mocks.NewMocksvcDescriber(ctrl)
+			mocks := workerSvcDescriberMocks{
+				storeSvc:     mockStore,
+				svcDescriber: mockSvcDescriber,
+			}
+
+			tc.setupMocks(mocks)
+
+			d := &WorkerServiceDescriber{
+				app:             testApp,
+				svc:             testSvc,
+				enableResources: tc.shouldOutputResources,
+				store:           mockStore,
+				svcDescriber: map[string]svcDescriber{
+					"test": mockSvcDescriber,
+				},
+				initServiceDescriber: func(string) error { return nil },
+			}
+
+			// WHEN
+			workersvc, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedWorkerSvc, workersvc, "expected output content match")
+			}
+		})
+	}
+}
+
+func TestWorkerSvcDesc_String(t *testing.T) {
+	wantedHumanString := `About
+
+  Application       my-app
+  Name              my-svc
+  Type              Worker Service
+
+Configurations
+
+  Environment       Tasks               CPU (vCPU)          Memory (MiB)        Port
+  test              1                   0.25                512                 -
+
+Variables
+
+  Name                      Environment         Value
+  COPILOT_ENVIRONMENT_NAME  test                test
+
+Publishes To
+
+  Name              Environment         ARN
+  processed         test                arn:aws:sns:us-west-2:1234:my-app-test-my-svc-processed
+`
+	wantedJSONString := "{\"service\":\"my-svc\",\"type\":\"Worker Service\",\"application\":\"my-app\",\"configurations\":[{\"environment\":\"test\",\"port\":\"-\",\"tasks\":\"1\",\"cpu\":\"256\",\"memory\":\"512\"}],\"variables\":[{\"environment\":\"test\",\"name\":\"COPILOT_ENVIRONMENT_NAME\",\"value\":\"test\"}],\"topics\":[{\"environment\":\"test\",\"name\":\"processed\",\"arn\":\"arn:aws:sns:us-west-2:1234:my-app-test-my-svc-processed\"}]}\n"
+	workerSvc := &workerSvcDesc{
+		Service: "my-svc",
+		Type:    "Worker Service",
+		App:     "my-app",
+		Configurations: []*ServiceConfig{
+			{
+				CPU:         "256",
+				Environment: "test",
+				Memory:      "512",
+				Port:        "-",
+				Tasks:       "1",
+			},
+		},
+		Variables: []*EnvVars{
+			{
+				Environment: "test",
+				Name:        "COPILOT_ENVIRONMENT_NAME",
+				Value:       "test",
+			},
+		},
+		Topics: []*Topic{
+			{
+				Environment: "test",
+				Name:        "processed",
+				ARN:         "arn:aws:sns:us-west-2:1234:my-app-test-my-svc-processed",
+			},
+		},
+	}
+
+	human := workerSvc.HumanString()
+	json, _ := workerSvc.JSONString()
+
+	require.Equal(t, wantedHumanString, human)
+	require.Equal(t, wantedJSONString, json)
+}
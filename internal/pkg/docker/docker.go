@@ -138,6 +138,89 @@ func (r Runner) Push(uri, imageTag string, additionalTags ...string) error {
 	return nil
 }
 
+// RunOptions holds the options for running a container locally.
+type RunOptions struct {
+	ImageURI      string            // Required. The image to run, including its tag.
+	ContainerName string            // Required. Name of the container.
+	Network       string            // Optional. Name of the network to connect the container to.
+	NetworkAlias  string            // Optional. Hostname of the container within the network.
+	Ports         map[string]string // Optional. Container ports published to the host, keyed by host port.
+	EnvVars       map[string]string // Optional. Environment variables to set in the container.
+	Secrets       map[string]string // Optional. Secrets to set in the container, passed by name so that their values aren't visible in the process list.
+	Detach        bool              // Optional. Run the container in the background.
+}
+
+// RunContainer runs a `docker run` command with the input options.
+// The container is removed once it stops.
+func (r Runner) RunContainer(in *RunOptions) error {
+	args := []string{"run", "--rm", "--name", in.ContainerName}
+	if in.Detach {
+		args = append(args, "--detach")
+	}
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+	}
+	if in.NetworkAlias != "" {
+		args = append(args, "--network-alias", in.NetworkAlias)
+	}
+	for _, hostPort := range sortedKeys(in.Ports) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, in.Ports[hostPort]))
+	}
+	for _, k := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, in.EnvVars[k]))
+	}
+	var secrets []string
+	for _, k := range sortedKeys(in.Secrets) {
+		// Docker reads the value of a variable given without "=" from its own environment.
+		args = append(args, "--env", k)
+		secrets = append(secrets, fmt.Sprintf("%s=%s", k, in.Secrets[k]))
+	}
+	args = append(args, in.ImageURI)
+
+	var opts []command.Option
+	if len(secrets) != 0 {
+		opts = append(opts, command.Env(secrets))
+	}
+	if err := r.Run("docker", args, opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", in.ContainerName, err)
+	}
+	return nil
+}
+
+// StopContainer will run `docker stop` command against the container with the input name.
+func (r Runner) StopContainer(name string) error {
+	if err := r.Run("docker", []string{"stop", name}); err != nil {
+		return fmt.Errorf("stop container %s: %w", name, err)
+	}
+	return nil
+}
+
+// CreateNetwork will run `docker network create` command to create a bridge network with the input name.
+func (r Runner) CreateNetwork(name string) error {
+	if err := r.Run("docker", []string{"network", "create", name}); err != nil {
+		return fmt.Errorf("create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveNetwork will run `docker network rm` command against the network with the input name.
+func (r Runner) RemoveNetwork(name string) error {
+	if err := r.Run("docker", []string{"network", "rm", name}); err != nil {
+		return fmt.Errorf("remove network %s: %w", name, err)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	// Collect the keys in a slice to sort for test stability.
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
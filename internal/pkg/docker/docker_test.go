@@ -227,3 +227,102 @@ func TestPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRunContainer(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	var mockRunner *mocks.Mockrunner
+
+	tests := map[string]struct {
+		in         *RunOptions
+		setupMocks func(controller *gomock.Controller)
+
+		want error
+	}{
+		"wrap error returned from Run()": {
+			in: &RunOptions{
+				ImageURI:      "phonetool/api:local",
+				ContainerName: "phonetool-test-api",
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+				mockRunner.EXPECT().Run("docker", []string{"run", "--rm", "--name", "phonetool-test-api", "phonetool/api:local"}).Return(mockError)
+			},
+			want: fmt.Errorf("run container phonetool-test-api: %w", mockError),
+		},
+		"runs the container with all options": {
+			in: &RunOptions{
+				ImageURI:      "phonetool/api:local",
+				ContainerName: "phonetool-test-api",
+				Network:       "phonetool-test-api",
+				NetworkAlias:  "api",
+				Ports: map[string]string{
+					"8080": "8080",
+				},
+				EnvVars: map[string]string{
+					"LOG_LEVEL":                "info",
+					"COPILOT_APPLICATION_NAME": "phonetool",
+				},
+				Secrets: map[string]string{
+					"GITHUB_TOKEN": "token",
+				},
+				Detach: true,
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockRunner = mocks.NewMockrunner(controller)
+				mockRunner.EXPECT().Run("docker", []string{"run", "--rm", "--name", "phonetool-test-api",
+					"--detach",
+					"--network", "phonetool-test-api",
+					"--network-alias", "api",
+					"--publish", "8080:8080",
+					"--env", "COPILOT_APPLICATION_NAME=phonetool",
+					"--env", "LOG_LEVEL=info",
+					"--env", "GITHUB_TOKEN",
+					"phonetool/api:local"}, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			test.setupMocks(controller)
+			s := Runner{
+				runner: mockRunner,
+			}
+
+			got := s.RunContainer(test.in)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestNetwork(t *testing.T) {
+	mockError := errors.New("mockError")
+
+	t.Run("wrap error returned from creating the network", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		mockRunner := mocks.NewMockrunner(controller)
+		mockRunner.EXPECT().Run("docker", []string{"network", "create", "phonetool-test-api"}).Return(mockError)
+		s := Runner{
+			runner: mockRunner,
+		}
+
+		got := s.CreateNetwork("phonetool-test-api")
+
+		require.Equal(t, fmt.Errorf("create network phonetool-test-api: %w", mockError), got)
+	})
+	t.Run("removes the network", func(t *testing.T) {
+		controller := gomock.NewController(t)
+		mockRunner := mocks.NewMockrunner(controller)
+		mockRunner.EXPECT().Run("docker", []string{"network", "rm", "phonetool-test-api"}).Return(nil)
+		s := Runner{
+			runner: mockRunner,
+		}
+
+		got := s.RemoveNetwork("phonetool-test-api")
+
+		require.NoError(t, got)
+	})
+}
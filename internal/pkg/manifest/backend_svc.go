@@ -39,6 +39,16 @@ type BackendServiceConfig struct {
 	TaskConfig `yaml:",inline"`
 	*Logging   `yaml:"logging,flow"`
 	Sidecar    `yaml:",inline"`
+	Network    NetworkConfig `yaml:"network"`
+	Exec       *bool         `yaml:"exec"`
+	Publish    PublishConfig `yaml:"publish"`
+	// RoutingRule routes requests from the internal load balancer of the environment to the service.
+	RoutingRule `yaml:"http,flow"`
+}
+
+// HasInternalRoutingRule returns true if the service is routed through the internal load balancer of the environment.
+func (bc *BackendServiceConfig) HasInternalRoutingRule() bool {
+	return bc.RoutingRule.Path != nil
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -113,13 +123,21 @@ func (s BackendService) ApplyEnv(envName string) (*BackendService, error) {
 	if !ok {
 		return &s, nil
 	}
+	override := *overrideConfig
+	if override.Publish.Topics == nil {
+		// Keep the topics when the environment doesn't override them, otherwise the empty slice would overwrite them.
+		override.Publish.Topics = s.Publish.Topics
+	}
+	override.RoutingRule.keepUnset(s.RoutingRule)
+	override.Network.keepUnset(s.Network)
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, BackendService{
-		BackendServiceConfig: *overrideConfig,
+		BackendServiceConfig: override,
 	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
 	if err != nil {
 		return nil, err
 	}
+	s.RoutingRule.applyHealthCheckPath(override.RoutingRule)
 	s.Environments = nil
 	return &s, nil
 }
@@ -195,14 +213,19 @@ func (hc *ContainerHealthCheck) applyIfNotSet(other *ContainerHealthCheck) {
 
 // HealthCheckOpts converts the image's healthcheck configuration into a format parsable by the templates pkg.
 func (i imageWithPortAndHealthcheck) HealthCheckOpts() *ecs.HealthCheck {
-	if i.HealthCheck == nil {
+	return i.HealthCheck.opts()
+}
+
+// opts converts the healthcheck configuration into a format parsable by the templates pkg.
+func (hc *ContainerHealthCheck) opts() *ecs.HealthCheck {
+	if hc == nil {
 		return nil
 	}
 	return &ecs.HealthCheck{
-		Command:     aws.StringSlice(i.HealthCheck.Command),
-		Interval:    aws.Int64(int64(i.HealthCheck.Interval.Seconds())),
-		Retries:     aws.Int64(int64(*i.HealthCheck.Retries)),
-		StartPeriod: aws.Int64(int64(i.HealthCheck.StartPeriod.Seconds())),
-		Timeout:     aws.Int64(int64(i.HealthCheck.Timeout.Seconds())),
+		Command:     aws.StringSlice(hc.Command),
+		Interval:    aws.Int64(int64(hc.Interval.Seconds())),
+		Retries:     aws.Int64(int64(*hc.Retries)),
+		StartPeriod: aws.Int64(int64(hc.StartPeriod.Seconds())),
+		Timeout:     aws.Int64(int64(hc.Timeout.Seconds())),
 	}
 }
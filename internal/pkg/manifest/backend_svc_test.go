@@ -283,6 +283,29 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 		},
 	}
+	mockBackendServiceWithRoutingRuleOverride := BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			RoutingRule: RoutingRule{
+				Path:  aws.String("api"),
+				Alias: Alias{"api.internal"},
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckArgs: HTTPHealthCheckArgs{
+						Path:             aws.String("/healthz"),
+						HealthyThreshold: aws.Int64(3),
+					},
+				},
+			},
+		},
+		Environments: map[string]*BackendServiceConfig{
+			"test": {
+				RoutingRule: RoutingRule{
+					HealthCheck: HealthCheckArgsOrString{
+						HealthCheckPath: aws.String("/ping"),
+					},
+				},
+			},
+		},
+	}
 	testCases := map[string]struct {
 		svc       *BackendService
 		inEnvName string
@@ -356,6 +379,26 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 			},
 			original: &mockBackendServiceWithAllOverride,
 		},
+		"keeps the routing rule when the environment only overrides the health check path": {
+			svc:       &mockBackendServiceWithRoutingRuleOverride,
+			inEnvName: "test",
+
+			wanted: &BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					RoutingRule: RoutingRule{
+						Path:  aws.String("api"),
+						Alias: Alias{"api.internal"},
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/ping"),
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								HealthyThreshold: aws.Int64(3),
+							},
+						},
+					},
+				},
+			},
+			original: &mockBackendServiceWithRoutingRuleOverride,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,321 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvironmentType is the type of an environment manifest.
+	EnvironmentType = "Environment"
+)
+
+// defaultELBAccessLogsRetention is the number of days before the access logs in the bucket created by Copilot expire.
+const defaultELBAccessLogsRetention = 30
+
+// Number of NAT gateways that route the traffic of the private subnets to the internet.
+const (
+	NATGatewaysPerAZ  = "per-az" // One NAT gateway in each availability zone.
+	NATGatewaysSingle = "single" // A single NAT gateway shared by all the private subnets.
+)
+
+var (
+	errVPCIDAndCIDR         = errors.New(`cannot specify both "id" and "cidr" under "network.vpc"`)
+	errSubnetsWithoutVPC    = errors.New(`"network.vpc.subnets" requires either "network.vpc.id" or "network.vpc.cidr"`)
+	errImportedSubnetID     = errors.New(`every subnet must have an "id" when importing a VPC with "network.vpc.id"`)
+	errManagedSubnetCIDR    = errors.New(`every subnet must have a "cidr" when configuring a VPC with "network.vpc.cidr"`)
+	errManagedVPCNoSubnet   = errors.New(`at least one public and one private subnet are required when configuring a VPC with "network.vpc.cidr"`)
+	errImportedVPCNAT       = errors.New(`cannot specify "network.vpc.nat_gateways" when importing a VPC with "network.vpc.id"`)
+	errImportedVPCEndpoints = errors.New(`cannot specify "network.vpc.endpoints" when importing a VPC with "network.vpc.id"`)
+	errNATPerAZSubnets      = errors.New(`"network.vpc.nat_gateways: per-az" requires a public subnet for each private subnet`)
+	errAZsWithoutCIDR       = errors.New(`"network.vpc.availability_zones" requires "network.vpc.cidr"`)
+
+	errUnmarshalELBAccessLogs   = errors.New(`unmarshal "access_logs" field to a boolean or access logs arguments`)
+	errImportedBucketRetention  = errors.New(`cannot specify "http.public.access_logs.retention" with an existing bucket in "bucket_name"`)
+	errAccessLogsRetentionRange = errors.New(`"http.public.access_logs.retention" must be a positive number of days`)
+
+	errInternalALBNoPrivateSubnet = errors.New(`"http.private.enabled" requires private subnets when importing a VPC with "network.vpc.id"`)
+)
+
+// Environment is the manifest configuration for an environment under copilot/environments/{name}/manifest.yml.
+type Environment struct {
+	Name              *string `yaml:"name"`
+	Type              *string `yaml:"type"`
+	EnvironmentConfig `yaml:",inline"`
+}
+
+// EnvironmentConfig holds the configuration of an environment.
+type EnvironmentConfig struct {
+	Network       EnvironmentNetworkConfig `yaml:"network"`
+	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http"`
+	Observability EnvironmentObservability `yaml:"observability"`
+}
+
+// EnvironmentNetworkConfig holds the network configuration of an environment.
+type EnvironmentNetworkConfig struct {
+	VPC EnvironmentVPCConfig `yaml:"vpc"`
+}
+
+// EnvironmentVPCConfig holds the VPC configuration of an environment.
+// Either an existing VPC is imported with "id", or Copilot creates one with the "cidr" range.
+type EnvironmentVPCConfig struct {
+	ID          *string              `yaml:"id"`
+	CIDR        *string              `yaml:"cidr"`
+	Subnets     SubnetsConfiguration `yaml:"subnets"`
+	NATGateways *string              `yaml:"nat_gateways"` // Either "per-az" or "single". No NAT gateways are created if empty.
+	Endpoints   *bool                `yaml:"endpoints"`    // Whether or not to create VPC endpoints for the AWS services used by the tasks.
+
+	// Names of the availability zones of the subnets created with "cidr": one public and one private subnet in each zone.
+	AZs []string `yaml:"availability_zones"`
+}
+
+// SubnetsConfiguration holds the public and private subnets of an environment's VPC.
+type SubnetsConfiguration struct {
+	Public  []SubnetConfiguration `yaml:"public"`
+	Private []SubnetConfiguration `yaml:"private"`
+}
+
+// SubnetConfiguration represents a subnet, either imported by ID or created with a CIDR range.
+type SubnetConfiguration struct {
+	SubnetID *string `yaml:"id"`
+	CIDR     *string `yaml:"cidr"`
+}
+
+// EnvironmentHTTPConfig holds the configuration of the load balancers of an environment.
+type EnvironmentHTTPConfig struct {
+	Public  PublicHTTPConfig  `yaml:"public"`
+	Private PrivateHTTPConfig `yaml:"private"`
+}
+
+// PublicHTTPConfig holds the configuration of the environment's public load balancer.
+type PublicHTTPConfig struct {
+	Certificates []string                `yaml:"certificates"` // ARNs of existing ACM certificates for the HTTPS listener.
+	WebACL       *string                 `yaml:"web_acl"`      // ARN of an existing WAFv2 web ACL associated with the load balancer.
+	AccessLogs   ELBAccessLogsArgsOrBool `yaml:"access_logs"`
+}
+
+// PrivateHTTPConfig holds the configuration of the environment's internal load balancer.
+// Backend services with an "http" section are routed through it.
+type PrivateHTTPConfig struct {
+	Enabled *bool `yaml:"enabled"` // Whether or not to create the internal load balancer.
+}
+
+// ELBAccessLogsArgsOrBool is a custom type which supports unmarshaling "access_logs" yaml which
+// can either be a boolean to store the logs in a bucket created by Copilot or type ELBAccessLogsArgs.
+type ELBAccessLogsArgsOrBool struct {
+	Enabled        *bool
+	AdvancedConfig ELBAccessLogsArgs
+}
+
+// ELBAccessLogsArgs holds the configuration of the access logs of the load balancer.
+// Retention only applies to the bucket created by Copilot, when no existing bucket is given.
+type ELBAccessLogsArgs struct {
+	BucketName *string `yaml:"bucket_name"`
+	Prefix     *string `yaml:"prefix"`
+	Retention  *int    `yaml:"retention"` // Number of days before the logs expire.
+}
+
+func (a *ELBAccessLogsArgs) isEmpty() bool {
+	return a.BucketName == nil && a.Prefix == nil && a.Retention == nil
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the ELBAccessLogsArgsOrBool
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (al *ELBAccessLogsArgsOrBool) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&al.AdvancedConfig); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !al.AdvancedConfig.isEmpty() {
+		// Unmarshaled successfully to al.AdvancedConfig, return.
+		return nil
+	}
+
+	if err := unmarshal(&al.Enabled); err != nil {
+		return errUnmarshalELBAccessLogs
+	}
+	return nil
+}
+
+// EnvironmentObservability holds the monitoring configuration of an environment.
+type EnvironmentObservability struct {
+	ContainerInsights *bool `yaml:"container_insights"`
+}
+
+// UnmarshalEnvironment deserializes the YAML input stream into an environment manifest object.
+// If an error occurs during deserialization or the manifest is invalid, then returns the error.
+func UnmarshalEnvironment(in []byte) (*Environment, error) {
+	m := &Environment{}
+	if err := yaml.Unmarshal(in, m); err != nil {
+		return nil, fmt.Errorf("unmarshal to environment manifest: %w", err)
+	}
+	if typ := aws.StringValue(m.Type); typ != EnvironmentType {
+		return nil, &ErrInvalidWorkloadType{Type: typ}
+	}
+	if err := m.Network.VPC.validate(); err != nil {
+		return nil, fmt.Errorf("validate environment manifest: %w", err)
+	}
+	if err := m.HTTPConfig.Public.validate(); err != nil {
+		return nil, fmt.Errorf("validate environment manifest: %w", err)
+	}
+	if err := m.HTTPConfig.Private.validate(m.Network.VPC); err != nil {
+		return nil, fmt.Errorf("validate environment manifest: %w", err)
+	}
+	return m, nil
+}
+
+// ImportedVPC returns the configuration of the existing VPC to import.
+// If the manifest doesn't import a VPC, returns nil.
+func (v EnvironmentVPCConfig) ImportedVPC() *config.ImportVPC {
+	if v.ID == nil {
+		return nil
+	}
+	conf := &config.ImportVPC{
+		ID: aws.StringValue(v.ID),
+	}
+	for _, subnet := range v.Subnets.Public {
+		conf.PublicSubnetIDs = append(conf.PublicSubnetIDs, aws.StringValue(subnet.SubnetID))
+	}
+	for _, subnet := range v.Subnets.Private {
+		conf.PrivateSubnetIDs = append(conf.PrivateSubnetIDs, aws.StringValue(subnet.SubnetID))
+	}
+	return conf
+}
+
+// ManagedVPC returns the CIDR ranges of the VPC that Copilot creates.
+// If the manifest doesn't override the default ranges, returns nil.
+func (v EnvironmentVPCConfig) ManagedVPC() *config.AdjustVPC {
+	if v.CIDR == nil {
+		return nil
+	}
+	conf := &config.AdjustVPC{
+		CIDR: aws.StringValue(v.CIDR),
+		AZs:  v.AZs,
+	}
+	for _, subnet := range v.Subnets.Public {
+		conf.PublicSubnetCIDRs = append(conf.PublicSubnetCIDRs, aws.StringValue(subnet.CIDR))
+	}
+	for _, subnet := range v.Subnets.Private {
+		conf.PrivateSubnetCIDRs = append(conf.PrivateSubnetCIDRs, aws.StringValue(subnet.CIDR))
+	}
+	return conf
+}
+
+func (v EnvironmentVPCConfig) validate() error {
+	if err := v.validateNATGateways(); err != nil {
+		return err
+	}
+	if v.ID != nil && aws.BoolValue(v.Endpoints) {
+		return errImportedVPCEndpoints
+	}
+	subnets := append(append([]SubnetConfiguration{}, v.Subnets.Public...), v.Subnets.Private...)
+	switch {
+	case v.ID != nil && v.CIDR != nil:
+		return errVPCIDAndCIDR
+	case v.ID != nil:
+		for _, subnet := range subnets {
+			if subnet.SubnetID == nil {
+				return errImportedSubnetID
+			}
+		}
+	case v.CIDR != nil:
+		if len(v.Subnets.Public) == 0 || len(v.Subnets.Private) == 0 {
+			return errManagedVPCNoSubnet
+		}
+		for _, subnet := range subnets {
+			if subnet.CIDR == nil {
+				return errManagedSubnetCIDR
+			}
+		}
+		if err := v.ManagedVPC().Validate(); err != nil {
+			return fmt.Errorf(`validate "network.vpc": %w`, err)
+		}
+	case len(subnets) != 0:
+		return errSubnetsWithoutVPC
+	}
+	if v.CIDR == nil && len(v.AZs) != 0 {
+		return errAZsWithoutCIDR
+	}
+	return nil
+}
+
+func (v EnvironmentVPCConfig) validateNATGateways() error {
+	switch nat := aws.StringValue(v.NATGateways); nat {
+	case "":
+		return nil
+	case NATGatewaysPerAZ:
+		if v.CIDR != nil && len(v.Subnets.Public) < len(v.Subnets.Private) {
+			return errNATPerAZSubnets
+		}
+	case NATGatewaysSingle:
+	default:
+		return fmt.Errorf(`"network.vpc.nat_gateways" must be one of %s or %s, got "%s"`, NATGatewaysPerAZ, NATGatewaysSingle, nat)
+	}
+	if v.ID != nil {
+		return errImportedVPCNAT
+	}
+	return nil
+}
+
+// ELBAccessLogsOpts converts the access logs configuration of the public load balancer into a format parsable by the templates pkg.
+// If access logs are disabled, returns nil.
+func (c PublicHTTPConfig) ELBAccessLogsOpts() *template.ELBAccessLogsOpts {
+	args := c.AccessLogs.AdvancedConfig
+	if args.isEmpty() && !aws.BoolValue(c.AccessLogs.Enabled) {
+		return nil
+	}
+	opts := &template.ELBAccessLogsOpts{
+		BucketName: aws.StringValue(args.BucketName),
+		Prefix:     aws.StringValue(args.Prefix),
+	}
+	if opts.BucketName == "" {
+		opts.Retention = defaultELBAccessLogsRetention
+		if args.Retention != nil {
+			opts.Retention = aws.IntValue(args.Retention)
+		}
+	}
+	return opts
+}
+
+func (c PublicHTTPConfig) validate() error {
+	if c.WebACL != nil {
+		parsed, err := arn.Parse(aws.StringValue(c.WebACL))
+		if err != nil || parsed.Service != "wafv2" {
+			return fmt.Errorf(`"http.public.web_acl" must be the ARN of a WAFv2 web ACL, got "%s"`, aws.StringValue(c.WebACL))
+		}
+	}
+	args := c.AccessLogs.AdvancedConfig
+	if args.Retention == nil {
+		return nil
+	}
+	if args.BucketName != nil {
+		return errImportedBucketRetention
+	}
+	if aws.IntValue(args.Retention) < 1 {
+		return errAccessLogsRetentionRange
+	}
+	return nil
+}
+
+// validate returns an error if the internal load balancer can't be placed in the private subnets of the VPC.
+func (c PrivateHTTPConfig) validate(vpc EnvironmentVPCConfig) error {
+	if aws.BoolValue(c.Enabled) && vpc.ID != nil && len(vpc.Subnets.Private) == 0 {
+		return errInternalALBNoPrivateSubnet
+	}
+	return nil
+}
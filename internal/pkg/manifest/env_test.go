@@ -0,0 +1,407 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedStruct    *Environment
+		wantedImportVPC *config.ImportVPC
+		wantedAdjustVPC *config.AdjustVPC
+		wantedLogs      *template.ELBAccessLogsOpts
+		wantedErr       string
+	}{
+		"unmarshal with imported VPC and certificates": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    subnets:
+      public:
+        - id: subnet-11111
+        - id: subnet-22222
+      private:
+        - id: subnet-33333
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/abc
+    web_acl: arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc
+    access_logs:
+      bucket_name: my-logs
+      prefix: test
+observability:
+  container_insights: true
+`,
+			wantedStruct: &Environment{
+				Name: aws.String("test"),
+				Type: aws.String(EnvironmentType),
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID: aws.String("vpc-3f139646"),
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{
+									{SubnetID: aws.String("subnet-11111")},
+									{SubnetID: aws.String("subnet-22222")},
+								},
+								Private: []SubnetConfiguration{
+									{SubnetID: aws.String("subnet-33333")},
+								},
+							},
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+							WebACL:       aws.String("arn:aws:wafv2:us-west-2:123456789012:regional/webacl/office/abc"),
+							AccessLogs: ELBAccessLogsArgsOrBool{
+								AdvancedConfig: ELBAccessLogsArgs{
+									BucketName: aws.String("my-logs"),
+									Prefix:     aws.String("test"),
+								},
+							},
+						},
+					},
+					Observability: EnvironmentObservability{
+						ContainerInsights: aws.Bool(true),
+					},
+				},
+			},
+			wantedImportVPC: &config.ImportVPC{
+				ID:               "vpc-3f139646",
+				PublicSubnetIDs:  []string{"subnet-11111", "subnet-22222"},
+				PrivateSubnetIDs: []string{"subnet-33333"},
+			},
+			wantedLogs: &template.ELBAccessLogsOpts{
+				BucketName: "my-logs",
+				Prefix:     "test",
+			},
+		},
+		"unmarshal with managed VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+      private:
+        - cidr: 10.1.2.0/24
+    nat_gateways: per-az
+    endpoints: true
+http:
+  public:
+    access_logs: true
+  private:
+    enabled: true
+`,
+			wantedStruct: &Environment{
+				Name: aws.String("test"),
+				Type: aws.String(EnvironmentType),
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR: aws.String("10.1.0.0/16"),
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{
+									{CIDR: aws.String("10.1.0.0/24")},
+								},
+								Private: []SubnetConfiguration{
+									{CIDR: aws.String("10.1.2.0/24")},
+								},
+							},
+							NATGateways: aws.String(NATGatewaysPerAZ),
+							Endpoints:   aws.Bool(true),
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							AccessLogs: ELBAccessLogsArgsOrBool{
+								Enabled: aws.Bool(true),
+							},
+						},
+						Private: PrivateHTTPConfig{
+							Enabled: aws.Bool(true),
+						},
+					},
+				},
+			},
+			wantedAdjustVPC: &config.AdjustVPC{
+				CIDR:               "10.1.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.1.0.0/24"},
+				PrivateSubnetCIDRs: []string{"10.1.2.0/24"},
+			},
+			wantedLogs: &template.ELBAccessLogsOpts{
+				Retention: 30,
+			},
+		},
+		"unmarshal with availability zones": {
+			inContent: `name: prod
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    availability_zones: [us-west-2a, us-west-2b, us-west-2c]
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+        - cidr: 10.1.1.0/24
+        - cidr: 10.1.2.0/24
+      private:
+        - cidr: 10.1.3.0/24
+        - cidr: 10.1.4.0/24
+        - cidr: 10.1.5.0/24
+`,
+			wantedStruct: &Environment{
+				Name: aws.String("prod"),
+				Type: aws.String(EnvironmentType),
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR: aws.String("10.1.0.0/16"),
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{
+									{CIDR: aws.String("10.1.0.0/24")},
+									{CIDR: aws.String("10.1.1.0/24")},
+									{CIDR: aws.String("10.1.2.0/24")},
+								},
+								Private: []SubnetConfiguration{
+									{CIDR: aws.String("10.1.3.0/24")},
+									{CIDR: aws.String("10.1.4.0/24")},
+									{CIDR: aws.String("10.1.5.0/24")},
+								},
+							},
+							AZs: []string{"us-west-2a", "us-west-2b", "us-west-2c"},
+						},
+					},
+				},
+			},
+			wantedAdjustVPC: &config.AdjustVPC{
+				CIDR:               "10.1.0.0/16",
+				PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/24"},
+				PrivateSubnetCIDRs: []string{"10.1.3.0/24", "10.1.4.0/24", "10.1.5.0/24"},
+				AZs:                []string{"us-west-2a", "us-west-2b", "us-west-2c"},
+			},
+		},
+		"error if the type is not an environment": {
+			inContent: `name: test
+type: Backend Service
+`,
+			wantedErr: "invalid manifest type: Backend Service",
+		},
+		"error if the web ACL is not a WAFv2 ARN": {
+			inContent: `name: test
+type: Environment
+http:
+  public:
+    web_acl: arn:aws:waf-regional:us-west-2:123456789012:webacl/abc
+`,
+			wantedErr: `validate environment manifest: "http.public.web_acl" must be the ARN of a WAFv2 web ACL, got "arn:aws:waf-regional:us-west-2:123456789012:webacl/abc"`,
+		},
+		"error if access logs are neither a boolean nor arguments": {
+			inContent: `name: test
+type: Environment
+http:
+  public:
+    access_logs: [my-logs]
+`,
+			wantedErr: `unmarshal to environment manifest: unmarshal "access_logs" field to a boolean or access logs arguments`,
+		},
+		"error if the retention is set for an existing bucket": {
+			inContent: `name: test
+type: Environment
+http:
+  public:
+    access_logs:
+      bucket_name: my-logs
+      retention: 90
+`,
+			wantedErr: `validate environment manifest: cannot specify "http.public.access_logs.retention" with an existing bucket in "bucket_name"`,
+		},
+		"error if the retention is not positive": {
+			inContent: `name: test
+type: Environment
+http:
+  public:
+    access_logs:
+      retention: 0
+`,
+			wantedErr: `validate environment manifest: "http.public.access_logs.retention" must be a positive number of days`,
+		},
+		"error if the internal load balancer has no private subnets in an imported VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    subnets:
+      public:
+        - id: subnet-11111
+http:
+  private:
+    enabled: true
+`,
+			wantedErr: `validate environment manifest: "http.private.enabled" requires private subnets when importing a VPC with "network.vpc.id"`,
+		},
+		"error if both a VPC ID and CIDR are specified": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    cidr: 10.1.0.0/16
+`,
+			wantedErr: `validate environment manifest: cannot specify both "id" and "cidr" under "network.vpc"`,
+		},
+		"error if an imported subnet has no ID": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+`,
+			wantedErr: `validate environment manifest: every subnet must have an "id" when importing a VPC with "network.vpc.id"`,
+		},
+		"error if a managed VPC has no private subnets": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+`,
+			wantedErr: `validate environment manifest: at least one public and one private subnet are required when configuring a VPC with "network.vpc.cidr"`,
+		},
+		"error if NAT gateways are requested for an imported VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    nat_gateways: single
+`,
+			wantedErr: `validate environment manifest: cannot specify "network.vpc.nat_gateways" when importing a VPC with "network.vpc.id"`,
+		},
+		"error if VPC endpoints are requested for an imported VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-3f139646
+    endpoints: true
+`,
+			wantedErr: `validate environment manifest: cannot specify "network.vpc.endpoints" when importing a VPC with "network.vpc.id"`,
+		},
+		"error if the number of NAT gateways is invalid": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    nat_gateways: two
+`,
+			wantedErr: `validate environment manifest: "network.vpc.nat_gateways" must be one of per-az or single, got "two"`,
+		},
+		"error if there are less public than private subnets with a NAT gateway per AZ": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+      private:
+        - cidr: 10.1.2.0/24
+        - cidr: 10.1.3.0/24
+    nat_gateways: per-az
+`,
+			wantedErr: `validate environment manifest: "network.vpc.nat_gateways: per-az" requires a public subnet for each private subnet`,
+		},
+		"error if a subnet is outside of the VPC CIDR": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    subnets:
+      public:
+        - cidr: 10.2.0.0/24
+      private:
+        - cidr: 10.1.2.0/24
+`,
+			wantedErr: `validate environment manifest: validate "network.vpc": subnet CIDR 10.2.0.0/24 is not within the VPC CIDR 10.1.0.0/16`,
+		},
+		"error if the subnets don't match the availability zones": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16
+    availability_zones: [us-west-2a, us-west-2b]
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+      private:
+        - cidr: 10.1.2.0/24
+`,
+			wantedErr: `validate environment manifest: validate "network.vpc": 2 availability zones require 2 public and 2 private subnets, got 1 public and 1 private subnets`,
+		},
+		"error if availability zones are specified without a VPC CIDR": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    availability_zones: [us-west-2a, us-west-2b]
+`,
+			wantedErr: `validate environment manifest: "network.vpc.availability_zones" requires "network.vpc.cidr"`,
+		},
+		"error if subnets are specified without a VPC": {
+			inContent: `name: test
+type: Environment
+network:
+  vpc:
+    subnets:
+      public:
+        - cidr: 10.1.0.0/24
+`,
+			wantedErr: `validate environment manifest: "network.vpc.subnets" requires either "network.vpc.id" or "network.vpc.cidr"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			m, err := UnmarshalEnvironment([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, m)
+			require.Equal(t, tc.wantedImportVPC, m.Network.VPC.ImportedVPC())
+			require.Equal(t, tc.wantedAdjustVPC, m.Network.VPC.ManagedVPC())
+			require.Equal(t, tc.wantedLogs, m.HTTPConfig.Public.ELBAccessLogsOpts())
+		})
+	}
+}
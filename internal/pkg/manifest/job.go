@@ -41,6 +41,7 @@ type ScheduledJobConfig struct {
 	*Logging       `yaml:"logging,flow"`
 	Sidecar        `yaml:",inline"`
 	ScheduleConfig `yaml:",inline"`
+	Network        NetworkConfig `yaml:"network"`
 }
 
 // ScheduleConfig holds the fields necessary to describe a scheduled job's execution frequency and error handling.
@@ -111,9 +112,11 @@ func (j ScheduledJob) ApplyEnv(envName string) (*ScheduledJob, error) {
 	if !ok {
 		return &j, nil
 	}
+	override := *overrideConfig
+	override.Network.keepUnset(j.Network)
 	// Apply overrides to the original job
 	err := mergo.Merge(&j, ScheduledJob{
-		ScheduledJobConfig: *overrideConfig,
+		ScheduledJobConfig: override,
 	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
 	if err != nil {
 		return nil, err
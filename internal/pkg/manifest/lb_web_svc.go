@@ -4,11 +4,18 @@
 package manifest
 
 import (
+	"fmt"
+	"net"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -16,8 +23,18 @@ const (
 
 	// LogRetentionInDays is the default log retention time in days.
 	LogRetentionInDays = 30
+
+	// Limits of a load balancer listener rule.
+	maxConditionValuesPerRule = 5
+	maxAliasLength            = 128
 )
 
+// aliasLabelRegexp matches a label of a host name, where the load balancer allows the "*" and "?" wildcards.
+var aliasLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9*?]([a-zA-Z0-9*?-]*[a-zA-Z0-9*?])?$`)
+
+// headerNameRegexp matches an HTTP header name, which is a token as defined in RFC 7230.
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9a-zA-Z-]+$")
+
 // LoadBalancedWebService holds the configuration to build a container image with an exposed port that receives
 // requests through a load balancer with AWS Fargate as the compute engine.
 type LoadBalancedWebService struct {
@@ -36,6 +53,9 @@ type LoadBalancedWebServiceConfig struct {
 	TaskConfig  `yaml:",inline"`
 	*Logging    `yaml:"logging,flow"`
 	Sidecar     `yaml:",inline"`
+	Network     NetworkConfig `yaml:"network"`
+	Exec        *bool         `yaml:"exec"`
+	Publish     PublishConfig `yaml:"publish"`
 }
 
 // LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
@@ -48,11 +68,235 @@ func (lc *LoadBalancedWebServiceConfig) LogConfigOpts() *template.LogConfigOpts
 
 // RoutingRule holds the path to route requests to the service.
 type RoutingRule struct {
-	Path            *string `yaml:"path"`
-	HealthCheckPath *string `yaml:"healthcheck"`
-	Stickiness      *bool   `yaml:"stickiness"`
+	Path        *string                 `yaml:"path"`
+	Alias       Alias                   `yaml:"alias"`
+	Headers     map[string][]string     `yaml:"headers"`
+	Query       map[string]string       `yaml:"query"`
+	HealthCheck HealthCheckArgsOrString `yaml:"healthcheck"`
+	Stickiness  *bool                   `yaml:"stickiness"`
+	// DeregistrationDelay is the time the load balancer waits for in-flight requests before deregistering a task.
+	DeregistrationDelay *time.Duration `yaml:"deregistration_delay"`
+	// ProtocolVersion is the protocol used to send requests to the tasks. Either HTTP1, HTTP2 or GRPC.
+	ProtocolVersion *string `yaml:"protocol_version"`
 	// TargetContainer is the container load balancer routes traffic to.
 	TargetContainer *string `yaml:"targetContainer"`
+	// AllowedSourceIPs are the CIDR ranges that requests must come from. Requests from anywhere are forwarded if empty.
+	AllowedSourceIPs []string `yaml:"allowed_source_ips"`
+	// AdditionalRules are other sets of conditions that forward requests to the service.
+	AdditionalRules []ListenerRule `yaml:"additional_rules"`
+}
+
+// HealthCheckArgsOrString is a custom type which supports unmarshaling "healthcheck" yaml which
+// can either be the path of the health check or type HTTPHealthCheckArgs.
+type HealthCheckArgsOrString struct {
+	HealthCheckPath *string
+	HealthCheckArgs HTTPHealthCheckArgs
+}
+
+// HTTPHealthCheckArgs holds the configuration of the load balancer's health check and
+// of the grace period during which the service ignores its failures.
+type HTTPHealthCheckArgs struct {
+	Path               *string        `yaml:"path"`
+	SuccessCodes       *string        `yaml:"success_codes"`
+	HealthyThreshold   *int64         `yaml:"healthy_threshold"`
+	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
+	Timeout            *time.Duration `yaml:"timeout"`
+	Interval           *time.Duration `yaml:"interval"`
+	GracePeriod        *time.Duration `yaml:"grace_period"`
+}
+
+func (h *HTTPHealthCheckArgs) isEmpty() bool {
+	return h.Path == nil && h.SuccessCodes == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil &&
+		h.Timeout == nil && h.Interval == nil && h.GracePeriod == nil
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the HealthCheckArgsOrString
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (h *HealthCheckArgsOrString) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if err := unmarshal(&h.HealthCheckArgs); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !h.HealthCheckArgs.isEmpty() {
+		// Unmarshaled successfully to h.HealthCheckArgs, return.
+		return nil
+	}
+
+	if err := unmarshal(&h.HealthCheckPath); err != nil {
+		return errUnmarshalHealthCheckArgs
+	}
+	return nil
+}
+
+// Path returns the path of the health check, "/" by default.
+func (h HealthCheckArgsOrString) Path() string {
+	if h.HealthCheckArgs.Path != nil {
+		return aws.StringValue(h.HealthCheckArgs.Path)
+	}
+	if h.HealthCheckPath != nil {
+		return aws.StringValue(h.HealthCheckPath)
+	}
+	return "/"
+}
+
+// ListenerRule holds the conditions that a request must match to be forwarded to the service.
+type ListenerRule struct {
+	Path    *string             `yaml:"path"`
+	Alias   Alias               `yaml:"alias"`
+	Headers map[string][]string `yaml:"headers"`
+	Query   map[string]string   `yaml:"query"`
+}
+
+// Alias is a custom type which supports unmarshaling "alias" yaml which
+// can either be a host name or a list of host names.
+type Alias []string
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the Alias type,
+// allowing it to unmarshal a single host name into a list.
+// This method implements the yaml.Unmarshaler (v2) interface.
+func (a *Alias) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var aliases []string
+	if err := unmarshal(&aliases); err == nil {
+		*a = aliases
+		return nil
+	}
+	var alias string
+	if err := unmarshal(&alias); err != nil {
+		return errUnmarshalAlias
+	}
+	*a = []string{alias}
+	return nil
+}
+
+// keepUnset copies the aliases, allowed source IPs and additional rules of base when r doesn't override them,
+// otherwise the empty slices would overwrite them when the environment overrides are merged.
+func (r *RoutingRule) keepUnset(base RoutingRule) {
+	if r.Alias == nil {
+		r.Alias = base.Alias
+	}
+	if r.AllowedSourceIPs == nil {
+		r.AllowedSourceIPs = base.AllowedSourceIPs
+	}
+	if r.AdditionalRules == nil {
+		r.AdditionalRules = base.AdditionalRules
+	}
+}
+
+// applyHealthCheckPath removes the merged path of the health check arguments if override sets the path with the string form,
+// since the path overridden with the string form takes precedence.
+func (r *RoutingRule) applyHealthCheckPath(override RoutingRule) {
+	if override.HealthCheck.HealthCheckPath != nil && override.HealthCheck.HealthCheckArgs.Path == nil {
+		r.HealthCheck.HealthCheckArgs.Path = nil
+	}
+}
+
+// ListenerRulesOpts converts the routing configuration into a format parsable by the templates pkg.
+// It returns the extra conditions of the main rule, whose path is a stack parameter, and the additional rules.
+// The allowed source IPs apply to every rule.
+func (r RoutingRule) ListenerRulesOpts() (*template.ListenerRuleOpts, []*template.ListenerRuleOpts, error) {
+	for _, ip := range r.AllowedSourceIPs {
+		if _, _, err := net.ParseCIDR(ip); err != nil {
+			return nil, nil, fmt.Errorf(`"http.allowed_source_ips" must contain CIDR ranges, got "%s"`, ip)
+		}
+	}
+	if err := r.Alias.validate(); err != nil {
+		return nil, nil, fmt.Errorf(`validate "http.alias": %w`, err)
+	}
+	if err := validateHeaderNames(r.Headers); err != nil {
+		return nil, nil, fmt.Errorf(`validate "http.headers": %w`, err)
+	}
+	var main *template.ListenerRuleOpts
+	if len(r.Alias) != 0 || len(r.Headers) != 0 || len(r.Query) != 0 || len(r.AllowedSourceIPs) != 0 {
+		main = &template.ListenerRuleOpts{
+			Aliases:   r.Alias,
+			Headers:   r.Headers,
+			Query:     r.Query,
+			SourceIPs: r.AllowedSourceIPs,
+		}
+		// The path of the main rule is "/*" for the root path, otherwise the path and its sub-paths.
+		pathValues := 2
+		if strings.Trim(aws.StringValue(r.Path), "/") == "" {
+			pathValues = 1
+		}
+		if n := pathValues + conditionValues(main); n > maxConditionValuesPerRule {
+			return nil, nil, fmt.Errorf(`"http" must have at most %d path, alias, header, query and allowed source IP values, got %d`, maxConditionValuesPerRule, n)
+		}
+	}
+	var additional []*template.ListenerRuleOpts
+	for i, rule := range r.AdditionalRules {
+		path := strings.Trim(aws.StringValue(rule.Path), "/")
+		if path == "" && len(rule.Alias) == 0 && len(rule.Headers) == 0 && len(rule.Query) == 0 {
+			return nil, nil, fmt.Errorf(`additional rule %d must have a path other than "/", an alias, headers or a query`, i+1)
+		}
+		if err := rule.Alias.validate(); err != nil {
+			return nil, nil, fmt.Errorf(`validate "alias" of additional rule %d: %w`, i+1, err)
+		}
+		if err := validateHeaderNames(rule.Headers); err != nil {
+			return nil, nil, fmt.Errorf(`validate "headers" of additional rule %d: %w`, i+1, err)
+		}
+		opts := &template.ListenerRuleOpts{
+			Aliases:   rule.Alias,
+			Headers:   rule.Headers,
+			Query:     rule.Query,
+			SourceIPs: r.AllowedSourceIPs,
+		}
+		pathValues := 0
+		if path != "" {
+			opts.Path = "/" + path
+			// The path condition matches both the path and its sub-paths.
+			pathValues = 2
+		}
+		if n := pathValues + conditionValues(opts); n > maxConditionValuesPerRule {
+			return nil, nil, fmt.Errorf(`additional rule %d must have at most %d path, alias, header, query and allowed source IP values, got %d`, i+1, maxConditionValuesPerRule, n)
+		}
+		additional = append(additional, opts)
+	}
+	return main, additional, nil
+}
+
+// conditionValues returns the number of host header, HTTP header, query string and source IP values of a listener rule.
+func conditionValues(rule *template.ListenerRuleOpts) int {
+	n := len(rule.Aliases) + len(rule.Query) + len(rule.SourceIPs)
+	for _, values := range rule.Headers {
+		n += len(values)
+	}
+	return n
+}
+
+// validate returns an error if an alias isn't a valid host name for a load balancer listener rule.
+func (a Alias) validate() error {
+	for _, alias := range a {
+		if len(alias) > maxAliasLength {
+			return fmt.Errorf(`alias "%s" must be at most %d characters long`, alias, maxAliasLength)
+		}
+		for _, label := range strings.Split(alias, ".") {
+			if !aliasLabelRegexp.MatchString(label) {
+				return fmt.Errorf(`alias "%s" must be a host name made of letters, numbers, hyphens, "*" and "?" separated by dots`, alias)
+			}
+		}
+	}
+	return nil
+}
+
+// validateHeaderNames returns an error if a header name isn't a valid HTTP header name.
+func validateHeaderNames(headers map[string][]string) error {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !headerNameRegexp.MatchString(name) {
+			return fmt.Errorf("header name \"%s\" must only contain letters, numbers and the characters !#$%%&'*+-.^_`|~", name)
+		}
+	}
+	return nil
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -88,7 +332,9 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 			Image: ServiceImageWithPort{},
 			RoutingRule: RoutingRule{
-				HealthCheckPath: aws.String("/"),
+				HealthCheck: HealthCheckArgsOrString{
+					HealthCheckPath: aws.String("/"),
+				},
 			},
 			TaskConfig: TaskConfig{
 				CPU:    aws.Int(256),
@@ -129,13 +375,21 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (*LoadBalancedWebServic
 	if !ok {
 		return &s, nil
 	}
+	override := *overrideConfig
+	if override.Publish.Topics == nil {
+		// Keep the topics when the environment doesn't override them, otherwise the empty slice would overwrite them.
+		override.Publish.Topics = s.Publish.Topics
+	}
+	override.RoutingRule.keepUnset(s.RoutingRule)
+	override.Network.keepUnset(s.Network)
 	// Apply overrides to the original service s.
 	err := mergo.Merge(&s, LoadBalancedWebService{
-		LoadBalancedWebServiceConfig: *overrideConfig,
+		LoadBalancedWebServiceConfig: override,
 	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
 	if err != nil {
 		return nil, err
 	}
+	s.RoutingRule.applyHealthCheckPath(override.RoutingRule)
 	s.Environments = nil
 	return &s, nil
 }
@@ -7,12 +7,14 @@ import (
 	"bytes"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestLoadBalancedWebSvc_MarshalBinary(t *testing.T) {
@@ -68,6 +70,103 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 
 		wanted *LoadBalancedWebService
 	}{
+		"overrides the health check path with the string form": {
+			in: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("phonetool"),
+					Type: aws.String(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						Path: aws.String("api"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								Path:             aws.String("/health"),
+								HealthyThreshold: aws.Int64(3),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						RoutingRule: RoutingRule{
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckPath: aws.String("/ping"),
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("phonetool"),
+					Type: aws.String(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						Path: aws.String("api"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/ping"),
+							HealthCheckArgs: HTTPHealthCheckArgs{
+								HealthyThreshold: aws.Int64(3),
+							},
+						},
+					},
+				},
+			},
+		},
+		"keeps aliases and additional rules that are not overridden": {
+			in: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("phonetool"),
+					Type: aws.String(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						Path:  aws.String("api"),
+						Alias: Alias{"api.example.com"},
+						AdditionalRules: []ListenerRule{
+							{
+								Path: aws.String("v2"),
+							},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod-iad": {
+						RoutingRule: RoutingRule{
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckPath: aws.String("/ping"),
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("phonetool"),
+					Type: aws.String(LoadBalancedWebServiceType),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					RoutingRule: RoutingRule{
+						Path:  aws.String("api"),
+						Alias: Alias{"api.example.com"},
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/ping"),
+						},
+						AdditionalRules: []ListenerRule{
+							{
+								Path: aws.String("v2"),
+							},
+						},
+					},
+				},
+			},
+		},
 		"with no existing environments": {
 			in: &LoadBalancedWebService{
 				Workload: Workload{
@@ -86,8 +185,10 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(80),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
@@ -117,8 +218,10 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(80),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
@@ -148,8 +251,10 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(80),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 					},
 					TaskConfig: TaskConfig{
 						CPU:    aws.Int(1024),
@@ -237,8 +342,10 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 						Port: aws.Uint16(5000),
 					},
 					RoutingRule: RoutingRule{
-						Path:            aws.String("/awards/*"),
-						HealthCheckPath: aws.String("/"),
+						Path: aws.String("/awards/*"),
+						HealthCheck: HealthCheckArgsOrString{
+							HealthCheckPath: aws.String("/"),
+						},
 						TargetContainer: aws.String("xray"),
 					},
 					TaskConfig: TaskConfig{
@@ -288,3 +395,318 @@ func TestLoadBalancedWebSvc_ApplyEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestAlias_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wanted    RoutingRule
+		wantedErr error
+	}{
+		"single host name": {
+			inContent: []byte(`alias: api.example.com`),
+			wanted: RoutingRule{
+				Alias: Alias{"api.example.com"},
+			},
+		},
+		"list of host names": {
+			inContent: []byte(`alias: ["api.example.com", "example.com"]`),
+			wanted: RoutingRule{
+				Alias: Alias{"api.example.com", "example.com"},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`alias:
+  hello: world`),
+			wantedErr: errUnmarshalAlias,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var r RoutingRule
+
+			// WHEN
+			err := yaml.Unmarshal(tc.inContent, &r)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, r)
+		})
+	}
+}
+
+func TestHealthCheckArgsOrString_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct HealthCheckArgsOrString
+		wantedPath   string
+		wantedErr    error
+	}{
+		"legacy case: simple path": {
+			inContent: []byte(`healthcheck: /testing`),
+
+			wantedStruct: HealthCheckArgsOrString{
+				HealthCheckPath: aws.String("/testing"),
+			},
+			wantedPath: "/testing",
+		},
+		"path specified in args": {
+			inContent: []byte(`healthcheck:
+  path: /testing
+  success_codes: 200-299
+  healthy_threshold: 5
+  unhealthy_threshold: 3
+  interval: 78s
+  timeout: 9s
+  grace_period: 2m`),
+
+			wantedStruct: HealthCheckArgsOrString{
+				HealthCheckArgs: HTTPHealthCheckArgs{
+					Path:               aws.String("/testing"),
+					SuccessCodes:       aws.String("200-299"),
+					HealthyThreshold:   aws.Int64(5),
+					UnhealthyThreshold: aws.Int64(3),
+					Interval:           durationp(78 * time.Second),
+					Timeout:            durationp(9 * time.Second),
+					GracePeriod:        durationp(2 * time.Minute),
+				},
+			},
+			wantedPath: "/testing",
+		},
+		"default path if only args without a path": {
+			inContent: []byte(`healthcheck:
+  healthy_threshold: 5`),
+
+			wantedStruct: HealthCheckArgsOrString{
+				HealthCheckArgs: HTTPHealthCheckArgs{
+					HealthyThreshold: aws.Int64(5),
+				},
+			},
+			wantedPath: "/",
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`healthcheck:
+  - /testing`),
+			wantedErr: errUnmarshalHealthCheckArgs,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var r RoutingRule
+
+			// WHEN
+			err := yaml.Unmarshal(tc.inContent, &r)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct, r.HealthCheck)
+			require.Equal(t, tc.wantedPath, r.HealthCheck.Path())
+		})
+	}
+}
+
+func TestRoutingRule_ListenerRulesOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in RoutingRule
+
+		wantedMain       *template.ListenerRuleOpts
+		wantedAdditional []*template.ListenerRuleOpts
+		wantedErr        error
+	}{
+		"no extra conditions": {
+			in: RoutingRule{
+				Path: aws.String("api"),
+			},
+		},
+		"main rule with aliases and headers": {
+			in: RoutingRule{
+				Path:  aws.String("api"),
+				Alias: Alias{"api.example.com"},
+				Headers: map[string][]string{
+					"X-Client": {"mobile", "tablet"},
+				},
+			},
+			wantedMain: &template.ListenerRuleOpts{
+				Aliases: []string{"api.example.com"},
+				Headers: map[string][]string{
+					"X-Client": {"mobile", "tablet"},
+				},
+			},
+		},
+		"additional rules with normalized paths": {
+			in: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []ListenerRule{
+					{
+						Path: aws.String("/v2/"),
+					},
+					{
+						Path:  aws.String("/"),
+						Alias: Alias{"api.example.com"},
+						Query: map[string]string{
+							"version": "2",
+						},
+					},
+				},
+			},
+			wantedAdditional: []*template.ListenerRuleOpts{
+				{
+					Path: "/v2",
+				},
+				{
+					Aliases: []string{"api.example.com"},
+					Query: map[string]string{
+						"version": "2",
+					},
+				},
+			},
+		},
+		"error if an additional rule has no conditions": {
+			in: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []ListenerRule{
+					{
+						Path: aws.String("v2"),
+					},
+					{
+						Path: aws.String("/"),
+					},
+				},
+			},
+			wantedErr: errors.New(`additional rule 2 must have a path other than "/", an alias, headers or a query`),
+		},
+		"allowed source IPs apply to every rule": {
+			in: RoutingRule{
+				Path:             aws.String("api"),
+				AllowedSourceIPs: []string{"10.24.34.0/23", "192.0.2.1/32"},
+				AdditionalRules: []ListenerRule{
+					{
+						Path: aws.String("v2"),
+					},
+				},
+			},
+			wantedMain: &template.ListenerRuleOpts{
+				SourceIPs: []string{"10.24.34.0/23", "192.0.2.1/32"},
+			},
+			wantedAdditional: []*template.ListenerRuleOpts{
+				{
+					Path:      "/v2",
+					SourceIPs: []string{"10.24.34.0/23", "192.0.2.1/32"},
+				},
+			},
+		},
+		"error if an allowed source IP is not a CIDR range": {
+			in: RoutingRule{
+				Path:             aws.String("api"),
+				AllowedSourceIPs: []string{"10.24.34.1"},
+			},
+			wantedErr: errors.New(`"http.allowed_source_ips" must contain CIDR ranges, got "10.24.34.1"`),
+		},
+		"wildcard aliases are valid host names": {
+			in: RoutingRule{
+				Path:  aws.String("/"),
+				Alias: Alias{"*.example.com", "api-?.example.com"},
+			},
+			wantedMain: &template.ListenerRuleOpts{
+				Aliases: []string{"*.example.com", "api-?.example.com"},
+			},
+		},
+		"error if an alias is not a valid host name": {
+			in: RoutingRule{
+				Path:  aws.String("api"),
+				Alias: Alias{"example.com", "api example.com"},
+			},
+			wantedErr: errors.New(`validate "http.alias": alias "api example.com" must be a host name made of letters, numbers, hyphens, "*" and "?" separated by dots`),
+		},
+		"error if an alias of an additional rule has an empty label": {
+			in: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []ListenerRule{
+					{
+						Alias: Alias{"api..example.com"},
+					},
+				},
+			},
+			wantedErr: errors.New(`validate "alias" of additional rule 1: alias "api..example.com" must be a host name made of letters, numbers, hyphens, "*" and "?" separated by dots`),
+		},
+		"error if a header name is not a token": {
+			in: RoutingRule{
+				Path: aws.String("api"),
+				Headers: map[string][]string{
+					"X-Client":      {"mobile"},
+					"X-Client: Foo": {"bar"},
+				},
+			},
+			wantedErr: errors.New("validate \"http.headers\": header name \"X-Client: Foo\" must only contain letters, numbers and the characters !#$%&'*+-.^_`|~"),
+		},
+		"error if a header name of an additional rule is not a token": {
+			in: RoutingRule{
+				Path: aws.String("api"),
+				AdditionalRules: []ListenerRule{
+					{
+						Headers: map[string][]string{
+							"X Client": {"mobile"},
+						},
+					},
+				},
+			},
+			wantedErr: errors.New("validate \"headers\" of additional rule 1: header name \"X Client\" must only contain letters, numbers and the characters !#$%&'*+-.^_`|~"),
+		},
+		"error if the main rule has too many condition values": {
+			in: RoutingRule{
+				Path:  aws.String("api"),
+				Alias: Alias{"api.example.com", "v1.example.com"},
+				Headers: map[string][]string{
+					"X-Client": {"mobile", "tablet"},
+				},
+			},
+			wantedErr: errors.New(`"http" must have at most 5 path, alias, header, query and allowed source IP values, got 6`),
+		},
+		"error if an additional rule has too many condition values": {
+			in: RoutingRule{
+				Path:             aws.String("api"),
+				AllowedSourceIPs: []string{"10.24.34.0/23"},
+				AdditionalRules: []ListenerRule{
+					{
+						Path: aws.String("v2"),
+						Query: map[string]string{
+							"version": "2",
+							"preview": "true",
+							"region":  "us",
+						},
+					},
+				},
+			},
+			wantedErr: errors.New(`additional rule 1 must have at most 5 path, alias, header, query and allowed source IP values, got 6`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			main, additional, err := tc.in.ListenerRulesOpts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedMain, main)
+			require.Equal(t, tc.wantedAdditional, additional)
+		})
+	}
+}
@@ -5,7 +5,9 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -20,12 +22,15 @@ const (
 	LoadBalancedWebServiceType = "Load Balanced Web Service"
 	// BackendServiceType is a service that cannot be accessed from the internet but can be reached from other services.
 	BackendServiceType = "Backend Service"
+	// WorkerServiceType is a service that consumes messages from a queue and cannot be reached from other services.
+	WorkerServiceType = "Worker Service"
 )
 
 // ServiceTypes are the supported service manifest types.
 var ServiceTypes = []string{
 	LoadBalancedWebServiceType,
 	BackendServiceType,
+	WorkerServiceType,
 }
 
 // Range is a number range with maximum and minimum values.
@@ -59,7 +64,13 @@ type ServiceImageWithPort struct {
 // can either be of type int or type Autoscaling.
 type Count struct {
 	Value       *int        // 0 is a valid value, so we want the default value to be nil.
-	Autoscaling Autoscaling // Mutually exclusive with Value.
+	Spot        *int        // Number of tasks that run on Fargate Spot. Mutually exclusive with Value.
+	Autoscaling Autoscaling // Mutually exclusive with Value and Spot.
+}
+
+// spotCount represents the "spot" field of the count.
+type spotCount struct {
+	Spot *int `yaml:"spot"`
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Count
@@ -75,7 +86,25 @@ func (a *Count) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	var spot spotCount
+	if err := unmarshal(&spot); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
 	if !a.Autoscaling.IsEmpty() {
+		if spot.Spot != nil {
+			return errors.New(`"spot" cannot be specified with autoscaling fields in "count", use "spot_from" instead`)
+		}
+		return nil
+	}
+
+	if spot.Spot != nil {
+		a.Spot = spot.Spot
 		return nil
 	}
 
@@ -92,6 +121,30 @@ type Autoscaling struct {
 	Memory       *int           `yaml:"memory_percentage"`
 	Requests     *int           `yaml:"requests"`
 	ResponseTime *time.Duration `yaml:"response_time"`
+	QueueScaling *QueueScaling  `yaml:"queue_delay"`
+	SpotFrom     *int           `yaml:"spot_from"` // Tasks beyond the first (SpotFrom - 1) tasks run on Fargate Spot.
+}
+
+// QueueScaling represents the configuration to scale a worker service based on the messages in its queue.
+type QueueScaling struct {
+	AcceptableLatency *time.Duration `yaml:"acceptable_latency"`
+	AvgProcessingTime *time.Duration `yaml:"msg_processing_time"`
+}
+
+// acceptableBacklogPerTask returns the number of messages that a task can have in the queue
+// while still processing them within the acceptable latency.
+func (qs *QueueScaling) acceptableBacklogPerTask() (int, error) {
+	if qs.AcceptableLatency == nil || qs.AvgProcessingTime == nil {
+		return 0, errors.New(`"acceptable_latency" and "msg_processing_time" must be specified under "queue_delay"`)
+	}
+	if *qs.AvgProcessingTime <= 0 {
+		return 0, errors.New(`"msg_processing_time" under "queue_delay" must be greater than 0`)
+	}
+	backlog := int(*qs.AcceptableLatency / *qs.AvgProcessingTime)
+	if backlog < 1 {
+		return 0, errors.New(`"acceptable_latency" under "queue_delay" must be greater than or equal to "msg_processing_time"`)
+	}
+	return backlog, nil
 }
 
 // Options converts the service's Auto Scaling configuration into a format parsable
@@ -121,13 +174,111 @@ func (a *Autoscaling) Options() (*template.AutoscalingOpts, error) {
 		responseTime := float64(*a.ResponseTime) / float64(time.Second)
 		autoscalingOpts.ResponseTime = aws.Float64(responseTime)
 	}
+	if a.QueueScaling != nil {
+		backlog, err := a.QueueScaling.acceptableBacklogPerTask()
+		if err != nil {
+			return nil, err
+		}
+		autoscalingOpts.QueueDelay = &template.AutoscalingQueueDelayOpts{
+			AcceptableBacklogPerTask: backlog,
+		}
+	}
 	return &autoscalingOpts, nil
 }
 
 // IsEmpty returns whether Autoscaling is empty.
 func (a *Autoscaling) IsEmpty() bool {
 	return a.Range == "" && a.CPU == nil && a.Memory == nil &&
-		a.Requests == nil && a.ResponseTime == nil
+		a.Requests == nil && a.ResponseTime == nil && a.QueueScaling == nil && a.SpotFrom == nil
+}
+
+// Desired returns the number of tasks that the service starts with.
+func (c *Count) Desired() (*int, error) {
+	if !c.Autoscaling.IsEmpty() {
+		min, _, err := c.Autoscaling.Range.Parse()
+		if err != nil {
+			return nil, fmt.Errorf("parse task count value %s: %w", string(c.Autoscaling.Range), err)
+		}
+		return aws.Int(min), nil
+	}
+	if c.Spot != nil {
+		return c.Spot, nil
+	}
+	return c.Value, nil
+}
+
+// CapacityProviders converts the service's Fargate Spot configuration into a capacity provider strategy
+// parsable by the templates pkg. Returns nil if none of the tasks run on Fargate Spot.
+func (c *Count) CapacityProviders() ([]*template.CapacityProviderStrategy, error) {
+	if c.Spot != nil {
+		if *c.Spot < 0 {
+			return nil, errors.New(`"spot" in "count" must be greater than or equal to 0`)
+		}
+		return []*template.CapacityProviderStrategy{
+			{
+				CapacityProvider: template.CapacityProviderFargateSpot,
+				Weight:           aws.Int(1),
+			},
+		}, nil
+	}
+	spotFrom := c.Autoscaling.SpotFrom
+	if spotFrom == nil {
+		return nil, nil
+	}
+	if *spotFrom < 1 {
+		return nil, errors.New(`"spot_from" in "count" must be greater than or equal to 1`)
+	}
+	return []*template.CapacityProviderStrategy{
+		{
+			CapacityProvider: template.CapacityProviderFargateSpot,
+			Weight:           aws.Int(1),
+		},
+		{
+			// The first (spot_from - 1) tasks run on Fargate, and the remaining tasks on Fargate Spot.
+			CapacityProvider: template.CapacityProviderFargate,
+			Base:             aws.Int(*spotFrom - 1),
+			Weight:           aws.Int(0),
+		},
+	}, nil
+}
+
+// topicNameRegexp matches the topic names that can be used in SNS topic names and CloudFormation export names.
+var topicNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// PublishConfig represents the SNS topics that the service publishes events to.
+type PublishConfig struct {
+	Topics []Topic `yaml:"topics"`
+}
+
+// Topic represents an SNS topic that the service publishes events to.
+type Topic struct {
+	Name *string `yaml:"name"`
+}
+
+// Options converts the service's topics into a format parsable by the templates pkg.
+// Returns nil if the service doesn't publish to any topic.
+func (p PublishConfig) Options() (*template.PublishOpts, error) {
+	if len(p.Topics) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]bool)
+	var topics []*template.Topic
+	for _, topic := range p.Topics {
+		name := aws.StringValue(topic.Name)
+		if !topicNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf(`topic name "%s" must contain only alphanumeric characters and hyphens`, name)
+		}
+		if seen[template.StripNonAlphaNumFunc(name)] {
+			return nil, fmt.Errorf(`topic name "%s" is not unique`, name)
+		}
+		seen[template.StripNonAlphaNumFunc(name)] = true
+		topics = append(topics, &template.Topic{
+			Name: name,
+		})
+	}
+	return &template.PublishOpts{
+		Topics: topics,
+	}, nil
 }
 
 func durationp(v time.Duration) *time.Duration {
@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -71,8 +72,10 @@ environments:
 							BuildString: aws.String("frontend/Dockerfile"),
 						}}, Port: aws.Uint16(80)},
 						RoutingRule: RoutingRule{
-							Path:            aws.String("svc"),
-							HealthCheckPath: aws.String("/"),
+							Path: aws.String("svc"),
+							HealthCheck: HealthCheckArgsOrString{
+								HealthCheckPath: aws.String("/"),
+							},
 							TargetContainer: aws.String("frontend"),
 						},
 						TaskConfig: TaskConfig{
@@ -187,6 +190,72 @@ secrets:
 				require.Equal(t, wantedManifest, actualManifest)
 			},
 		},
+		"Worker Service": {
+			inContent: `
+name: processor
+type: Worker Service
+image:
+  build: ./processor/Dockerfile
+subscribe:
+  topics:
+    - name: orders
+      service: api
+  queue:
+    dead_letter:
+      tries: 5
+count:
+  range: 1-10
+  queue_delay:
+    acceptable_latency: 1m
+    msg_processing_time: 250ms`,
+			requireCorrectValues: func(t *testing.T, i interface{}) {
+				actualManifest, ok := i.(*WorkerService)
+				require.True(t, ok)
+				wantedManifest := &WorkerService{
+					Workload: Workload{
+						Name: aws.String("processor"),
+						Type: aws.String(WorkerServiceType),
+					},
+					WorkerServiceConfig: WorkerServiceConfig{
+						Image: imageWithHealthcheck{
+							Image: Image{
+								Build: BuildArgsOrString{
+									BuildString: aws.String("./processor/Dockerfile"),
+								},
+							},
+						},
+						TaskConfig: TaskConfig{
+							CPU:    aws.Int(256),
+							Memory: aws.Int(512),
+							Count: Count{
+								Value: aws.Int(1),
+								Autoscaling: Autoscaling{
+									Range: "1-10",
+									QueueScaling: &QueueScaling{
+										AcceptableLatency: durationp(time.Minute),
+										AvgProcessingTime: durationp(250 * time.Millisecond),
+									},
+								},
+							},
+						},
+						Subscribe: SubscribeConfig{
+							Topics: []TopicSubscription{
+								{
+									Name:    aws.String("orders"),
+									Service: aws.String("api"),
+								},
+							},
+							Queue: QueueConfig{
+								DeadLetter: DeadLetterQueueConfig{
+									Tries: aws.Int(5),
+								},
+							},
+						},
+					},
+				}
+				require.Equal(t, wantedManifest, actualManifest)
+			},
+		},
 		"invalid svc type": {
 			inContent: `
 name: CowSvc
@@ -242,6 +311,35 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"With spot count": {
+			inContent: []byte(`count:
+  spot: 3
+`),
+			wantedStruct: Count{
+				Spot: aws.Int(3),
+			},
+		},
+		"With auto scaling on spot": {
+			inContent: []byte(`count:
+  range: 1-10
+  spot_from: 3
+  cpu_percentage: 70
+`),
+			wantedStruct: Count{
+				Autoscaling: Autoscaling{
+					Range:    Range("1-10"),
+					CPU:      aws.Int(70),
+					SpotFrom: aws.Int(3),
+				},
+			},
+		},
+		"Error if both spot and auto scaling fields are specified": {
+			inContent: []byte(`count:
+  range: 1-10
+  spot: 3
+`),
+			wantedError: errors.New(`"spot" cannot be specified with autoscaling fields in "count", use "spot_from" instead`),
+		},
 		"Error if unmarshalable": {
 			inContent: []byte(`count: badNumber
 `),
@@ -258,6 +356,8 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				require.NoError(t, err)
 				// check memberwise dereferenced pointer equality
 				require.Equal(t, tc.wantedStruct.Value, b.Count.Value)
+				require.Equal(t, tc.wantedStruct.Spot, b.Count.Spot)
+				require.Equal(t, tc.wantedStruct.Autoscaling.SpotFrom, b.Count.Autoscaling.SpotFrom)
 				require.Equal(t, tc.wantedStruct.Autoscaling.Range, b.Count.Autoscaling.Range)
 				require.Equal(t, tc.wantedStruct.Autoscaling.CPU, b.Count.Autoscaling.CPU)
 				require.Equal(t, tc.wantedStruct.Autoscaling.Memory, b.Count.Autoscaling.Memory)
@@ -326,6 +426,7 @@ func TestAutoscaling_Options(t *testing.T) {
 		inMemory       int
 		inRequests     int
 		inResponseTime time.Duration
+		inQueueScaling *QueueScaling
 
 		wanted    *template.AutoscalingOpts
 		wantedErr error
@@ -351,6 +452,38 @@ func TestAutoscaling_Options(t *testing.T) {
 				ResponseTime: aws.Float64(0.512),
 			},
 		},
+		"invalid queue delay": {
+			inRange: mockRange,
+			inQueueScaling: &QueueScaling{
+				AcceptableLatency: durationp(100 * time.Millisecond),
+				AvgProcessingTime: durationp(time.Second),
+			},
+
+			wantedErr: fmt.Errorf(`"acceptable_latency" under "queue_delay" must be greater than or equal to "msg_processing_time"`),
+		},
+		"success with queue delay": {
+			inRange:        mockRange,
+			inCPU:          70,
+			inMemory:       80,
+			inRequests:     mockRequests,
+			inResponseTime: mockResponseTime,
+			inQueueScaling: &QueueScaling{
+				AcceptableLatency: durationp(time.Minute),
+				AvgProcessingTime: durationp(250 * time.Millisecond),
+			},
+
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity:  aws.Int(100),
+				MinCapacity:  aws.Int(1),
+				CPU:          aws.Float64(70),
+				Memory:       aws.Float64(80),
+				Requests:     aws.Float64(1000),
+				ResponseTime: aws.Float64(0.512),
+				QueueDelay: &template.AutoscalingQueueDelayOpts{
+					AcceptableBacklogPerTask: 240,
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -360,6 +493,7 @@ func TestAutoscaling_Options(t *testing.T) {
 				Memory:       aws.Int(tc.inMemory),
 				Requests:     aws.Int(tc.inRequests),
 				ResponseTime: &tc.inResponseTime,
+				QueueScaling: tc.inQueueScaling,
 			}
 			got, err := a.Options()
 
@@ -372,3 +506,173 @@ func TestAutoscaling_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestCount_Desired(t *testing.T) {
+	testCases := map[string]struct {
+		in Count
+
+		wanted    *int
+		wantedErr error
+	}{
+		"task count": {
+			in:     Count{Value: aws.Int(2)},
+			wanted: aws.Int(2),
+		},
+		"spot count": {
+			in:     Count{Spot: aws.Int(3)},
+			wanted: aws.Int(3),
+		},
+		"minimum of the auto scaling range": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range:    Range("2-10"),
+					SpotFrom: aws.Int(3),
+				},
+			},
+			wanted: aws.Int(2),
+		},
+		"invalid auto scaling range": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					SpotFrom: aws.Int(3),
+				},
+			},
+			wantedErr: errors.New("parse task count value : invalid range value . Should be in format of ${min}-${max}"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Desired()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCount_CapacityProviders(t *testing.T) {
+	testCases := map[string]struct {
+		in Count
+
+		wanted    []*template.CapacityProviderStrategy
+		wantedErr error
+	}{
+		"no tasks on spot": {
+			in: Count{Value: aws.Int(2)},
+		},
+		"all tasks on spot": {
+			in: Count{Spot: aws.Int(3)},
+			wanted: []*template.CapacityProviderStrategy{
+				{
+					CapacityProvider: "FARGATE_SPOT",
+					Weight:           aws.Int(1),
+				},
+			},
+		},
+		"negative spot count": {
+			in:        Count{Spot: aws.Int(-1)},
+			wantedErr: errors.New(`"spot" in "count" must be greater than or equal to 0`),
+		},
+		"tasks on spot from the third one": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range:    Range("1-10"),
+					SpotFrom: aws.Int(3),
+				},
+			},
+			wanted: []*template.CapacityProviderStrategy{
+				{
+					CapacityProvider: "FARGATE_SPOT",
+					Weight:           aws.Int(1),
+				},
+				{
+					CapacityProvider: "FARGATE",
+					Base:             aws.Int(2),
+					Weight:           aws.Int(0),
+				},
+			},
+		},
+		"spot_from less than 1": {
+			in: Count{
+				Autoscaling: Autoscaling{
+					Range:    Range("1-10"),
+					SpotFrom: aws.Int(0),
+				},
+			},
+			wantedErr: errors.New(`"spot_from" in "count" must be greater than or equal to 1`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.CapacityProviders()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestPublishConfig_Options(t *testing.T) {
+	testCases := map[string]struct {
+		in PublishConfig
+
+		wanted    *template.PublishOpts
+		wantedErr error
+	}{
+		"returns nil if there are no topics": {
+			in: PublishConfig{},
+		},
+		"converts the topics": {
+			in: PublishConfig{
+				Topics: []Topic{
+					{Name: aws.String("orders")},
+					{Name: aws.String("give-aways")},
+				},
+			},
+			wanted: &template.PublishOpts{
+				Topics: []*template.Topic{
+					{Name: "orders"},
+					{Name: "give-aways"},
+				},
+			},
+		},
+		"errors if a topic name has invalid characters": {
+			in: PublishConfig{
+				Topics: []Topic{
+					{Name: aws.String("orders.fifo")},
+				},
+			},
+			wantedErr: errors.New(`topic name "orders.fifo" must contain only alphanumeric characters and hyphens`),
+		},
+		"errors if topic names are not unique": {
+			in: PublishConfig{
+				Topics: []Topic{
+					{Name: aws.String("give-aways")},
+					{Name: aws.String("giveaways")},
+				},
+			},
+			wantedErr: errors.New(`topic name "giveaways" is not unique`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.Options()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/imdario/mergo"
+)
+
+const (
+	workerSvcManifestPath = "workloads/services/worker/manifest.yml"
+
+	defaultDeadLetterTries = 10
+)
+
+// WorkerServiceProps represents the configuration needed to create a worker service.
+type WorkerServiceProps struct {
+	WorkloadProps
+	HealthCheck *ContainerHealthCheck // Optional healthcheck configuration.
+	Topics      []TopicSubscription   // Optional topics to subscribe to.
+}
+
+// WorkerService holds the configuration to create a worker service manifest.
+type WorkerService struct {
+	Workload            `yaml:",inline"`
+	WorkerServiceConfig `yaml:",inline"`
+	// Use *WorkerServiceConfig because of https://github.com/imdario/mergo/issues/146
+	Environments map[string]*WorkerServiceConfig `yaml:",flow"`
+
+	parser template.Parser
+}
+
+// WorkerServiceConfig holds the configuration that can be overriden per environments.
+type WorkerServiceConfig struct {
+	Image      imageWithHealthcheck `yaml:",flow"`
+	TaskConfig `yaml:",inline"`
+	*Logging   `yaml:"logging,flow"`
+	Sidecar    `yaml:",inline"`
+	Network    NetworkConfig   `yaml:"network"`
+	Exec       *bool           `yaml:"exec"`
+	Subscribe  SubscribeConfig `yaml:"subscribe"`
+	Publish    PublishConfig   `yaml:"publish"`
+}
+
+// LogConfigOpts converts the service's Firelens configuration into a format parsable by the templates pkg.
+func (wc *WorkerServiceConfig) LogConfigOpts() *template.LogConfigOpts {
+	if wc.Logging == nil {
+		return nil
+	}
+	return wc.logConfigOpts()
+}
+
+type imageWithHealthcheck struct {
+	Image       `yaml:",inline"`
+	HealthCheck *ContainerHealthCheck `yaml:"healthcheck"`
+}
+
+// HealthCheckOpts converts the image's healthcheck configuration into a format parsable by the templates pkg.
+func (i imageWithHealthcheck) HealthCheckOpts() *ecs.HealthCheck {
+	return i.HealthCheck.opts()
+}
+
+// SubscribeConfig represents the topics that the worker service consumes messages from.
+type SubscribeConfig struct {
+	Topics []TopicSubscription `yaml:"topics"`
+	Queue  QueueConfig         `yaml:"queue"`
+}
+
+// TopicSubscription represents a topic published by another service.
+type TopicSubscription struct {
+	Name    *string `yaml:"name"`
+	Service *string `yaml:"service"`
+}
+
+// QueueConfig represents the configuration of the queue that the topics deliver messages to.
+type QueueConfig struct {
+	DeadLetter DeadLetterQueueConfig `yaml:"dead_letter"`
+}
+
+// DeadLetterQueueConfig represents the configuration of the dead-letter queue.
+type DeadLetterQueueConfig struct {
+	Tries *int `yaml:"tries"` // Number of times a message is received before it's moved to the dead-letter queue.
+}
+
+// SubscribeOpts converts the service's subscriptions into a format parsable by the templates pkg.
+func (s SubscribeConfig) SubscribeOpts() *template.SubscribeOpts {
+	var topics []*template.TopicSubscription
+	for _, topic := range s.Topics {
+		topics = append(topics, &template.TopicSubscription{
+			Name:    aws.StringValue(topic.Name),
+			Service: aws.StringValue(topic.Service),
+		})
+	}
+	tries := s.Queue.DeadLetter.Tries
+	if tries == nil {
+		tries = aws.Int(defaultDeadLetterTries)
+	}
+	return &template.SubscribeOpts{
+		Topics:          topics,
+		DeadLetterTries: tries,
+	}
+}
+
+// NewWorkerService applies the props to a default worker service configuration with
+// minimal task sizes, single replica, no healthcheck, and then returns it.
+func NewWorkerService(props WorkerServiceProps) *WorkerService {
+	svc := newDefaultWorkerService()
+	var healthCheck *ContainerHealthCheck
+	if props.HealthCheck != nil {
+		// Create the healthcheck field only if the caller specified a healthcheck.
+		healthCheck = newDefaultContainerHealthCheck()
+		healthCheck.apply(props.HealthCheck)
+	}
+	// Apply overrides.
+	svc.Name = aws.String(props.Name)
+	if props.Dockerfile != "" {
+		svc.WorkerServiceConfig.Image.Build.BuildArgs.Dockerfile = aws.String(props.Dockerfile)
+	} else if props.Builder != "" {
+		svc.WorkerServiceConfig.Image.Build.BuildArgs.Builder = aws.String(props.Builder)
+	}
+	svc.WorkerServiceConfig.Image.HealthCheck = healthCheck
+	svc.WorkerServiceConfig.Subscribe.Topics = props.Topics
+	svc.parser = template.New()
+	return svc
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (s *WorkerService) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(workerSvcManifestPath, *s, template.WithFuncs(map[string]interface{}{
+		"fmtSlice":   template.FmtSliceFunc,
+		"quoteSlice": template.QuoteSliceFunc,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// BuildArgs returns a docker.BuildArguments object for the service given a workspace root directory
+func (s *WorkerService) BuildArgs(wsRoot string) *DockerBuildArgs {
+	return s.Image.BuildConfig(wsRoot)
+}
+
+// ApplyEnv returns the service manifest with environment overrides.
+// If the environment passed in does not have any overrides then it returns itself.
+func (s WorkerService) ApplyEnv(envName string) (*WorkerService, error) {
+	overrideConfig, ok := s.Environments[envName]
+	if !ok {
+		return &s, nil
+	}
+	override := *overrideConfig
+	if override.Subscribe.Topics == nil {
+		// Keep the subscriptions when the environment only overrides the queue configuration,
+		// otherwise the empty slice would overwrite them.
+		override.Subscribe.Topics = s.Subscribe.Topics
+	}
+	if override.Publish.Topics == nil {
+		override.Publish.Topics = s.Publish.Topics
+	}
+	override.Network.keepUnset(s.Network)
+	// Apply overrides to the original service s.
+	err := mergo.Merge(&s, WorkerService{
+		WorkerServiceConfig: override,
+	}, mergo.WithOverride, mergo.WithOverwriteWithEmptyValue)
+	if err != nil {
+		return nil, err
+	}
+	s.Environments = nil
+	return &s, nil
+}
+
+// newDefaultWorkerService returns a worker service with minimal task sizes and a single replica.
+func newDefaultWorkerService() *WorkerService {
+	return &WorkerService{
+		Workload: Workload{
+			Type: aws.String(WorkerServiceType),
+		},
+		WorkerServiceConfig: WorkerServiceConfig{
+			Image: imageWithHealthcheck{},
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+				Count: Count{
+					Value: aws.Int(1),
+				},
+			},
+		},
+	}
+}
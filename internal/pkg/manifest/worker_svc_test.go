@@ -0,0 +1,319 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNewWorkerSvc(t *testing.T) {
+	testCases := map[string]struct {
+		inProps WorkerServiceProps
+
+		wantedManifest *WorkerService
+	}{
+		"without healthcheck and topics": {
+			inProps: WorkerServiceProps{
+				WorkloadProps: WorkloadProps{
+					Name:       "processor",
+					Dockerfile: "./processor/Dockerfile",
+				},
+			},
+			wantedManifest: &WorkerService{
+				Workload: Workload{
+					Name: aws.String("processor"),
+					Type: aws.String(WorkerServiceType),
+				},
+				WorkerServiceConfig: WorkerServiceConfig{
+					Image: imageWithHealthcheck{
+						Image: Image{
+							Build: BuildArgsOrString{
+								BuildArgs: DockerBuildArgs{
+									Dockerfile: aws.String("./processor/Dockerfile"),
+								},
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+						Count: Count{
+							Value: aws.Int(1),
+						},
+					},
+				},
+			},
+		},
+		"with custom healthcheck command and topics": {
+			inProps: WorkerServiceProps{
+				WorkloadProps: WorkloadProps{
+					Name:       "processor",
+					Dockerfile: "./processor/Dockerfile",
+				},
+				HealthCheck: &ContainerHealthCheck{
+					Command: []string{"CMD", "pgrep processor || exit 1"},
+				},
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+			wantedManifest: &WorkerService{
+				Workload: Workload{
+					Name: aws.String("processor"),
+					Type: aws.String(WorkerServiceType),
+				},
+				WorkerServiceConfig: WorkerServiceConfig{
+					Image: imageWithHealthcheck{
+						Image: Image{
+							Build: BuildArgsOrString{
+								BuildArgs: DockerBuildArgs{
+									Dockerfile: aws.String("./processor/Dockerfile"),
+								},
+							},
+						},
+						HealthCheck: &ContainerHealthCheck{
+							Command:     []string{"CMD", "pgrep processor || exit 1"},
+							Interval:    durationp(10 * time.Second),
+							Retries:     aws.Int(2),
+							Timeout:     durationp(5 * time.Second),
+							StartPeriod: durationp(0 * time.Second),
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+						Count: Count{
+							Value: aws.Int(1),
+						},
+					},
+					Subscribe: SubscribeConfig{
+						Topics: []TopicSubscription{
+							{
+								Name:    aws.String("orders"),
+								Service: aws.String("api"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			wantedBytes, err := yaml.Marshal(tc.wantedManifest)
+			require.NoError(t, err)
+
+			// THEN
+			actualBytes, err := yaml.Marshal(NewWorkerService(tc.inProps))
+			require.NoError(t, err)
+			require.Equal(t, string(wantedBytes), string(actualBytes))
+		})
+	}
+}
+
+func TestWorkerSvc_ApplyEnv(t *testing.T) {
+	mockWorkerService := WorkerService{
+		Workload: Workload{
+			Name: aws.String("processor"),
+			Type: aws.String(WorkerServiceType),
+		},
+		WorkerServiceConfig: WorkerServiceConfig{
+			Image: imageWithHealthcheck{
+				Image: Image{
+					Build: BuildArgsOrString{
+						BuildArgs: DockerBuildArgs{
+							Dockerfile: aws.String("./Dockerfile"),
+						},
+					},
+				},
+			},
+			TaskConfig: TaskConfig{
+				CPU:    aws.Int(256),
+				Memory: aws.Int(512),
+				Count: Count{
+					Value: aws.Int(1),
+				},
+			},
+			Subscribe: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+			Network: NetworkConfig{
+				VPC: vpcConfig{
+					SecurityGroups: []string{"sg-1234"},
+				},
+				Ingress: []IngressRule{
+					{From: aws.String("api"), Ports: []int{8080}},
+				},
+			},
+			Publish: PublishConfig{
+				Topics: []Topic{
+					{Name: aws.String("processed")},
+				},
+			},
+		},
+		Environments: map[string]*WorkerServiceConfig{
+			"prod": {
+				Network: NetworkConfig{
+					VPC: vpcConfig{
+						Placement: aws.String(PrivateSubnetPlacement),
+					},
+				},
+				TaskConfig: TaskConfig{
+					Count: Count{
+						Value: aws.Int(3),
+					},
+				},
+				Subscribe: SubscribeConfig{
+					Queue: QueueConfig{
+						DeadLetter: DeadLetterQueueConfig{
+							Tries: aws.Int(5),
+						},
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		in         *WorkerService
+		envToApply string
+
+		wanted *WorkerService
+	}{
+		"no env override": {
+			in:         &mockWorkerService,
+			envToApply: "test",
+
+			wanted: &mockWorkerService,
+		},
+		"with env override": {
+			in:         &mockWorkerService,
+			envToApply: "prod",
+
+			wanted: &WorkerService{
+				Workload: Workload{
+					Name: aws.String("processor"),
+					Type: aws.String(WorkerServiceType),
+				},
+				WorkerServiceConfig: WorkerServiceConfig{
+					Image: imageWithHealthcheck{
+						Image: Image{
+							Build: BuildArgsOrString{
+								BuildArgs: DockerBuildArgs{
+									Dockerfile: aws.String("./Dockerfile"),
+								},
+							},
+						},
+					},
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+						Count: Count{
+							Value: aws.Int(3),
+						},
+					},
+					Subscribe: SubscribeConfig{
+						Topics: []TopicSubscription{
+							{
+								Name:    aws.String("orders"),
+								Service: aws.String("api"),
+							},
+						},
+						Queue: QueueConfig{
+							DeadLetter: DeadLetterQueueConfig{
+								Tries: aws.Int(5),
+							},
+						},
+					},
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement:      aws.String(PrivateSubnetPlacement),
+							SecurityGroups: []string{"sg-1234"},
+						},
+						Ingress: []IngressRule{
+							{From: aws.String("api"), Ports: []int{8080}},
+						},
+					},
+					Publish: PublishConfig{
+						Topics: []Topic{
+							{Name: aws.String("processed")},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := tc.in.ApplyEnv(tc.envToApply)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSubscribeConfig_SubscribeOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in SubscribeConfig
+
+		wanted *template.SubscribeOpts
+	}{
+		"defaults the number of dead-letter tries": {
+			in: SubscribeConfig{
+				Topics: []TopicSubscription{
+					{
+						Name:    aws.String("orders"),
+						Service: aws.String("api"),
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:    "orders",
+						Service: "api",
+					},
+				},
+				DeadLetterTries: aws.Int(10),
+			},
+		},
+		"with custom number of dead-letter tries": {
+			in: SubscribeConfig{
+				Queue: QueueConfig{
+					DeadLetter: DeadLetterQueueConfig{
+						Tries: aws.Int(3),
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				DeadLetterTries: aws.Int(3),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.SubscribeOpts())
+		})
+	}
+}
@@ -22,9 +22,20 @@ const (
 	defaultFluentbitImage = "amazon/aws-for-fluent-bit:latest"
 )
 
+// Subnet placements of the tasks.
+const (
+	PublicSubnetPlacement  = "public"
+	PrivateSubnetPlacement = "private"
+)
+
+var subnetPlacements = []string{PublicSubnetPlacement, PrivateSubnetPlacement}
+
 var (
 	errUnmarshalBuildOpts = errors.New("can't unmarshal build field into string or compose-style map")
-	errUnmarshalCountOpts = errors.New(`unmarshal "count" field to an integer or autoscaling configuration`)
+	errUnmarshalCountOpts = errors.New(`unmarshal "count" field to an integer, a spot count or autoscaling configuration`)
+	errUnmarshalAlias     = errors.New(`unmarshal "alias" field to a string or a list of strings`)
+
+	errUnmarshalHealthCheckArgs = errors.New(`unmarshal "healthcheck" field to a string or health check arguments`)
 )
 
 var dockerfileDefaultName = "Dockerfile"
@@ -176,16 +187,30 @@ func (b *DockerBuildArgs) isEmpty() bool {
 	return false
 }
 
-// Logging holds configuration for Firelens to route your logs.
+// Logging holds configuration for Firelens to route your logs, and for how long the logs are kept in CloudWatch.
 type Logging struct {
 	Image          *string           `yaml:"image"`
 	Destination    map[string]string `yaml:"destination,flow"`
 	EnableMetadata *bool             `yaml:"enableMetadata"`
 	SecretOptions  map[string]string `yaml:"secretOptions"`
 	ConfigFile     *string           `yaml:"configFilePath"`
+	Retention      *int              `yaml:"retention"` // Number of days the logs are kept in CloudWatch.
+}
+
+// LogRetention returns the number of days the logs of the workload are kept in CloudWatch.
+func (lc *Logging) LogRetention() int {
+	if lc == nil || lc.Retention == nil {
+		return LogRetentionInDays
+	}
+	return aws.IntValue(lc.Retention)
 }
 
 func (lc *Logging) logConfigOpts() *template.LogConfigOpts {
+	if lc.Retention != nil && lc.Image == nil && lc.Destination == nil && lc.EnableMetadata == nil &&
+		lc.SecretOptions == nil && lc.ConfigFile == nil {
+		// Only the retention of the CloudWatch logs is configured, so the logs aren't routed with Firelens.
+		return nil
+	}
 	return &template.LogConfigOpts{
 		Image:          lc.image(),
 		ConfigFile:     lc.ConfigFile,
@@ -270,6 +295,93 @@ type TaskConfig struct {
 	Secrets   map[string]string `yaml:"secrets"`
 }
 
+// NetworkConfig represents options for the network connection of the tasks within the environment's VPC.
+type NetworkConfig struct {
+	VPC     vpcConfig     `yaml:"vpc"`
+	Ingress []IngressRule `yaml:"ingress"`
+}
+
+// vpcConfig represents the subnets in which the tasks are placed and the security groups attached to them.
+type vpcConfig struct {
+	Placement      *string  `yaml:"placement"`
+	SecurityGroups []string `yaml:"security_groups"`
+}
+
+// IngressRule represents another workload in the environment that can reach the tasks on a list of ports.
+type IngressRule struct {
+	From  *string `yaml:"from"`
+	Ports []int   `yaml:"ports"`
+}
+
+// Options converts the network configuration into a format parsable by the templates pkg.
+// Tasks are placed in public subnets with a public IP unless the placement is private.
+func (c NetworkConfig) Options() (*template.NetworkOpts, error) {
+	var opts *template.NetworkOpts
+	switch placement := aws.StringValue(c.VPC.Placement); placement {
+	case "", PublicSubnetPlacement:
+		opts = &template.NetworkOpts{
+			AssignPublicIP: template.EnablePublicIP,
+			SubnetsType:    template.PublicSubnetsPlacement,
+		}
+	case PrivateSubnetPlacement:
+		opts = &template.NetworkOpts{
+			AssignPublicIP: template.DisablePublicIP,
+			SubnetsType:    template.PrivateSubnetsPlacement,
+		}
+	default:
+		return nil, fmt.Errorf(`field "network.vpc.placement" must be one of %s, got "%s"`,
+			strings.Join(subnetPlacements, ", "), placement)
+	}
+	opts.SecurityGroups = c.VPC.SecurityGroups
+	ingress, err := c.ingressOpts()
+	if err != nil {
+		return nil, err
+	}
+	opts.Ingress = ingress
+	return opts, nil
+}
+
+// keepUnset copies the security groups and ingress rules of base when c doesn't override them,
+// otherwise the empty slices would overwrite them when the environment overrides are merged.
+func (c *NetworkConfig) keepUnset(base NetworkConfig) {
+	if c.VPC.SecurityGroups == nil {
+		c.VPC.SecurityGroups = base.VPC.SecurityGroups
+	}
+	if c.Ingress == nil {
+		c.Ingress = base.Ingress
+	}
+}
+
+// ingressOpts returns one rule per source workload and port.
+func (c NetworkConfig) ingressOpts() ([]*template.IngressRule, error) {
+	var rules []*template.IngressRule
+	seen := make(map[string]bool)
+	for _, rule := range c.Ingress {
+		from := aws.StringValue(rule.From)
+		if from == "" {
+			return nil, errors.New(`field "from" of an ingress rule must be specified`)
+		}
+		if len(rule.Ports) == 0 {
+			return nil, fmt.Errorf(`field "ports" of the ingress rule from %s must have at least one port`, from)
+		}
+		for _, port := range rule.Ports {
+			if port < 1 || port > 65535 {
+				return nil, fmt.Errorf("port %d of the ingress rule from %s must be between 1 and 65535", port, from)
+			}
+			key := fmt.Sprintf("%s:%d", from, port)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			rules = append(rules, &template.IngressRule{
+				From: from,
+				Port: port,
+			})
+		}
+	}
+	return rules, nil
+}
+
 // WorkloadProps contains properties for creating a new workload manifest.
 type WorkloadProps struct {
 	Name       string
@@ -304,6 +416,16 @@ func UnmarshalWorkload(in []byte) (interface{}, error) {
 			m.BackendServiceConfig.Image.HealthCheck.applyIfNotSet(newDefaultContainerHealthCheck())
 		}
 		return m, nil
+	case WorkerServiceType:
+		m := newDefaultWorkerService()
+		if err := yaml.Unmarshal(in, m); err != nil {
+			return nil, fmt.Errorf("unmarshal to worker service: %w", err)
+		}
+		if m.WorkerServiceConfig.Image.HealthCheck != nil {
+			// Make sure that unset fields in the healthcheck gets a default value.
+			m.WorkerServiceConfig.Image.HealthCheck.applyIfNotSet(newDefaultContainerHealthCheck())
+		}
+		return m, nil
 	case ScheduledJobType:
 		m := newDefaultScheduledJob()
 		if err := yaml.Unmarshal(in, m); err != nil {
@@ -4,6 +4,7 @@
 package manifest
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -218,3 +219,150 @@ func TestSidecar_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestLogging_LogRetention(t *testing.T) {
+	testCases := map[string]struct {
+		in *Logging
+
+		wanted int
+	}{
+		"default retention without logging configuration": {
+			wanted: 30,
+		},
+		"default retention if not set": {
+			in: &Logging{
+				Image: aws.String("mockImage"),
+			},
+			wanted: 30,
+		},
+		"retention from the manifest": {
+			in: &Logging{
+				Retention: aws.Int(7),
+			},
+			wanted: 7,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.LogRetention())
+		})
+	}
+}
+
+func TestLogging_logConfigOpts(t *testing.T) {
+	testCases := map[string]struct {
+		in *Logging
+
+		wanted *template.LogConfigOpts
+	}{
+		"no firelens if only the retention is set": {
+			in: &Logging{
+				Retention: aws.Int(7),
+			},
+		},
+		"firelens with the default image": {
+			in: &Logging{
+				Destination: map[string]string{"Name": "cloudwatch"},
+				Retention:   aws.Int(7),
+			},
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String(defaultFluentbitImage),
+				EnableMetadata: aws.String("true"),
+				Destination:    map[string]string{"Name": "cloudwatch"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.logConfigOpts())
+		})
+	}
+}
+
+func TestNetworkConfig_Options(t *testing.T) {
+	testCases := map[string]struct {
+		inPlacement      *string
+		inSecurityGroups []string
+		inIngress        []IngressRule
+
+		wantedOpts *template.NetworkOpts
+		wantedErr  error
+	}{
+		"defaults to public subnets": {
+			wantedOpts: &template.NetworkOpts{
+				AssignPublicIP: template.EnablePublicIP,
+				SubnetsType:    template.PublicSubnetsPlacement,
+			},
+		},
+		"places the tasks in private subnets without a public IP": {
+			inPlacement: aws.String("private"),
+			wantedOpts: &template.NetworkOpts{
+				AssignPublicIP: template.DisablePublicIP,
+				SubnetsType:    template.PrivateSubnetsPlacement,
+			},
+		},
+		"errors on an invalid placement": {
+			inPlacement: aws.String("isolated"),
+			wantedErr:   fmt.Errorf(`field "network.vpc.placement" must be one of public, private, got "isolated"`),
+		},
+		"errors if an ingress rule doesn't have a source": {
+			inIngress: []IngressRule{
+				{Ports: []int{80}},
+			},
+			wantedErr: errors.New(`field "from" of an ingress rule must be specified`),
+		},
+		"errors if an ingress rule doesn't have ports": {
+			inIngress: []IngressRule{
+				{From: aws.String("frontend")},
+			},
+			wantedErr: errors.New(`field "ports" of the ingress rule from frontend must have at least one port`),
+		},
+		"errors if a port is out of range": {
+			inIngress: []IngressRule{
+				{From: aws.String("frontend"), Ports: []int{80, 70000}},
+			},
+			wantedErr: errors.New("port 70000 of the ingress rule from frontend must be between 1 and 65535"),
+		},
+		"flattens the ingress rules and keeps the security groups": {
+			inSecurityGroups: []string{"sg-1234"},
+			inIngress: []IngressRule{
+				{From: aws.String("frontend"), Ports: []int{80, 8080}},
+				{From: aws.String("api"), Ports: []int{80}},
+				{From: aws.String("frontend"), Ports: []int{80}},
+			},
+			wantedOpts: &template.NetworkOpts{
+				AssignPublicIP: template.EnablePublicIP,
+				SubnetsType:    template.PublicSubnetsPlacement,
+				SecurityGroups: []string{"sg-1234"},
+				Ingress: []*template.IngressRule{
+					{From: "frontend", Port: 80},
+					{From: "frontend", Port: 8080},
+					{From: "api", Port: 80},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := NetworkConfig{
+				VPC: vpcConfig{
+					Placement:      tc.inPlacement,
+					SecurityGroups: tc.inSecurityGroups,
+				},
+				Ingress: tc.inIngress,
+			}
+
+			// WHEN
+			opts, err := conf.Options()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOpts, opts)
+		})
+	}
+}
@@ -25,6 +25,9 @@ type NetworkConfigRunner struct {
 	Subnets        []string
 	SecurityGroups []string
 
+	// Spot is true if the tasks run on Fargate Spot instead of Fargate.
+	Spot bool
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	ClusterGetter DefaultClusterGetter
 	Starter       Runner
@@ -66,6 +69,7 @@ func (r *NetworkConfigRunner) Run() ([]*Task, error) {
 		SecurityGroups: r.SecurityGroups,
 		TaskFamilyName: taskFamilyName(r.GroupName),
 		StartedBy:      startedBy,
+		Spot:           r.Spot,
 	})
 	if err != nil {
 		return nil, &errRunTask{
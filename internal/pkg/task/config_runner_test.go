@@ -23,6 +23,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 
 		subnets        []string
 		securityGroups []string
+		spot           bool
 
 		mockClusterGetter func(m *mocks.MockDefaultClusterGetter)
 		mockStarter       func(m *mocks.MockRunner)
@@ -144,6 +145,42 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 				}, nil)
 			},
 
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
+		"run on Fargate Spot": {
+			count:     1,
+			groupName: "my-task",
+			spot:      true,
+
+			subnets:        []string{"subnet-1", "subnet-2"},
+			securityGroups: []string{"sg-1", "sg-2"},
+
+			mockClusterGetter: func(m *mocks.MockDefaultClusterGetter) {
+				m.EXPECT().DefaultCluster().Return("cluster-1", nil)
+			},
+			mockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().SubnetIDs(gomock.Any()).Times(0)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "cluster-1",
+					Count:          1,
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1", "sg-2"},
+					TaskFamilyName: taskFamilyName("my-task"),
+					StartedBy:      startedBy,
+					Spot:           true,
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+
 			wantedTasks: []*Task{
 				{
 					TaskARN: "task-1",
@@ -171,6 +208,7 @@ func TestNetworkConfigRunner_Run(t *testing.T) {
 
 				Subnets:        tc.subnets,
 				SecurityGroups: tc.securityGroups,
+				Spot:           tc.spot,
 
 				VPCGetter:     mockVpcGetter,
 				ClusterGetter: mockClusterGetter,
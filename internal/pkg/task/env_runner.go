@@ -38,6 +38,14 @@ type EnvRunner struct {
 	App string
 	Env string
 
+	// Optional subnets and security groups of the tasks, for example those of a service in the environment.
+	// By default, the tasks run in the public subnets of the environment with its security group.
+	Subnets        []string
+	SecurityGroups []string
+
+	// Spot is true if the tasks run on Fargate Spot instead of Fargate.
+	Spot bool
+
 	// Interfaces to interact with dependencies. Must not be nil.
 	VPCGetter     VPCGetter
 	ClusterGetter ResourceGetter
@@ -50,24 +58,30 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		return nil, err
 	}
 
-	cluster, err := r.cluster(r.App, r.Env)
+	cluster, err := envCluster(r.ClusterGetter, r.App, r.Env)
 	if err != nil {
 		return nil, err
 	}
 
 	filters := r.filtersForVPCFromAppEnv()
 
-	subnets, err := r.VPCGetter.PublicSubnetIDs(filters...)
-	if err != nil {
-		return nil, fmt.Errorf(fmtErrPublicSubnetsFromEnv, r.Env, err)
-	}
+	subnets := r.Subnets
 	if len(subnets) == 0 {
-		return nil, errNoSubnetFound
+		subnets, err = r.VPCGetter.PublicSubnetIDs(filters...)
+		if err != nil {
+			return nil, fmt.Errorf(fmtErrPublicSubnetsFromEnv, r.Env, err)
+		}
+		if len(subnets) == 0 {
+			return nil, errNoSubnetFound
+		}
 	}
 
-	securityGroups, err := r.VPCGetter.SecurityGroups(filters...)
-	if err != nil {
-		return nil, fmt.Errorf(fmtErrSecurityGroupsFromEnv, r.Env, err)
+	securityGroups := r.SecurityGroups
+	if len(securityGroups) == 0 {
+		securityGroups, err = r.VPCGetter.SecurityGroups(filters...)
+		if err != nil {
+			return nil, fmt.Errorf(fmtErrSecurityGroupsFromEnv, r.Env, err)
+		}
 	}
 
 	ecsTasks, err := r.Starter.RunTask(ecs.RunTaskInput{
@@ -77,6 +91,7 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 		SecurityGroups: securityGroups,
 		TaskFamilyName: taskFamilyName(r.GroupName),
 		StartedBy:      startedBy,
+		Spot:           r.Spot,
 	})
 	if err != nil {
 		return nil, &errRunTask{
@@ -87,8 +102,8 @@ func (r *EnvRunner) Run() ([]*Task, error) {
 	return convertECSTasks(ecsTasks), nil
 }
 
-func (r *EnvRunner) cluster(app, env string) (string, error) {
-	clusters, err := r.ClusterGetter.GetResourcesByTags(clusterResourceType, map[string]string{
+func envCluster(getter ResourceGetter, app, env string) (string, error) {
+	clusters, err := getter.GetResourcesByTags(clusterResourceType, map[string]string{
 		deploy.AppTagKey: app,
 		deploy.EnvTagKey: env,
 	})
@@ -103,7 +118,7 @@ func (r *EnvRunner) cluster(app, env string) (string, error) {
 
 	// NOTE: only one cluster is associated with an application and an environment
 	if len(clusters) > 1 {
-		return "", fmt.Errorf(fmtErrMoreThanOneClusterFromEnv, r.Env)
+		return "", fmt.Errorf(fmtErrMoreThanOneClusterFromEnv, env)
 	}
 	return clusters[0].ARN, nil
 }
@@ -54,6 +54,10 @@ func TestEnvRunner_Run(t *testing.T) {
 		count     int
 		groupName string
 
+		subnets        []string
+		securityGroups []string
+		spot           bool
+
 		mockVPCGetter      func(m *mocks.MockVPCGetter)
 		mockResourceGetter func(m *mocks.MockResourceGetter)
 		mockStarter        func(m *mocks.MockRunner)
@@ -179,6 +183,69 @@ func TestEnvRunner_Run(t *testing.T) {
 				},
 			},
 		},
+		"run in the given subnets and security groups": {
+			count:     1,
+			groupName: "my-task",
+
+			subnets:        []string{"subnet-3"},
+			securityGroups: []string{"sg-3"},
+
+			mockResourceGetter: mockResourceGetterWithCluster,
+			mockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().PublicSubnetIDs(gomock.Any()).Times(0)
+				m.EXPECT().SecurityGroups(gomock.Any()).Times(0)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "cluster-1",
+					Count:          1,
+					Subnets:        []string{"subnet-3"},
+					SecurityGroups: []string{"sg-3"},
+					TaskFamilyName: taskFamilyName("my-task"),
+					StartedBy:      startedBy,
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
+		"run on Fargate Spot": {
+			count:     1,
+			groupName: "my-task",
+			spot:      true,
+
+			mockResourceGetter: mockResourceGetterWithCluster,
+			mockVPCGetter: func(m *mocks.MockVPCGetter) {
+				m.EXPECT().PublicSubnetIDs(filtersForVPCFromAppEnv).Return([]string{"subnet-1", "subnet-2"}, nil)
+				m.EXPECT().SecurityGroups(filtersForVPCFromAppEnv).Return([]string{"sg-1", "sg-2"}, nil)
+			},
+			mockStarter: func(m *mocks.MockRunner) {
+				m.EXPECT().RunTask(ecs.RunTaskInput{
+					Cluster:        "cluster-1",
+					Count:          1,
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1", "sg-2"},
+					TaskFamilyName: taskFamilyName("my-task"),
+					StartedBy:      startedBy,
+					Spot:           true,
+				}).Return([]*ecs.Task{
+					{
+						TaskArn: aws.String("task-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN: "task-1",
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -200,6 +267,10 @@ func TestEnvRunner_Run(t *testing.T) {
 				App: inApp,
 				Env: inEnv,
 
+				Subnets:        tc.subnets,
+				SecurityGroups: tc.securityGroups,
+				Spot:           tc.spot,
+
 				VPCGetter:     mockVPCGetter,
 				ClusterGetter: mockResourceGetter,
 				Starter:       mockStarter,
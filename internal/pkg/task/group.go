@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+const (
+	fmtErrListGroupTasks = "list tasks of group %s: %w"
+	fmtErrStopGroupTasks = "stop tasks of group %s: %w"
+)
+
+// Group lists and stops the tasks of a task group, either in the cluster of an environment or in the default cluster.
+type Group struct {
+	// Name of the task group.
+	Name string
+
+	// App and Env in which the tasks run. Empty if the tasks run in the default cluster.
+	App string
+	Env string
+
+	// Interfaces to interact with dependencies.
+	// ClusterGetter must not be nil if the tasks run in an environment, DefaultClusterGetter otherwise.
+	ClusterGetter        ResourceGetter
+	DefaultClusterGetter DefaultClusterGetter
+	Manager              Manager
+}
+
+// RunningTasks returns the tasks of the group that are not stopped yet.
+func (g *Group) RunningTasks() ([]*Task, error) {
+	cluster, err := g.cluster()
+	if err != nil {
+		return nil, err
+	}
+	return g.tasks(cluster, ecs.DesiredStatusRunning)
+}
+
+// StoppedTasks returns the tasks of the group that stopped recently.
+// Amazon ECS only lists stopped tasks for a short time after they stop.
+func (g *Group) StoppedTasks() ([]*Task, error) {
+	cluster, err := g.cluster()
+	if err != nil {
+		return nil, err
+	}
+	return g.tasks(cluster, ecs.DesiredStatusStopped)
+}
+
+// Stop stops the running tasks of the group with the reason, and returns them.
+func (g *Group) Stop(reason string) ([]*Task, error) {
+	cluster, err := g.cluster()
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := g.tasks(cluster, ecs.DesiredStatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, nil
+	}
+	taskARNs := make([]string, len(tasks))
+	for i, task := range tasks {
+		taskARNs[i] = task.TaskARN
+	}
+	if err := g.Manager.StopTasks(cluster, taskARNs, reason); err != nil {
+		return nil, fmt.Errorf(fmtErrStopGroupTasks, g.Name, err)
+	}
+	return tasks, nil
+}
+
+func (g *Group) tasks(cluster, desiredStatus string) ([]*Task, error) {
+	ecsTasks, err := g.Manager.ListTasks(cluster, ecs.WithFamily(taskFamilyName(g.Name)), ecs.WithDesiredStatus(desiredStatus))
+	if err != nil {
+		return nil, fmt.Errorf(fmtErrListGroupTasks, g.Name, err)
+	}
+	return convertECSTasks(ecsTasks), nil
+}
+
+func (g *Group) cluster() (string, error) {
+	if g.Env != "" {
+		return envCluster(g.ClusterGetter, g.App, g.Env)
+	}
+	cluster, err := g.DefaultClusterGetter.DefaultCluster()
+	if err != nil {
+		return "", &errGetDefaultCluster{
+			parentErr: err,
+		}
+	}
+	return cluster, nil
+}
@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type groupMocks struct {
+	clusterGetter        *mocks.MockResourceGetter
+	defaultClusterGetter *mocks.MockDefaultClusterGetter
+	manager              *mocks.MockManager
+}
+
+func TestGroup_RunningTasks(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv      string
+		setupMocks func(m groupMocks)
+
+		wantedTasks []*Task
+		wantedError error
+	}{
+		"errors if failed to get the cluster of the environment": {
+			inEnv: "test",
+			setupMocks: func(m groupMocks) {
+				m.clusterGetter.EXPECT().GetResourcesByTags(clusterResourceType, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get cluster by env test: some error"),
+		},
+		"errors if failed to get the default cluster": {
+			setupMocks: func(m groupMocks) {
+				m.defaultClusterGetter.EXPECT().DefaultCluster().Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get default cluster: some error"),
+		},
+		"errors if failed to list tasks": {
+			setupMocks: func(m groupMocks) {
+				m.defaultClusterGetter.EXPECT().DefaultCluster().Return("default", nil)
+				m.manager.EXPECT().ListTasks("default", gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list tasks of group db-migrate: some error"),
+		},
+		"lists the running tasks in the cluster of the environment": {
+			inEnv: "test",
+			setupMocks: func(m groupMocks) {
+				m.clusterGetter.EXPECT().GetResourcesByTags(clusterResourceType, gomock.Any()).Return([]*resourcegroups.Resource{
+					{ARN: "cluster-1"},
+				}, nil)
+				m.manager.EXPECT().ListTasks("cluster-1", gomock.Any(), gomock.Any()).Return([]*ecs.Task{
+					{
+						TaskArn:    aws.String("task-1"),
+						ClusterArn: aws.String("cluster-1"),
+					},
+				}, nil)
+			},
+			wantedTasks: []*Task{
+				{
+					TaskARN:    "task-1",
+					ClusterARN: "cluster-1",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := groupMocks{
+				clusterGetter:        mocks.NewMockResourceGetter(ctrl),
+				defaultClusterGetter: mocks.NewMockDefaultClusterGetter(ctrl),
+				manager:              mocks.NewMockManager(ctrl),
+			}
+			tc.setupMocks(m)
+
+			group := &Group{
+				Name:                 "db-migrate",
+				App:                  "my-app",
+				Env:                  tc.inEnv,
+				ClusterGetter:        m.clusterGetter,
+				DefaultClusterGetter: m.defaultClusterGetter,
+				Manager:              m.manager,
+			}
+
+			tasks, err := group.RunningTasks()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
+
+func TestGroup_Stop(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m groupMocks)
+
+		wantedTasks []*Task
+		wantedError error
+	}{
+		"does not stop anything if no task is running": {
+			setupMocks: func(m groupMocks) {
+				m.defaultClusterGetter.EXPECT().DefaultCluster().Return("default", nil)
+				m.manager.EXPECT().ListTasks("default", gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.manager.EXPECT().StopTasks(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"errors if failed to stop tasks": {
+			setupMocks: func(m groupMocks) {
+				m.defaultClusterGetter.EXPECT().DefaultCluster().Return("default", nil)
+				m.manager.EXPECT().ListTasks("default", gomock.Any(), gomock.Any()).Return([]*ecs.Task{
+					{TaskArn: aws.String("task-1")},
+				}, nil)
+				m.manager.EXPECT().StopTasks(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("stop tasks of group db-migrate: some error"),
+		},
+		"stops the running tasks": {
+			setupMocks: func(m groupMocks) {
+				m.defaultClusterGetter.EXPECT().DefaultCluster().Return("default", nil)
+				m.manager.EXPECT().ListTasks("default", gomock.Any(), gomock.Any()).Return([]*ecs.Task{
+					{TaskArn: aws.String("task-1"), ClusterArn: aws.String("default")},
+					{TaskArn: aws.String("task-2"), ClusterArn: aws.String("default")},
+				}, nil)
+				m.manager.EXPECT().StopTasks("default", []string{"task-1", "task-2"}, "some reason").Return(nil)
+			},
+			wantedTasks: []*Task{
+				{TaskARN: "task-1", ClusterARN: "default"},
+				{TaskARN: "task-2", ClusterARN: "default"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := groupMocks{
+				clusterGetter:        mocks.NewMockResourceGetter(ctrl),
+				defaultClusterGetter: mocks.NewMockDefaultClusterGetter(ctrl),
+				manager:              mocks.NewMockManager(ctrl),
+			}
+			tc.setupMocks(m)
+
+			group := &Group{
+				Name:                 "db-migrate",
+				DefaultClusterGetter: m.defaultClusterGetter,
+				Manager:              m.manager,
+			}
+
+			tasks, err := group.Stop("some reason")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, tasks)
+			}
+		})
+	}
+}
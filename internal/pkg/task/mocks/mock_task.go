@@ -205,3 +205,113 @@ func (mr *MockRunnerMockRecorder) RunTask(input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTask", reflect.TypeOf((*MockRunner)(nil).RunTask), input)
 }
+
+// MockDescriber is a mock of Describer interface
+type MockDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockDescriberMockRecorder
+}
+
+// MockDescriberMockRecorder is the mock recorder for MockDescriber
+type MockDescriberMockRecorder struct {
+	mock *MockDescriber
+}
+
+// NewMockDescriber creates a new mock instance
+func NewMockDescriber(ctrl *gomock.Controller) *MockDescriber {
+	mock := &MockDescriber{ctrl: ctrl}
+	mock.recorder = &MockDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDescriber) EXPECT() *MockDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeTasks mocks base method
+func (m *MockDescriber) DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTasks", cluster, taskARNs)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTasks indicates an expected call of DescribeTasks
+func (mr *MockDescriberMockRecorder) DescribeTasks(cluster, taskARNs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTasks", reflect.TypeOf((*MockDescriber)(nil).DescribeTasks), cluster, taskARNs)
+}
+
+// TaskDefinition mocks base method
+func (m *MockDescriber) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition
+func (mr *MockDescriberMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockDescriber)(nil).TaskDefinition), taskDefName)
+}
+
+// MockManager is a mock of Manager interface
+type MockManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagerMockRecorder
+}
+
+// MockManagerMockRecorder is the mock recorder for MockManager
+type MockManagerMockRecorder struct {
+	mock *MockManager
+}
+
+// NewMockManager creates a new mock instance
+func NewMockManager(ctrl *gomock.Controller) *MockManager {
+	mock := &MockManager{ctrl: ctrl}
+	mock.recorder = &MockManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockManager) EXPECT() *MockManagerMockRecorder {
+	return m.recorder
+}
+
+// ListTasks mocks base method
+func (m *MockManager) ListTasks(cluster string, opts ...ecs.ListTasksOpts) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{cluster}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTasks", varargs...)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTasks indicates an expected call of ListTasks
+func (mr *MockManagerMockRecorder) ListTasks(cluster interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{cluster}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockManager)(nil).ListTasks), varargs...)
+}
+
+// StopTasks mocks base method
+func (m *MockManager) StopTasks(cluster string, taskARNs []string, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTasks", cluster, taskARNs, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks
+func (mr *MockManagerMockRecorder) StopTasks(cluster, taskARNs, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MockManager)(nil).StopTasks), cluster, taskARNs, reason)
+}
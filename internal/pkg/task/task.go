@@ -36,6 +36,18 @@ type Runner interface {
 	RunTask(input ecs.RunTaskInput) ([]*ecs.Task, error)
 }
 
+// Describer describes tasks and their task definitions.
+type Describer interface {
+	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
+	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+}
+
+// Manager lists and stops tasks in a cluster.
+type Manager interface {
+	ListTasks(cluster string, opts ...ecs.ListTasksOpts) ([]*ecs.Task, error)
+	StopTasks(cluster string, taskARNs []string, reason string) error
+}
+
 // Task represents a one-off workload that runs until completed or an error occurs.
 type Task struct {
 	TaskARN    string
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+var pollInterval = 6 * time.Second
+
+// ContainerExit holds how a container of a stopped task exited.
+type ContainerExit struct {
+	TaskARN   string
+	Name      string
+	Essential bool   // The task stops when an essential container exits.
+	ExitCode  *int64 // Nil if the container never ran, for example if its image could not be pulled.
+	Reason    string // Reason the container stopped, e.g. "CannotPullContainerError: ...".
+
+	TaskStoppedReason string // Reason the task stopped, e.g. "Essential container in task exited".
+}
+
+// Failed returns true if the container did not run or exited with a non-zero code.
+func (c *ContainerExit) Failed() bool {
+	return c.ExitCode == nil || *c.ExitCode != 0
+}
+
+// Watcher waits for tasks to stop and reports how their containers exited.
+type Watcher struct {
+	// Interfaces to interact with dependencies. Must not be nil.
+	Describer Describer
+}
+
+// WaitUntilStopped polls the tasks until all of them have stopped.
+func (w *Watcher) WaitUntilStopped(tasks []*Task) error {
+	for {
+		ecsTasks, err := w.describe(tasks)
+		if err != nil {
+			return err
+		}
+		stopped := true
+		for _, t := range ecsTasks {
+			if aws.StringValue(t.LastStatus) != ecs.DesiredStatusStopped {
+				stopped = false
+				break
+			}
+		}
+		if stopped {
+			return nil
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// ContainerExits returns how each container of the stopped tasks exited.
+func (w *Watcher) ContainerExits(tasks []*Task) ([]*ContainerExit, error) {
+	ecsTasks, err := w.describe(tasks)
+	if err != nil {
+		return nil, err
+	}
+	essentials := make(map[string]map[string]bool) // Essential containers by task definition ARN.
+	var exits []*ContainerExit
+	for _, t := range ecsTasks {
+		taskDefARN := aws.StringValue(t.TaskDefinitionArn)
+		if _, ok := essentials[taskDefARN]; !ok {
+			essentials[taskDefARN], err = w.essentialContainers(taskDefARN)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, container := range t.Containers {
+			exits = append(exits, &ContainerExit{
+				TaskARN:           aws.StringValue(t.TaskArn),
+				Name:              aws.StringValue(container.Name),
+				Essential:         essentials[taskDefARN][aws.StringValue(container.Name)],
+				ExitCode:          container.ExitCode,
+				Reason:            aws.StringValue(container.Reason),
+				TaskStoppedReason: aws.StringValue(t.StoppedReason),
+			})
+		}
+	}
+	return exits, nil
+}
+
+func (w *Watcher) describe(tasks []*Task) ([]*ecs.Task, error) {
+	taskARNs := make([]string, len(tasks))
+	for idx, task := range tasks {
+		taskARNs[idx] = task.TaskARN
+	}
+	// NOTE: all tasks are deployed to the same cluster and there are at least one tasks being deployed
+	ecsTasks, err := w.Describer.DescribeTasks(tasks[0].ClusterARN, taskARNs)
+	if err != nil {
+		return nil, fmt.Errorf("describe tasks: %w", err)
+	}
+	return ecsTasks, nil
+}
+
+func (w *Watcher) essentialContainers(taskDefARN string) (map[string]bool, error) {
+	taskDef, err := w.Describer.TaskDefinition(taskDefARN)
+	if err != nil {
+		return nil, fmt.Errorf("get task definition of tasks: %w", err)
+	}
+	essentials := make(map[string]bool)
+	for _, container := range taskDef.ContainerDefinitions {
+		// Containers are essential unless explicitly marked otherwise.
+		essentials[aws.StringValue(container.Name)] = container.Essential == nil || aws.BoolValue(container.Essential)
+	}
+	return essentials, nil
+}
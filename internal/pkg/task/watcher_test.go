@@ -0,0 +1,205 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package task
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockTaskARN1   = "arn:aws:ecs:us-west-2:123456789:task/cluster/task1"
+	mockTaskARN2   = "arn:aws:ecs:us-west-2:123456789:task/cluster/task2"
+	mockTaskDefARN = "arn:aws:ecs:us-west-2:123456789:task-definition/copilot-migrate:1"
+)
+
+var mockTasks = []*Task{
+	{
+		TaskARN:    mockTaskARN1,
+		ClusterARN: "cluster",
+	},
+	{
+		TaskARN:    mockTaskARN2,
+		ClusterARN: "cluster",
+	},
+}
+
+func TestWatcher_WaitUntilStopped(t *testing.T) {
+	pollInterval = 0
+	testCases := map[string]struct {
+		mockDescriber func(m *mocks.MockDescriber)
+
+		wantedError error
+	}{
+		"error describing tasks": {
+			mockDescriber: func(m *mocks.MockDescriber) {
+				m.EXPECT().DescribeTasks("cluster", []string{mockTaskARN1, mockTaskARN2}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe tasks: some error"),
+		},
+		"polls until all tasks have stopped": {
+			mockDescriber: func(m *mocks.MockDescriber) {
+				gomock.InOrder(
+					m.EXPECT().DescribeTasks("cluster", []string{mockTaskARN1, mockTaskARN2}).Return([]*ecs.Task{
+						{TaskArn: aws.String(mockTaskARN1), LastStatus: aws.String(ecs.DesiredStatusStopped)},
+						{TaskArn: aws.String(mockTaskARN2), LastStatus: aws.String("RUNNING")},
+					}, nil),
+					m.EXPECT().DescribeTasks("cluster", []string{mockTaskARN1, mockTaskARN2}).Return([]*ecs.Task{
+						{TaskArn: aws.String(mockTaskARN1), LastStatus: aws.String(ecs.DesiredStatusStopped)},
+						{TaskArn: aws.String(mockTaskARN2), LastStatus: aws.String(ecs.DesiredStatusStopped)},
+					}, nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDescriber := mocks.NewMockDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			w := &Watcher{
+				Describer: mockDescriber,
+			}
+
+			err := w.WaitUntilStopped(mockTasks)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWatcher_ContainerExits(t *testing.T) {
+	testCases := map[string]struct {
+		mockDescriber func(m *mocks.MockDescriber)
+
+		wantedExits []*ContainerExit
+		wantedError error
+	}{
+		"error describing tasks": {
+			mockDescriber: func(m *mocks.MockDescriber) {
+				m.EXPECT().DescribeTasks("cluster", []string{mockTaskARN1, mockTaskARN2}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe tasks: some error"),
+		},
+		"error getting the task definition": {
+			mockDescriber: func(m *mocks.MockDescriber) {
+				m.EXPECT().DescribeTasks("cluster", []string{mockTaskARN1, mockTaskARN2}).Return([]*ecs.Task{
+					{TaskArn: aws.String(mockTaskARN1), TaskDefinitionArn: aws.String(mockTaskDefARN)},
+				}, nil)
+				m.EXPECT().TaskDefinition(mockTaskDefARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get task definition of tasks: some error"),
+		},
+		"returns the exit of every container": {
+			mockDescriber: func(m *mocks.MockDescriber) {
+				m.EXPECT().DescribeTasks("cluster", []string{mockTaskARN1, mockTaskARN2}).Return([]*ecs.Task{
+					{
+						TaskArn:           aws.String(mockTaskARN1),
+						TaskDefinitionArn: aws.String(mockTaskDefARN),
+						StoppedReason:     aws.String("Essential container in task exited"),
+						Containers: []*awsecs.Container{
+							{
+								Name:     aws.String("migrate"),
+								ExitCode: aws.Int64(0),
+							},
+							{
+								Name:     aws.String("sidecar"),
+								ExitCode: aws.Int64(137),
+							},
+						},
+					},
+					{
+						TaskArn:           aws.String(mockTaskARN2),
+						TaskDefinitionArn: aws.String(mockTaskDefARN),
+						StoppedReason:     aws.String("Task failed to start"),
+						Containers: []*awsecs.Container{
+							{
+								Name:   aws.String("migrate"),
+								Reason: aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)"),
+							},
+							{
+								Name: aws.String("sidecar"),
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().TaskDefinition(mockTaskDefARN).Return(&ecs.TaskDefinition{
+					ContainerDefinitions: []*awsecs.ContainerDefinition{
+						{Name: aws.String("migrate")},
+						{Name: aws.String("sidecar"), Essential: aws.Bool(false)},
+					},
+				}, nil).Times(1)
+			},
+			wantedExits: []*ContainerExit{
+				{
+					TaskARN:           mockTaskARN1,
+					Name:              "migrate",
+					Essential:         true,
+					ExitCode:          aws.Int64(0),
+					TaskStoppedReason: "Essential container in task exited",
+				},
+				{
+					TaskARN:           mockTaskARN1,
+					Name:              "sidecar",
+					ExitCode:          aws.Int64(137),
+					TaskStoppedReason: "Essential container in task exited",
+				},
+				{
+					TaskARN:           mockTaskARN2,
+					Name:              "migrate",
+					Essential:         true,
+					Reason:            "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+					TaskStoppedReason: "Task failed to start",
+				},
+				{
+					TaskARN:           mockTaskARN2,
+					Name:              "sidecar",
+					TaskStoppedReason: "Task failed to start",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDescriber := mocks.NewMockDescriber(ctrl)
+			tc.mockDescriber(mockDescriber)
+
+			w := &Watcher{
+				Describer: mockDescriber,
+			}
+
+			exits, err := w.ContainerExits(mockTasks)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExits, exits)
+			}
+		})
+	}
+}
+
+func TestContainerExit_Failed(t *testing.T) {
+	require.False(t, (&ContainerExit{ExitCode: aws.Int64(0)}).Failed())
+	require.True(t, (&ContainerExit{ExitCode: aws.Int64(1)}).Failed())
+	require.True(t, (&ContainerExit{}).Failed())
+}
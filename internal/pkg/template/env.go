@@ -24,9 +24,21 @@ var (
 		"environment-manager-role",
 		"lambdas",
 		"vpc-resources",
+		"private-route-tables",
+		"nat-gateways",
+		"vpc-endpoints",
+		"elb-access-logs",
 	}
 )
 
+// ELBAccessLogsOpts holds the configuration of the access logs of the public load balancer.
+// If BucketName is empty, the logs are stored in a bucket created by the environment stack whose objects expire after Retention days.
+type ELBAccessLogsOpts struct {
+	BucketName string
+	Prefix     string
+	Retention  int
+}
+
 // EnvOpts holds data that can be provided to enable features in an environment stack template.
 type EnvOpts struct {
 	Version string // The template version to use for the environment. If empty uses the "legacy" template.
@@ -35,8 +47,16 @@ type EnvOpts struct {
 	ACMValidationLambda       string
 	EnableLongARNFormatLambda string
 
-	ImportVPC *config.ImportVPC
-	VPCConfig *config.AdjustVPC
+	ImportVPC    *config.ImportVPC
+	VPCConfig    *config.AdjustVPC
+	NATGateways  string // Either "per-az" or "single". No NAT gateways are created if empty.
+	VPCEndpoints bool   // Whether or not to create VPC endpoints for the AWS services used by the tasks.
+
+	ImportCertARNs          []string
+	WebACLARN               string // ARN of an existing WAFv2 web ACL associated with the public load balancer.
+	ELBAccessLogs           *ELBAccessLogsOpts
+	InternalALB             bool // Whether or not to create an internal load balancer for backend services.
+	EnableContainerInsights bool
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
@@ -34,6 +34,10 @@ func TestTemplate_ParseEnv(t *testing.T) {
   environment-manager-role
   lambdas
   vpc-resources
+  private-route-tables
+  nat-gateways
+  vpc-endpoints
+  elb-access-logs
 `,
 		},
 		"renders v1.0.0 template": {
@@ -58,6 +62,10 @@ func TestTemplate_ParseEnv(t *testing.T) {
 			tpl.box.AddString("environment/partials/environment-manager-role.yml", "environment-manager-role")
 			tpl.box.AddString("environment/partials/lambdas.yml", "lambdas")
 			tpl.box.AddString("environment/partials/vpc-resources.yml", "vpc-resources")
+			tpl.box.AddString("environment/partials/private-route-tables.yml", "private-route-tables")
+			tpl.box.AddString("environment/partials/nat-gateways.yml", "nat-gateways")
+			tpl.box.AddString("environment/partials/vpc-endpoints.yml", "vpc-endpoints")
+			tpl.box.AddString("environment/partials/elb-access-logs.yml", "elb-access-logs")
 
 			// WHEN
 			c, err := tpl.ParseEnv(&EnvOpts{
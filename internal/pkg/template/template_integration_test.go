@@ -76,6 +76,21 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				},
 			},
 		},
+		"renders a valid template with tasks on Fargate Spot": {
+			opts: template.WorkloadOpts{
+				CapacityProviders: []*template.CapacityProviderStrategy{
+					{
+						CapacityProvider: template.CapacityProviderFargateSpot,
+						Weight:           aws.Int(1),
+					},
+					{
+						CapacityProvider: template.CapacityProviderFargate,
+						Base:             aws.Int(2),
+						Weight:           aws.Int(0),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -37,6 +37,10 @@ var (
 		"sidecars",
 		"logconfig",
 		"autoscaling",
+		"publish",
+		"publish-outputs",
+		"security-group",
+		"listener-rule-conditions",
 		"eventrule",
 		"state-machine",
 		"state-machine-definition.json",
@@ -47,6 +51,7 @@ var (
 const (
 	lbWebSvcTplName     = "lb-web"
 	backendSvcTplName   = "backend"
+	workerSvcTplName    = "worker"
 	scheduledJobTplName = "scheduled-job"
 )
 
@@ -86,6 +91,79 @@ type AutoscalingOpts struct {
 	Memory       *float64
 	Requests     *float64
 	ResponseTime *float64
+	QueueDelay   *AutoscalingQueueDelayOpts
+}
+
+// AutoscalingQueueDelayOpts holds configuration to scale a worker service based on the backlog of messages per task.
+type AutoscalingQueueDelayOpts struct {
+	AcceptableBacklogPerTask int
+}
+
+// SubscribeOpts holds configuration needed if the service consumes messages from a queue.
+type SubscribeOpts struct {
+	Topics          []*TopicSubscription
+	DeadLetterTries *int
+}
+
+// TopicSubscription holds the name of a topic and the name of the service that publishes to it.
+type TopicSubscription struct {
+	Name    string
+	Service string
+}
+
+// PublishOpts holds configuration needed if the service publishes events to SNS topics.
+type PublishOpts struct {
+	Topics []*Topic
+}
+
+// Topic holds the name of an SNS topic that the service publishes to.
+type Topic struct {
+	Name string
+}
+
+// Values of the network configuration of the tasks.
+const (
+	EnablePublicIP          = "ENABLED"
+	DisablePublicIP         = "DISABLED"
+	PublicSubnetsPlacement  = "PublicSubnets"
+	PrivateSubnetsPlacement = "PrivateSubnets"
+)
+
+// NetworkOpts holds the network configuration of the tasks.
+type NetworkOpts struct {
+	AssignPublicIP string
+	SubnetsType    string   // Name of the environment stack output with the subnets to place the tasks in.
+	SecurityGroups []string // IDs of additional security groups attached to the tasks.
+	Ingress        []*IngressRule
+}
+
+// IngressRule holds the name of a workload in the environment that can reach the tasks on a port.
+// Tasks with ingress rules are no longer reachable through the environment security group.
+type IngressRule struct {
+	From string
+	Port int
+}
+
+// ListenerRuleOpts holds the conditions of a load balancer listener rule that forwards requests to the service.
+type ListenerRuleOpts struct {
+	Path      string // Empty for the main rule, which uses the "RulePath" parameter.
+	Aliases   []string
+	Headers   map[string][]string
+	Query     map[string]string
+	SourceIPs []string // CIDR ranges that requests must come from.
+}
+
+// Capacity providers of the tasks.
+const (
+	CapacityProviderFargate     = "FARGATE"
+	CapacityProviderFargateSpot = "FARGATE_SPOT"
+)
+
+// CapacityProviderStrategy holds how many tasks, and which share of the remaining tasks, run on a capacity provider.
+type CapacityProviderStrategy struct {
+	CapacityProvider string
+	Base             *int // Minimum number of tasks on the capacity provider.
+	Weight           *int // Relative share of the tasks beyond the base.
 }
 
 // StateMachineOpts holds configuration neeed for State Machine retries and timeout.
@@ -103,11 +181,27 @@ type WorkloadOpts struct {
 	Sidecars    []*SidecarOpts
 	LogConfig   *LogConfigOpts
 	Autoscaling *AutoscalingOpts
+	Network     *NetworkOpts
 
 	// Additional options for service templates.
 	HealthCheck        *ecs.HealthCheck
 	RulePriorityLambda string
 	DesiredCountLambda string
+	EnableExec         bool
+	Publish            *PublishOpts
+	CapacityProviders  []*CapacityProviderStrategy // Replaces the Fargate launch type if some tasks run on Fargate Spot.
+
+	// Additional options for load balanced web service templates.
+	ListenerRule            *ListenerRuleOpts // Extra conditions of the main listener rule.
+	AdditionalListenerRules []*ListenerRuleOpts
+	ImportedCertsOnly       bool // True if the HTTPS listener uses imported certificates and the environment has no hosted zone.
+
+	// Additional options for backend service templates.
+	InternalALB bool // True if the service is routed through the internal load balancer of the environment.
+
+	// Additional options for worker service templates.
+	Subscribe            *SubscribeOpts
+	BacklogPerTaskLambda string
 
 	// Additional options for job templates.
 	ScheduleExpression string
@@ -125,6 +219,11 @@ func (t *Template) ParseBackendService(data WorkloadOpts) (*Content, error) {
 	return t.parseSvc(backendSvcTplName, data, withSvcParsingFuncs())
 }
 
+// ParseWorkerService parses a worker service's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseWorkerService(data WorkloadOpts) (*Content, error) {
+	return t.parseSvc(workerSvcTplName, data, withSvcParsingFuncs())
+}
+
 // ParseScheduledJob parses a scheduled job's Cloudformation Template
 func (t *Template) ParseScheduledJob(data WorkloadOpts) (*Content, error) {
 	return t.parseJob(scheduledJobTplName, data, withSvcParsingFuncs())
@@ -165,11 +264,14 @@ func (t *Template) parseWkld(name, wkldDirName string, data interface{}, options
 func withSvcParsingFuncs() ParseOption {
 	return func(t *template.Template) *template.Template {
 		return t.Funcs(map[string]interface{}{
-			"toSnakeCase": ToSnakeCaseFunc,
-			"hasSecrets":  hasSecrets,
-			"fmtSlice":    FmtSliceFunc,
-			"quoteSlice":  QuotePSliceFunc,
-			"randomUUID":  randomUUIDFunc,
+			"toSnakeCase":      ToSnakeCaseFunc,
+			"hasSecrets":       hasSecrets,
+			"fmtSlice":         FmtSliceFunc,
+			"quoteSlice":       QuotePSliceFunc,
+			"quoteStringSlice": QuoteSliceFunc,
+			"randomUUID":       randomUUIDFunc,
+			"logicalIDSafe":    StripNonAlphaNumFunc,
+			"aliases":          aliases,
 		})
 	}
 }
@@ -184,6 +286,26 @@ func hasSecrets(opts WorkloadOpts) bool {
 	return false
 }
 
+// aliases returns the unique host names of all the listener rules of a load balanced web service.
+func aliases(opts WorkloadOpts) []string {
+	rules := opts.AdditionalListenerRules
+	if opts.ListenerRule != nil {
+		rules = append([]*ListenerRuleOpts{opts.ListenerRule}, rules...)
+	}
+	var names []string
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		for _, alias := range rule.Aliases {
+			if seen[alias] {
+				continue
+			}
+			seen[alias] = true
+			names = append(names, alias)
+		}
+	}
+	return names
+}
+
 func randomUUIDFunc() (string, error) {
 	id, err := uuid.NewRandom()
 	if err != nil {
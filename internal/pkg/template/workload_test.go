@@ -39,6 +39,10 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				mockBox.AddString("workloads/common/cf/sidecars.yml", "sidecars")
 				mockBox.AddString("workloads/common/cf/logconfig.yml", "logconfig")
 				mockBox.AddString("workloads/common/cf/autoscaling.yml", "autoscaling")
+				mockBox.AddString("workloads/common/cf/publish.yml", "publish")
+				mockBox.AddString("workloads/common/cf/publish-outputs.yml", "publish-outputs")
+				mockBox.AddString("workloads/common/cf/security-group.yml", "security-group")
+				mockBox.AddString("workloads/common/cf/listener-rule-conditions.yml", "listener-rule-conditions")
 				mockBox.AddString("workloads/common/cf/state-machine-definition.json.yml", "state-machine-definition")
 				mockBox.AddString("workloads/common/cf/eventrule.yml", "eventrule")
 				mockBox.AddString("workloads/common/cf/state-machine.yml", "state-machine")
@@ -56,6 +60,10 @@ func TestTemplate_ParseSvc(t *testing.T) {
   sidecars
   logconfig
   autoscaling
+  publish
+  publish-outputs
+  security-group
+  listener-rule-conditions
   eventrule
   state-machine
   state-machine-definition
@@ -121,3 +129,36 @@ func TestHasSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestAliases(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted []string
+	}{
+		"no listener rules": {
+			in: WorkloadOpts{},
+		},
+		"unique aliases of all the rules": {
+			in: WorkloadOpts{
+				ListenerRule: &ListenerRuleOpts{
+					Aliases: []string{"example.com", "api.example.com"},
+				},
+				AdditionalListenerRules: []*ListenerRuleOpts{
+					{
+						Path: "/v2",
+					},
+					{
+						Aliases: []string{"api.example.com", "v2.example.com"},
+					},
+				},
+			},
+			wanted: []string{"example.com", "api.example.com", "v2.example.com"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, aliases(tc.in))
+		})
+	}
+}
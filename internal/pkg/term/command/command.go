@@ -34,6 +34,20 @@ func Stdout(writer io.Writer) Option {
 	}
 }
 
+// Stderr sets the internal *exec.Cmd's Stderr field.
+func Stderr(writer io.Writer) Option {
+	return func(c *exec.Cmd) {
+		c.Stderr = writer
+	}
+}
+
+// Env appends the input "KEY=VALUE" variables to the environment of the current process for the internal *exec.Cmd.
+func Env(vars []string) Option {
+	return func(c *exec.Cmd) {
+		c.Env = append(os.Environ(), vars...)
+	}
+}
+
 // Run runs the input command with input args with Stdout and Stderr defaulted to os.Stderr.
 // Input options will override these defaults.
 func (s Service) Run(name string, args []string, options ...Option) error {
@@ -205,6 +205,44 @@ func (mr *MockConfigSvcListerMockRecorder) ListServices(appName interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockConfigSvcLister)(nil).ListServices), appName)
 }
 
+// MockConfigJobLister is a mock of ConfigJobLister interface
+type MockConfigJobLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigJobListerMockRecorder
+}
+
+// MockConfigJobListerMockRecorder is the mock recorder for MockConfigJobLister
+type MockConfigJobListerMockRecorder struct {
+	mock *MockConfigJobLister
+}
+
+// NewMockConfigJobLister creates a new mock instance
+func NewMockConfigJobLister(ctrl *gomock.Controller) *MockConfigJobLister {
+	mock := &MockConfigJobLister{ctrl: ctrl}
+	mock.recorder = &MockConfigJobListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockConfigJobLister) EXPECT() *MockConfigJobListerMockRecorder {
+	return m.recorder
+}
+
+// ListJobs mocks base method
+func (m *MockConfigJobLister) ListJobs(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockConfigJobListerMockRecorder) ListJobs(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockConfigJobLister)(nil).ListJobs), appName)
+}
+
 // MockConfigLister is a mock of ConfigLister interface
 type MockConfigLister struct {
 	ctrl     *gomock.Controller
@@ -273,6 +311,21 @@ func (mr *MockConfigListerMockRecorder) ListServices(appName interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockConfigLister)(nil).ListServices), appName)
 }
 
+// ListJobs mocks base method
+func (m *MockConfigLister) ListJobs(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockConfigListerMockRecorder) ListJobs(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockConfigLister)(nil).ListJobs), appName)
+}
+
 // MockWsWorkloadLister is a mock of WsWorkloadLister interface
 type MockWsWorkloadLister struct {
 	ctrl     *gomock.Controller
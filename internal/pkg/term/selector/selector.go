@@ -81,10 +81,16 @@ type ConfigSvcLister interface {
 	ListServices(appName string) ([]*config.Workload, error)
 }
 
+// ConfigJobLister wraps the method to list jobs in config store.
+type ConfigJobLister interface {
+	ListJobs(appName string) ([]*config.Workload, error)
+}
+
 // ConfigLister wraps config store listing methods.
 type ConfigLister interface {
 	AppEnvLister
 	ConfigSvcLister
+	ConfigJobLister
 }
 
 // WsWorkloadLister wraps the method to get workloads in current workspace.
@@ -111,10 +117,11 @@ type Select struct {
 	lister AppEnvLister
 }
 
-// ConfigSelect is an application and environment selector, but can also choose a service from the config store.
+// ConfigSelect is an application and environment selector, but can also choose a service or job from the config store.
 type ConfigSelect struct {
 	*Select
 	svcLister ConfigSvcLister
+	jobLister ConfigJobLister
 }
 
 // WorkspaceSelect  is an application and environment selector, but can also choose a service from the workspace.
@@ -139,11 +146,12 @@ func NewSelect(prompt Prompter, store AppEnvLister) *Select {
 	}
 }
 
-// NewConfigSelect returns a new selector that chooses applications, environments, or services from the config store.
+// NewConfigSelect returns a new selector that chooses applications, environments, services, or jobs from the config store.
 func NewConfigSelect(prompt Prompter, store ConfigLister) *ConfigSelect {
 	return &ConfigSelect{
 		Select:    NewSelect(prompt, store),
 		svcLister: store,
+		jobLister: store,
 	}
 }
 
@@ -324,6 +332,29 @@ func (s *ConfigSelect) Service(prompt, help, app string) (string, error) {
 	return selectedAppName, nil
 }
 
+// Job fetches all jobs in an app and prompts the user to select one.
+func (s *ConfigSelect) Job(prompt, help, app string) (string, error) {
+	jobs, err := s.retrieveJobs(app)
+	if err != nil {
+		return "", fmt.Errorf("get jobs for app %s: %w", app, err)
+	}
+	if len(jobs) == 0 {
+		log.Infof("Couldn't find any jobs associated with app %s, try initializing one: %s\n",
+			color.HighlightUserInput(app),
+			color.HighlightCode("copilot job init"))
+		return "", fmt.Errorf("no jobs found in app %s", app)
+	}
+	if len(jobs) == 1 {
+		log.Infof("Only found one job, defaulting to: %s\n", color.HighlightUserInput(jobs[0]))
+		return jobs[0], nil
+	}
+	selectedJobName, err := s.prompt.SelectOne(prompt, help, jobs)
+	if err != nil {
+		return "", fmt.Errorf("select job: %w", err)
+	}
+	return selectedJobName, nil
+}
+
 // Environment fetches all the environments in an app and prompts the user to select one.
 func (s *Select) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
 	envs, err := s.retrieveEnvironments(app)
@@ -413,6 +444,18 @@ func (s *ConfigSelect) retrieveServices(app string) ([]string, error) {
 	return serviceNames, nil
 }
 
+func (s *ConfigSelect) retrieveJobs(app string) ([]string, error) {
+	jobs, err := s.jobLister.ListJobs(app)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	jobNames := make([]string, len(jobs))
+	for ind, job := range jobs {
+		jobNames[ind] = job.Name
+	}
+	return jobNames, nil
+}
+
 func (s *WorkspaceSelect) retrieveWorkspaceServices() ([]string, error) {
 	localServiceNames, err := s.wlLister.ServiceNames()
 	if err != nil {
@@ -577,6 +577,144 @@ func TestConfigSelect_Service(t *testing.T) {
 	}
 }
 
+func TestConfigSelect_Job(t *testing.T) {
+	appName := "myapp"
+	testCases := map[string]struct {
+		setupMocks func(m configSelectMocks)
+		wantErr    error
+		want       string
+	}{
+		"with no jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			wantErr: fmt.Errorf("no jobs found in app myapp"),
+		},
+		"with error listing jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return(nil, fmt.Errorf("some error")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("get jobs for app myapp: list jobs: some error"),
+		},
+		"with only one job (skips prompting)": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{
+						{
+							App:  appName,
+							Name: "job1",
+							Type: "Scheduled Job",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			want: "job1",
+		},
+		"with multiple jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{
+						{
+							App:  appName,
+							Name: "job1",
+							Type: "Scheduled Job",
+						},
+						{
+							App:  appName,
+							Name: "job2",
+							Type: "Scheduled Job",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(
+						gomock.Eq("Select a job"),
+						gomock.Eq("Help text"),
+						gomock.Eq([]string{"job1", "job2"})).
+					Return("job2", nil).
+					Times(1)
+			},
+			want: "job2",
+		},
+		"with error selecting jobs": {
+			setupMocks: func(m configSelectMocks) {
+				m.serviceLister.
+					EXPECT().
+					ListJobs(gomock.Eq(appName)).
+					Return([]*config.Workload{
+						{
+							App:  appName,
+							Name: "job1",
+							Type: "Scheduled Job",
+						},
+						{
+							App:  appName,
+							Name: "job2",
+							Type: "Scheduled Job",
+						},
+					}, nil).
+					Times(1)
+				m.prompt.
+					EXPECT().
+					SelectOne(gomock.Any(), gomock.Any(), gomock.Eq([]string{"job1", "job2"})).
+					Return("", fmt.Errorf("error selecting")).
+					Times(1)
+			},
+			wantErr: fmt.Errorf("select job: error selecting"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockconfigLister := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := configSelectMocks{
+				serviceLister: mockconfigLister,
+				prompt:        mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := ConfigSelect{
+				Select: &Select{
+					prompt: mockprompt,
+				},
+				jobLister: mockconfigLister,
+			}
+
+			got, err := sel.Job("Select a job", "Help text", appName)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 type environmentMocks struct {
 	envLister *mocks.MockAppEnvLister
 	prompt    *mocks.MockPrompter
@@ -20,6 +20,16 @@ func (e *ErrFileExists) Error() string {
 	return fmt.Sprintf("file %s already exists", e.FileName)
 }
 
+// ErrEnvironmentManifestNotFound means there is no manifest for the environment in the workspace.
+type ErrEnvironmentManifestNotFound struct {
+	EnvName string
+	parent  error
+}
+
+func (e *ErrEnvironmentManifestNotFound) Error() string {
+	return fmt.Sprintf("read environment %s manifest file: %v", e.EnvName, e.parent)
+}
+
 // errWorkspaceNotFound means we couldn't locate a workspace root.
 type errWorkspaceNotFound struct {
 	CurrentDirectory      string
@@ -7,6 +7,9 @@
 //  .
 //  ├── copilot                        (application directory)
 //  │   ├── .workspace                 (workspace summary)
+//  │   ├── environments
+//  │   │   └── test
+//  │   │       └── manifest.yml       (environment manifest)
 //  │   └── my-service
 //  │   │   └── manifest.yml           (service manifest)
 //  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
@@ -34,6 +37,7 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	environmentsDirName       = "environments"
 	maximumParentDirsToSearch = 5
 	pipelineFileName          = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
@@ -195,6 +199,22 @@ func (ws *Workspace) ReadJobManifest(name string) ([]byte, error) {
 	return mf, nil
 }
 
+// ReadEnvironmentManifest returns the contents of the environment's manifest under copilot/environments/{name}/manifest.yml.
+func (ws *Workspace) ReadEnvironmentManifest(name string) ([]byte, error) {
+	mf, err := ws.read(environmentsDirName, name, manifestFileName)
+	if err != nil {
+		var errNoWorkspace *errWorkspaceNotFound
+		if errors.Is(err, os.ErrNotExist) || errors.As(err, &errNoWorkspace) {
+			return nil, &ErrEnvironmentManifestNotFound{
+				EnvName: name,
+				parent:  err,
+			}
+		}
+		return nil, fmt.Errorf("read environment %s manifest file: %w", name, err)
+	}
+	return mf, nil
+}
+
 func (ws *Workspace) readWorkloadManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
@@ -712,6 +712,54 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadEnvironmentManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent string
+		wantedErr     string
+	}{
+		"reads the environment manifest": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				afero.WriteFile(fs, "/copilot/environments/test/manifest.yml", []byte("name: test"), 0644)
+				return fs
+			},
+			wantedContent: "name: test",
+		},
+		"wraps the error if the manifest doesn't exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments", 0755)
+				return fs
+			},
+			wantedErr: "read environment test manifest file: open /copilot/environments/test/manifest.yml: file does not exist",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			// WHEN
+			content, err := ws.ReadEnvironmentManifest("test")
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(content))
+		})
+	}
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string